@@ -136,17 +136,524 @@ func (PlayerStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{1}
 }
 
+// 球员异动类型
+type TransactionType int32
+
+const (
+	TransactionType_TRANSACTION_UNKNOWN TransactionType = 0
+	TransactionType_SIGNING             TransactionType = 1 // 签约
+	TransactionType_TRADE               TransactionType = 2 // 交易
+	TransactionType_WAIVER              TransactionType = 3 // 裁掉 (进入裁员名单)
+	TransactionType_RELEASE             TransactionType = 4 // 解约
+	TransactionType_TWO_WAY_CONVERSION  TransactionType = 5 // 标准合同/双向合同互转
+	TransactionType_G_LEAGUE_ASSIGNMENT TransactionType = 6 // 下放发展联盟
+	TransactionType_G_LEAGUE_RECALL     TransactionType = 7 // 从发展联盟召回
+)
+
+// Enum value maps for TransactionType.
+var (
+	TransactionType_name = map[int32]string{
+		0: "TRANSACTION_UNKNOWN",
+		1: "SIGNING",
+		2: "TRADE",
+		3: "WAIVER",
+		4: "RELEASE",
+		5: "TWO_WAY_CONVERSION",
+		6: "G_LEAGUE_ASSIGNMENT",
+		7: "G_LEAGUE_RECALL",
+	}
+	TransactionType_value = map[string]int32{
+		"TRANSACTION_UNKNOWN": 0,
+		"SIGNING":             1,
+		"TRADE":               2,
+		"WAIVER":              3,
+		"RELEASE":             4,
+		"TWO_WAY_CONVERSION":  5,
+		"G_LEAGUE_ASSIGNMENT": 6,
+		"G_LEAGUE_RECALL":     7,
+	}
+)
+
+func (x TransactionType) Enum() *TransactionType {
+	p := new(TransactionType)
+	*p = x
+	return p
+}
+
+func (x TransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[2].Descriptor()
+}
+
+func (TransactionType) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[2]
+}
+
+func (x TransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionType.Descriptor instead.
+func (TransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{2}
+}
+
+// 合同选项
+type ContractOption int32
+
+const (
+	ContractOption_OPTION_NONE       ContractOption = 0
+	ContractOption_PLAYER_OPTION     ContractOption = 1 // 球员选项
+	ContractOption_TEAM_OPTION       ContractOption = 2 // 球队选项
+	ContractOption_EARLY_TERMINATION ContractOption = 3 // 提前终止选项
+)
+
+// Enum value maps for ContractOption.
+var (
+	ContractOption_name = map[int32]string{
+		0: "OPTION_NONE",
+		1: "PLAYER_OPTION",
+		2: "TEAM_OPTION",
+		3: "EARLY_TERMINATION",
+	}
+	ContractOption_value = map[string]int32{
+		"OPTION_NONE":       0,
+		"PLAYER_OPTION":     1,
+		"TEAM_OPTION":       2,
+		"EARLY_TERMINATION": 3,
+	}
+)
+
+func (x ContractOption) Enum() *ContractOption {
+	p := new(ContractOption)
+	*p = x
+	return p
+}
+
+func (x ContractOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContractOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ContractOption) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[3]
+}
+
+func (x ContractOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContractOption.Descriptor instead.
+func (ContractOption) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{3}
+}
+
+// 鸟权
+type BirdRights int32
+
+const (
+	BirdRights_BIRD_NONE  BirdRights = 0
+	BirdRights_NON_BIRD   BirdRights = 1 // 效力 1 个赛季
+	BirdRights_EARLY_BIRD BirdRights = 2 // 连续效力 2 个赛季
+	BirdRights_FULL_BIRD  BirdRights = 3 // 连续效力 3 个赛季及以上
+)
+
+// Enum value maps for BirdRights.
+var (
+	BirdRights_name = map[int32]string{
+		0: "BIRD_NONE",
+		1: "NON_BIRD",
+		2: "EARLY_BIRD",
+		3: "FULL_BIRD",
+	}
+	BirdRights_value = map[string]int32{
+		"BIRD_NONE":  0,
+		"NON_BIRD":   1,
+		"EARLY_BIRD": 2,
+		"FULL_BIRD":  3,
+	}
+)
+
+func (x BirdRights) Enum() *BirdRights {
+	p := new(BirdRights)
+	*p = x
+	return p
+}
+
+func (x BirdRights) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BirdRights) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[4].Descriptor()
+}
+
+func (BirdRights) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[4]
+}
+
+func (x BirdRights) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BirdRights.Descriptor instead.
+func (BirdRights) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{4}
+}
+
+// 工资总额所处区间
+type PayrollLevel int32
+
+const (
+	PayrollLevel_UNDER_CAP         PayrollLevel = 0 // 低于工资帽
+	PayrollLevel_OVER_CAP          PayrollLevel = 1 // 超过工资帽
+	PayrollLevel_OVER_TAX          PayrollLevel = 2 // 超过奢侈税线
+	PayrollLevel_OVER_FIRST_APRON  PayrollLevel = 3 // 超过第一土豪线
+	PayrollLevel_OVER_SECOND_APRON PayrollLevel = 4 // 超过第二土豪线
+)
+
+// Enum value maps for PayrollLevel.
+var (
+	PayrollLevel_name = map[int32]string{
+		0: "UNDER_CAP",
+		1: "OVER_CAP",
+		2: "OVER_TAX",
+		3: "OVER_FIRST_APRON",
+		4: "OVER_SECOND_APRON",
+	}
+	PayrollLevel_value = map[string]int32{
+		"UNDER_CAP":         0,
+		"OVER_CAP":          1,
+		"OVER_TAX":          2,
+		"OVER_FIRST_APRON":  3,
+		"OVER_SECOND_APRON": 4,
+	}
+)
+
+func (x PayrollLevel) Enum() *PayrollLevel {
+	p := new(PayrollLevel)
+	*p = x
+	return p
+}
+
+func (x PayrollLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayrollLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PayrollLevel) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[5]
+}
+
+func (x PayrollLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayrollLevel.Descriptor instead.
+func (PayrollLevel) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{5}
+}
+
+// 伤病出战状态
+type InjuryDesignation int32
+
+const (
+	InjuryDesignation_DESIGNATION_UNKNOWN InjuryDesignation = 0
+	InjuryDesignation_OUT                 InjuryDesignation = 1 // 缺阵
+	InjuryDesignation_DOUBTFUL            InjuryDesignation = 2 // 出战成疑 (大概率缺阵)
+	InjuryDesignation_QUESTIONABLE        InjuryDesignation = 3 // 出战存疑
+	InjuryDesignation_PROBABLE            InjuryDesignation = 4 // 大概率出战
+)
+
+// Enum value maps for InjuryDesignation.
+var (
+	InjuryDesignation_name = map[int32]string{
+		0: "DESIGNATION_UNKNOWN",
+		1: "OUT",
+		2: "DOUBTFUL",
+		3: "QUESTIONABLE",
+		4: "PROBABLE",
+	}
+	InjuryDesignation_value = map[string]int32{
+		"DESIGNATION_UNKNOWN": 0,
+		"OUT":                 1,
+		"DOUBTFUL":            2,
+		"QUESTIONABLE":        3,
+		"PROBABLE":            4,
+	}
+)
+
+func (x InjuryDesignation) Enum() *InjuryDesignation {
+	p := new(InjuryDesignation)
+	*p = x
+	return p
+}
+
+func (x InjuryDesignation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InjuryDesignation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[6].Descriptor()
+}
+
+func (InjuryDesignation) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[6]
+}
+
+func (x InjuryDesignation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InjuryDesignation.Descriptor instead.
+func (InjuryDesignation) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{6}
+}
+
+// 阵容名额类型
+type RosterSlot int32
+
+const (
+	RosterSlot_ROSTER_SLOT_UNKNOWN RosterSlot = 0 // 不在阵容 (自由球员)
+	RosterSlot_STANDARD            RosterSlot = 1 // 标准合同
+	RosterSlot_TWO_WAY             RosterSlot = 2 // 双向合同
+)
+
+// Enum value maps for RosterSlot.
+var (
+	RosterSlot_name = map[int32]string{
+		0: "ROSTER_SLOT_UNKNOWN",
+		1: "STANDARD",
+		2: "TWO_WAY",
+	}
+	RosterSlot_value = map[string]int32{
+		"ROSTER_SLOT_UNKNOWN": 0,
+		"STANDARD":            1,
+		"TWO_WAY":             2,
+	}
+)
+
+func (x RosterSlot) Enum() *RosterSlot {
+	p := new(RosterSlot)
+	*p = x
+	return p
+}
+
+func (x RosterSlot) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RosterSlot) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[7].Descriptor()
+}
+
+func (RosterSlot) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[7]
+}
+
+func (x RosterSlot) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RosterSlot.Descriptor instead.
+func (RosterSlot) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{7}
+}
+
+// 比赛事件类型
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNKNOWN EventType = 0
+	EventType_SHOT_MADE          EventType = 1  // 投篮命中 (value: 2/3)
+	EventType_SHOT_MISSED        EventType = 2  // 投篮不中
+	EventType_FREE_THROW_MADE    EventType = 3  // 罚球命中 (value: 1)
+	EventType_FREE_THROW_MISSED  EventType = 4  // 罚球不中
+	EventType_REBOUND_OFFENSIVE  EventType = 5  // 前场篮板
+	EventType_REBOUND_DEFENSIVE  EventType = 6  // 后场篮板
+	EventType_ASSIST             EventType = 7  // 助攻
+	EventType_STEAL              EventType = 8  // 抢断
+	EventType_BLOCK              EventType = 9  // 盖帽
+	EventType_TURNOVER           EventType = 10 // 失误
+	EventType_FOUL_PERSONAL      EventType = 11 // 普通犯规
+	EventType_FOUL_SHOOTING      EventType = 12 // 投篮犯规
+	EventType_FOUL_OFFENSIVE     EventType = 13 // 进攻犯规
+	EventType_FOUL_TECHNICAL     EventType = 14 // 技术犯规
+	EventType_FOUL_FLAGRANT      EventType = 15 // 恶意犯规
+	EventType_SUBSTITUTION_IN    EventType = 16 // 换人上场
+	EventType_SUBSTITUTION_OUT   EventType = 17 // 换人下场
+	EventType_TIMEOUT            EventType = 18 // 暂停 (只需 team_id)
+	EventType_JUMP_BALL          EventType = 19 // 跳球
+	EventType_PERIOD_START       EventType = 20 // 单节开始 (无需 player/team)
+	EventType_PERIOD_END         EventType = 21 // 单节结束 (无需 player/team)
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNKNOWN",
+		1:  "SHOT_MADE",
+		2:  "SHOT_MISSED",
+		3:  "FREE_THROW_MADE",
+		4:  "FREE_THROW_MISSED",
+		5:  "REBOUND_OFFENSIVE",
+		6:  "REBOUND_DEFENSIVE",
+		7:  "ASSIST",
+		8:  "STEAL",
+		9:  "BLOCK",
+		10: "TURNOVER",
+		11: "FOUL_PERSONAL",
+		12: "FOUL_SHOOTING",
+		13: "FOUL_OFFENSIVE",
+		14: "FOUL_TECHNICAL",
+		15: "FOUL_FLAGRANT",
+		16: "SUBSTITUTION_IN",
+		17: "SUBSTITUTION_OUT",
+		18: "TIMEOUT",
+		19: "JUMP_BALL",
+		20: "PERIOD_START",
+		21: "PERIOD_END",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNKNOWN": 0,
+		"SHOT_MADE":          1,
+		"SHOT_MISSED":        2,
+		"FREE_THROW_MADE":    3,
+		"FREE_THROW_MISSED":  4,
+		"REBOUND_OFFENSIVE":  5,
+		"REBOUND_DEFENSIVE":  6,
+		"ASSIST":             7,
+		"STEAL":              8,
+		"BLOCK":              9,
+		"TURNOVER":           10,
+		"FOUL_PERSONAL":      11,
+		"FOUL_SHOOTING":      12,
+		"FOUL_OFFENSIVE":     13,
+		"FOUL_TECHNICAL":     14,
+		"FOUL_FLAGRANT":      15,
+		"SUBSTITUTION_IN":    16,
+		"SUBSTITUTION_OUT":   17,
+		"TIMEOUT":            18,
+		"JUMP_BALL":          19,
+		"PERIOD_START":       20,
+		"PERIOD_END":         21,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[8].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[8]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{8}
+}
+
+// 出手方式 (仅投篮/罚球事件使用)
+type ShotType int32
+
+const (
+	ShotType_SHOT_TYPE_UNKNOWN ShotType = 0
+	ShotType_JUMP_SHOT         ShotType = 1 // 两分跳投
+	ShotType_LAYUP             ShotType = 2 // 上篮
+	ShotType_DUNK              ShotType = 3 // 扣篮
+	ShotType_HOOK_SHOT         ShotType = 4 // 勾手
+	ShotType_TIP_IN            ShotType = 5 // 补篮
+	ShotType_THREE_POINTER     ShotType = 6 // 三分
+	ShotType_FREE_THROW        ShotType = 7 // 罚球
+)
+
+// Enum value maps for ShotType.
+var (
+	ShotType_name = map[int32]string{
+		0: "SHOT_TYPE_UNKNOWN",
+		1: "JUMP_SHOT",
+		2: "LAYUP",
+		3: "DUNK",
+		4: "HOOK_SHOT",
+		5: "TIP_IN",
+		6: "THREE_POINTER",
+		7: "FREE_THROW",
+	}
+	ShotType_value = map[string]int32{
+		"SHOT_TYPE_UNKNOWN": 0,
+		"JUMP_SHOT":         1,
+		"LAYUP":             2,
+		"DUNK":              3,
+		"HOOK_SHOT":         4,
+		"TIP_IN":            5,
+		"THREE_POINTER":     6,
+		"FREE_THROW":        7,
+	}
+)
+
+func (x ShotType) Enum() *ShotType {
+	p := new(ShotType)
+	*p = x
+	return p
+}
+
+func (x ShotType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShotType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_nba_service_proto_enumTypes[9].Descriptor()
+}
+
+func (ShotType) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_nba_service_proto_enumTypes[9]
+}
+
+func (x ShotType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShotType.Descriptor instead.
+func (ShotType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{9}
+}
+
 // 创建球员请求
 type CreatePlayerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // 球员姓名
-	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                   // 所属球队ID
-	JerseyNumber  int32                  `protobuf:"varint,3,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"` // 球衣号码 (1-99)
-	Position      Position               `protobuf:"varint,4,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`            // 位置
-	Height        float64                `protobuf:"fixed64,5,opt,name=height,proto3" json:"height,omitempty"`                                // 身高 (米), 例如: 1.98
-	Weight        float64                `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`                                // 体重 (kg), 例如: 95.5
-	Birthday      string                 `protobuf:"bytes,7,opt,name=birthday,proto3" json:"birthday,omitempty"`                              // 出生日期, 格式: YYYY-MM-DD
-	Status        PlayerStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=v1.PlayerStatus" json:"status,omitempty"`            // 状态
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                            // 球员姓名
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                         // 所属球队ID
+	JerseyNumber  int32                  `protobuf:"varint,3,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"`       // 球衣号码 (1-99)
+	Position      Position               `protobuf:"varint,4,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`                  // 位置
+	Height        float64                `protobuf:"fixed64,5,opt,name=height,proto3" json:"height,omitempty"`                                      // 身高 (米), 例如: 1.98
+	Weight        float64                `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`                                      // 体重 (kg), 例如: 95.5
+	Birthday      string                 `protobuf:"bytes,7,opt,name=birthday,proto3" json:"birthday,omitempty"`                                    // 出生日期, 格式: YYYY-MM-DD
+	Status        PlayerStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=v1.PlayerStatus" json:"status,omitempty"`                  // 状态
+	Aliases       []string               `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`                                      // 别名/绰号 (搜索用)
+	NoTradeClause bool                   `protobuf:"varint,10,opt,name=no_trade_clause,json=noTradeClause,proto3" json:"no_trade_clause,omitempty"` // 不可交易条款 (交易需球员同意)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -237,6 +744,20 @@ func (x *CreatePlayerRequest) GetStatus() PlayerStatus {
 	return PlayerStatus_STATUS_UNKNOWN
 }
 
+func (x *CreatePlayerRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CreatePlayerRequest) GetNoTradeClause() bool {
+	if x != nil {
+		return x.NoTradeClause
+	}
+	return false
+}
+
 // 获取球员请求
 type GetPlayerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -285,15 +806,17 @@ func (x *GetPlayerRequest) GetId() int32 {
 // 更新球员请求
 type UpdatePlayerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // 球员ID
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // 球员姓名
-	TeamId        int32                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                   // 所属球队ID
-	JerseyNumber  int32                  `protobuf:"varint,4,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"` // 球衣号码
-	Position      Position               `protobuf:"varint,5,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`            // 位置
-	Height        float64                `protobuf:"fixed64,6,opt,name=height,proto3" json:"height,omitempty"`                                // 身高
-	Weight        float64                `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`                                // 体重
-	Birthday      string                 `protobuf:"bytes,8,opt,name=birthday,proto3" json:"birthday,omitempty"`                              // 出生日期
-	Status        PlayerStatus           `protobuf:"varint,9,opt,name=status,proto3,enum=v1.PlayerStatus" json:"status,omitempty"`            // 状态
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                               // 球员ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                            // 球员姓名
+	TeamId        int32                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                         // 所属球队ID
+	JerseyNumber  int32                  `protobuf:"varint,4,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"`       // 球衣号码
+	Position      Position               `protobuf:"varint,5,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`                  // 位置
+	Height        float64                `protobuf:"fixed64,6,opt,name=height,proto3" json:"height,omitempty"`                                      // 身高
+	Weight        float64                `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`                                      // 体重
+	Birthday      string                 `protobuf:"bytes,8,opt,name=birthday,proto3" json:"birthday,omitempty"`                                    // 出生日期
+	Status        PlayerStatus           `protobuf:"varint,9,opt,name=status,proto3,enum=v1.PlayerStatus" json:"status,omitempty"`                  // 状态
+	Aliases       []string               `protobuf:"bytes,10,rep,name=aliases,proto3" json:"aliases,omitempty"`                                     // 别名/绰号 (搜索用)
+	NoTradeClause bool                   `protobuf:"varint,11,opt,name=no_trade_clause,json=noTradeClause,proto3" json:"no_trade_clause,omitempty"` // 不可交易条款 (交易需球员同意)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -391,6 +914,20 @@ func (x *UpdatePlayerRequest) GetStatus() PlayerStatus {
 	return PlayerStatus_STATUS_UNKNOWN
 }
 
+func (x *UpdatePlayerRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *UpdatePlayerRequest) GetNoTradeClause() bool {
+	if x != nil {
+		return x.NoTradeClause
+	}
+	return false
+}
+
 // 删除球员请求
 type DeletePlayerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -492,18 +1029,21 @@ func (x *DeletePlayerResponse) GetMessage() string {
 // 球员响应（完整信息）
 type PlayerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // 球员ID
-	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                   // 所属球队ID
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                      // 球员姓名
-	JerseyNumber  int32                  `protobuf:"varint,4,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"` // 球衣号码
-	Position      Position               `protobuf:"varint,5,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`            // 位置
-	Height        float64                `protobuf:"fixed64,6,opt,name=height,proto3" json:"height,omitempty"`                                // 身高 (米)
-	Weight        float64                `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`                                // 体重 (kg)
-	Birthday      string                 `protobuf:"bytes,8,opt,name=birthday,proto3" json:"birthday,omitempty"`                              // 出生日期
-	Status        PlayerStatus           `protobuf:"varint,9,opt,name=status,proto3,enum=v1.PlayerStatus" json:"status,omitempty"`            // 状态
-	StatusText    string                 `protobuf:"bytes,10,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`       // 状态文本
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // 创建时间
-	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`          // 更新时间
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                       // 球员ID
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                                 // 所属球队ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                    // 球员姓名
+	JerseyNumber  int32                  `protobuf:"varint,4,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"`               // 球衣号码
+	Position      Position               `protobuf:"varint,5,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`                          // 位置
+	Height        float64                `protobuf:"fixed64,6,opt,name=height,proto3" json:"height,omitempty"`                                              // 身高 (米)
+	Weight        float64                `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`                                              // 体重 (kg)
+	Birthday      string                 `protobuf:"bytes,8,opt,name=birthday,proto3" json:"birthday,omitempty"`                                            // 出生日期
+	Status        PlayerStatus           `protobuf:"varint,9,opt,name=status,proto3,enum=v1.PlayerStatus" json:"status,omitempty"`                          // 状态
+	StatusText    string                 `protobuf:"bytes,10,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`                     // 状态文本
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                        // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                        // 更新时间
+	Aliases       []string               `protobuf:"bytes,13,rep,name=aliases,proto3" json:"aliases,omitempty"`                                             // 别名/绰号
+	RosterSlot    RosterSlot             `protobuf:"varint,14,opt,name=roster_slot,json=rosterSlot,proto3,enum=v1.RosterSlot" json:"roster_slot,omitempty"` // 阵容名额类型
+	NoTradeClause bool                   `protobuf:"varint,15,opt,name=no_trade_clause,json=noTradeClause,proto3" json:"no_trade_clause,omitempty"`         // 不可交易条款
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -622,6 +1162,27 @@ func (x *PlayerResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *PlayerResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetRosterSlot() RosterSlot {
+	if x != nil {
+		return x.RosterSlot
+	}
+	return RosterSlot_ROSTER_SLOT_UNKNOWN
+}
+
+func (x *PlayerResponse) GetNoTradeClause() bool {
+	if x != nil {
+		return x.NoTradeClause
+	}
+	return false
+}
+
 // 查询球员列表请求
 type ListPlayersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -821,28 +1382,28 @@ func (x *GetPlayersByTeamRequest) GetTeamId() int32 {
 	return 0
 }
 
-// --- 球队相关 Message ---
-type GetTeamRequest struct {
+// 阵容请求
+type GetRosterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // 球队ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamRequest) Reset() {
-	*x = GetTeamRequest{}
+func (x *GetRosterRequest) Reset() {
+	*x = GetRosterRequest{}
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamRequest) String() string {
+func (x *GetRosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamRequest) ProtoMessage() {}
+func (*GetRosterRequest) ProtoMessage() {}
 
-func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRosterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -854,44 +1415,46 @@ func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRosterRequest.ProtoReflect.Descriptor instead.
+func (*GetRosterRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetTeamRequest) GetId() int32 {
+func (x *GetRosterRequest) GetTeamId() int32 {
 	if x != nil {
-		return x.Id
+		return x.TeamId
 	}
 	return 0
 }
 
-type TeamResponse struct {
+// 阵容响应, 下放 (ASSIGNED) 的球员仍占用原名额
+type RosterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                 // e.g. Lakers
-	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`                 // e.g. Los Angeles
-	Abbreviation  string                 `protobuf:"bytes,4,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"` // e.g. LAL
-	Conference    string                 `protobuf:"bytes,5,opt,name=conference,proto3" json:"conference,omitempty"`     // East / West
-	LogoUrl       string                 `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Players       []*PlayerResponse      `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`                                   // 按名额类型、球衣号排序
+	StandardCount int32                  `protobuf:"varint,3,opt,name=standard_count,json=standardCount,proto3" json:"standard_count,omitempty"` // 标准合同人数
+	TwoWayCount   int32                  `protobuf:"varint,4,opt,name=two_way_count,json=twoWayCount,proto3" json:"two_way_count,omitempty"`     // 双向合同人数
+	AssignedCount int32                  `protobuf:"varint,5,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"` // 其中下放发展联盟的人数
+	MaxStandard   int32                  `protobuf:"varint,6,opt,name=max_standard,json=maxStandard,proto3" json:"max_standard,omitempty"`       // 标准合同名额上限
+	MaxTwoWay     int32                  `protobuf:"varint,7,opt,name=max_two_way,json=maxTwoWay,proto3" json:"max_two_way,omitempty"`           // 双向合同名额上限
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TeamResponse) Reset() {
-	*x = TeamResponse{}
+func (x *RosterResponse) Reset() {
+	*x = RosterResponse{}
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TeamResponse) String() string {
+func (x *RosterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TeamResponse) ProtoMessage() {}
+func (*RosterResponse) ProtoMessage() {}
 
-func (x *TeamResponse) ProtoReflect() protoreflect.Message {
+func (x *RosterResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,73 +1466,86 @@ func (x *TeamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TeamResponse.ProtoReflect.Descriptor instead.
-func (*TeamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RosterResponse.ProtoReflect.Descriptor instead.
+func (*RosterResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TeamResponse) GetId() int32 {
+func (x *RosterResponse) GetTeamId() int32 {
 	if x != nil {
-		return x.Id
+		return x.TeamId
 	}
 	return 0
 }
 
-func (x *TeamResponse) GetName() string {
+func (x *RosterResponse) GetPlayers() []*PlayerResponse {
 	if x != nil {
-		return x.Name
+		return x.Players
 	}
-	return ""
+	return nil
 }
 
-func (x *TeamResponse) GetCity() string {
+func (x *RosterResponse) GetStandardCount() int32 {
 	if x != nil {
-		return x.City
+		return x.StandardCount
 	}
-	return ""
+	return 0
 }
 
-func (x *TeamResponse) GetAbbreviation() string {
+func (x *RosterResponse) GetTwoWayCount() int32 {
 	if x != nil {
-		return x.Abbreviation
+		return x.TwoWayCount
 	}
-	return ""
+	return 0
 }
 
-func (x *TeamResponse) GetConference() string {
+func (x *RosterResponse) GetAssignedCount() int32 {
 	if x != nil {
-		return x.Conference
+		return x.AssignedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *TeamResponse) GetLogoUrl() string {
+func (x *RosterResponse) GetMaxStandard() int32 {
 	if x != nil {
-		return x.LogoUrl
+		return x.MaxStandard
 	}
-	return ""
+	return 0
 }
 
-type ListTeamsRequest struct {
+func (x *RosterResponse) GetMaxTwoWay() int32 {
+	if x != nil {
+		return x.MaxTwoWay
+	}
+	return 0
+}
+
+// 签入阵容请求 (球员需为自由球员)
+type AddToRosterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	JerseyNumber  int32                  `protobuf:"varint,3,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"` // 球衣号码 (0-99), 队内唯一
+	Slot          RosterSlot             `protobuf:"varint,4,opt,name=slot,proto3,enum=v1.RosterSlot" json:"slot,omitempty"`                  // 名额类型, 默认标准合同
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`                                      // 生效日期 YYYY-MM-DD, 默认当天
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTeamsRequest) Reset() {
-	*x = ListTeamsRequest{}
+func (x *AddToRosterRequest) Reset() {
+	*x = AddToRosterRequest{}
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTeamsRequest) String() string {
+func (x *AddToRosterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTeamsRequest) ProtoMessage() {}
+func (*AddToRosterRequest) ProtoMessage() {}
 
-func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+func (x *AddToRosterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -981,32 +1557,70 @@ func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
-func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddToRosterRequest.ProtoReflect.Descriptor instead.
+func (*AddToRosterRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{11}
 }
 
-type ListTeamsResponse struct {
+func (x *AddToRosterRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *AddToRosterRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *AddToRosterRequest) GetJerseyNumber() int32 {
+	if x != nil {
+		return x.JerseyNumber
+	}
+	return 0
+}
+
+func (x *AddToRosterRequest) GetSlot() RosterSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return RosterSlot_ROSTER_SLOT_UNKNOWN
+}
+
+func (x *AddToRosterRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 裁掉球员请求, 裁掉后成为自由球员
+type ReleasePlayerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teams         []*TeamResponse        `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Waive         bool                   `protobuf:"varint,2,opt,name=waive,proto3" json:"waive,omitempty"` // true 记为 WAIVER, 否则记为 RELEASE
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`    // 生效日期 YYYY-MM-DD, 默认当天
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTeamsResponse) Reset() {
-	*x = ListTeamsResponse{}
+func (x *ReleasePlayerRequest) Reset() {
+	*x = ReleasePlayerRequest{}
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTeamsResponse) String() string {
+func (x *ReleasePlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTeamsResponse) ProtoMessage() {}
+func (*ReleasePlayerRequest) ProtoMessage() {}
 
-func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+func (x *ReleasePlayerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1018,40 +1632,56 @@ func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
-func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleasePlayerRequest.ProtoReflect.Descriptor instead.
+func (*ReleasePlayerRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListTeamsResponse) GetTeams() []*TeamResponse {
+func (x *ReleasePlayerRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.Teams
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-// --- 比赛相关 Message ---
-type ListMatchesRequest struct {
+func (x *ReleasePlayerRequest) GetWaive() bool {
+	if x != nil {
+		return x.Waive
+	}
+	return false
+}
+
+func (x *ReleasePlayerRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 名额类型转换请求
+type ChangeRosterSlotRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 格式 "2023-11-05"
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Slot          RosterSlot             `protobuf:"varint,2,opt,name=slot,proto3,enum=v1.RosterSlot" json:"slot,omitempty"` // 目标名额类型
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                     // 生效日期 YYYY-MM-DD, 默认当天
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMatchesRequest) Reset() {
-	*x = ListMatchesRequest{}
+func (x *ChangeRosterSlotRequest) Reset() {
+	*x = ChangeRosterSlotRequest{}
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMatchesRequest) String() string {
+func (x *ChangeRosterSlotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMatchesRequest) ProtoMessage() {}
+func (*ChangeRosterSlotRequest) ProtoMessage() {}
 
-func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeRosterSlotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1063,49 +1693,58 @@ func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMatchesRequest.ProtoReflect.Descriptor instead.
-func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeRosterSlotRequest.ProtoReflect.Descriptor instead.
+func (*ChangeRosterSlotRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListMatchesRequest) GetDate() string {
+func (x *ChangeRosterSlotRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ChangeRosterSlotRequest) GetSlot() RosterSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return RosterSlot_ROSTER_SLOT_UNKNOWN
+}
+
+func (x *ChangeRosterSlotRequest) GetDate() string {
 	if x != nil {
 		return x.Date
 	}
 	return ""
 }
 
-type MatchResponse struct {
+// 交易中的一项资产: player_id 与 pick_id 二选一
+type TradeAsset struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	HomeTeamId    int32                  `protobuf:"varint,3,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
-	VisitorTeamId int32                  `protobuf:"varint,4,opt,name=visitor_team_id,json=visitorTeamId,proto3" json:"visitor_team_id,omitempty"`
-	HomeScore     int32                  `protobuf:"varint,5,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`          // 主队得分
-	VisitorScore  int32                  `protobuf:"varint,6,opt,name=visitor_score,json=visitorScore,proto3" json:"visitor_score,omitempty"` // 客队得分
-	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`                                 // 0:未开始, 1:进行中, 2:已结束
-	StartTime     string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// 这里直接嵌套 TeamResponse，方便前端显示队名
-	HomeTeam      *TeamResponse `protobuf:"bytes,9,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
-	VisitorTeam   *TeamResponse `protobuf:"bytes,10,opt,name=visitor_team,json=visitorTeam,proto3" json:"visitor_team,omitempty"`
+	FromTeamId    int32                  `protobuf:"varint,1,opt,name=from_team_id,json=fromTeamId,proto3" json:"from_team_id,omitempty"`
+	ToTeamId      int32                  `protobuf:"varint,2,opt,name=to_team_id,json=toTeamId,proto3" json:"to_team_id,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PickId        int32                  `protobuf:"varint,4,opt,name=pick_id,json=pickId,proto3" json:"pick_id,omitempty"` // 选秀权ID
+	Protection    int32                  `protobuf:"varint,5,opt,name=protection,proto3" json:"protection,omitempty"`       // 随选秀权附加的保护 (前 N 顺位), 0 表示沿用原有保护; 已带保护的选秀权不能再附加
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchResponse) Reset() {
-	*x = MatchResponse{}
+func (x *TradeAsset) Reset() {
+	*x = TradeAsset{}
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchResponse) String() string {
+func (x *TradeAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchResponse) ProtoMessage() {}
+func (*TradeAsset) ProtoMessage() {}
 
-func (x *MatchResponse) ProtoReflect() protoreflect.Message {
+func (x *TradeAsset) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_v1_nba_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1117,103 +1756,143 @@ func (x *MatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchResponse.ProtoReflect.Descriptor instead.
-func (*MatchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TradeAsset.ProtoReflect.Descriptor instead.
+func (*TradeAsset) Descriptor() ([]byte, []int) {
 	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MatchResponse) GetId() int64 {
+func (x *TradeAsset) GetFromTeamId() int32 {
 	if x != nil {
-		return x.Id
+		return x.FromTeamId
 	}
 	return 0
 }
 
-func (x *MatchResponse) GetDate() string {
+func (x *TradeAsset) GetToTeamId() int32 {
 	if x != nil {
-		return x.Date
+		return x.ToTeamId
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchResponse) GetHomeTeamId() int32 {
+func (x *TradeAsset) GetPlayerId() int32 {
 	if x != nil {
-		return x.HomeTeamId
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *MatchResponse) GetVisitorTeamId() int32 {
+func (x *TradeAsset) GetPickId() int32 {
 	if x != nil {
-		return x.VisitorTeamId
+		return x.PickId
 	}
 	return 0
 }
 
-func (x *MatchResponse) GetHomeScore() int32 {
+func (x *TradeAsset) GetProtection() int32 {
 	if x != nil {
-		return x.HomeScore
+		return x.Protection
 	}
 	return 0
 }
 
-func (x *MatchResponse) GetVisitorScore() int32 {
+// 交易请求, 可以涉及多支球队
+type TradePlayersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assets        []*TradeAsset          `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // 生效日期 YYYY-MM-DD, 默认当天
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	NoTradeWaived []int32                `protobuf:"varint,4,rep,packed,name=no_trade_waived,json=noTradeWaived,proto3" json:"no_trade_waived,omitempty"` // 同意放弃不可交易条款的球员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradePlayersRequest) Reset() {
+	*x = TradePlayersRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradePlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradePlayersRequest) ProtoMessage() {}
+
+func (x *TradePlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[15]
 	if x != nil {
-		return x.VisitorScore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MatchResponse) GetStatus() int32 {
+// Deprecated: Use TradePlayersRequest.ProtoReflect.Descriptor instead.
+func (*TradePlayersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TradePlayersRequest) GetAssets() []*TradeAsset {
 	if x != nil {
-		return x.Status
+		return x.Assets
 	}
-	return 0
+	return nil
 }
 
-func (x *MatchResponse) GetStartTime() string {
+func (x *TradePlayersRequest) GetDate() string {
 	if x != nil {
-		return x.StartTime
+		return x.Date
 	}
 	return ""
 }
 
-func (x *MatchResponse) GetHomeTeam() *TeamResponse {
+func (x *TradePlayersRequest) GetNote() string {
 	if x != nil {
-		return x.HomeTeam
+		return x.Note
 	}
-	return nil
+	return ""
 }
 
-func (x *MatchResponse) GetVisitorTeam() *TeamResponse {
+func (x *TradePlayersRequest) GetNoTradeWaived() []int32 {
 	if x != nil {
-		return x.VisitorTeam
+		return x.NoTradeWaived
 	}
 	return nil
 }
 
-type ListMatchesResponse struct {
+// 违反的交易规则, code 为 errors 包中的业务错误码
+type TradeViolation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*MatchResponse       `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Rule          string                 `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	TeamId        int32                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PickId        int32                  `protobuf:"varint,5,opt,name=pick_id,json=pickId,proto3" json:"pick_id,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMatchesResponse) Reset() {
-	*x = ListMatchesResponse{}
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[15]
+func (x *TradeViolation) Reset() {
+	*x = TradeViolation{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMatchesResponse) String() string {
+func (x *TradeViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMatchesResponse) ProtoMessage() {}
+func (*TradeViolation) ProtoMessage() {}
 
-func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[15]
+func (x *TradeViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,40 +1903,77 @@ func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMatchesResponse.ProtoReflect.Descriptor instead.
-func (*ListMatchesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TradeViolation.ProtoReflect.Descriptor instead.
+func (*TradeViolation) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListMatchesResponse) GetMatches() []*MatchResponse {
+func (x *TradeViolation) GetCode() int32 {
 	if x != nil {
-		return x.Matches
+		return x.Code
 	}
-	return nil
+	return 0
 }
 
-type GetMatchRequest struct {
+func (x *TradeViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *TradeViolation) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TradeViolation) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *TradeViolation) GetPickId() int32 {
+	if x != nil {
+		return x.PickId
+	}
+	return 0
+}
+
+func (x *TradeViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 交易预检响应
+type ValidateTradeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Violations    []*TradeViolation      `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMatchRequest) Reset() {
-	*x = GetMatchRequest{}
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[16]
+func (x *ValidateTradeResponse) Reset() {
+	*x = ValidateTradeResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMatchRequest) String() string {
+func (x *ValidateTradeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMatchRequest) ProtoMessage() {}
+func (*ValidateTradeResponse) ProtoMessage() {}
 
-func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[16]
+func (x *ValidateTradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,49 +1984,6218 @@ func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
-func (*GetMatchRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ValidateTradeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTradeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateTradeResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateTradeResponse) GetViolations() []*TradeViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// 球员异动记录
+type PlayerTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          TransactionType        `protobuf:"varint,2,opt,name=type,proto3,enum=v1.TransactionType" json:"type,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	FromTeamId    int32                  `protobuf:"varint,4,opt,name=from_team_id,json=fromTeamId,proto3" json:"from_team_id,omitempty"` // 0 表示自由球员
+	ToTeamId      int32                  `protobuf:"varint,5,opt,name=to_team_id,json=toTeamId,proto3" json:"to_team_id,omitempty"`       // 0 表示自由球员
+	Date          string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                                  // 生效日期
+	TradeId       int64                  `protobuf:"varint,7,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`            // 交易产生的记录才有
+	Note          string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerTransaction) Reset() {
+	*x = PlayerTransaction{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerTransaction) ProtoMessage() {}
+
+func (x *PlayerTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerTransaction.ProtoReflect.Descriptor instead.
+func (*PlayerTransaction) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlayerTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerTransaction) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_UNKNOWN
+}
+
+func (x *PlayerTransaction) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerTransaction) GetFromTeamId() int32 {
+	if x != nil {
+		return x.FromTeamId
+	}
+	return 0
+}
+
+func (x *PlayerTransaction) GetToTeamId() int32 {
+	if x != nil {
+		return x.ToTeamId
+	}
+	return 0
+}
+
+func (x *PlayerTransaction) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PlayerTransaction) GetTradeId() int64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *PlayerTransaction) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// 交易响应
+type TradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       int64                  `protobuf:"varint,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Assets        []*TradeAsset          `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty"`
+	Transactions  []*PlayerTransaction   `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeResponse) Reset() {
+	*x = TradeResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeResponse) ProtoMessage() {}
+
+func (x *TradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeResponse.ProtoReflect.Descriptor instead.
+func (*TradeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TradeResponse) GetTradeId() int64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+func (x *TradeResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TradeResponse) GetAssets() []*TradeAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *TradeResponse) GetTransactions() []*PlayerTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// 合同中一个赛季的条款
+type ContractSeason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`          // 2023-24
+	Salary        int64                  `protobuf:"varint,2,opt,name=salary,proto3" json:"salary,omitempty"`         // 工资 (美元)
+	Guaranteed    int64                  `protobuf:"varint,3,opt,name=guaranteed,proto3" json:"guaranteed,omitempty"` // 保障金额, 等于 salary 为全额保障
+	Option        ContractOption         `protobuf:"varint,4,opt,name=option,proto3,enum=v1.ContractOption" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContractSeason) Reset() {
+	*x = ContractSeason{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractSeason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractSeason) ProtoMessage() {}
+
+func (x *ContractSeason) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractSeason.ProtoReflect.Descriptor instead.
+func (*ContractSeason) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ContractSeason) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *ContractSeason) GetSalary() int64 {
+	if x != nil {
+		return x.Salary
+	}
+	return 0
+}
+
+func (x *ContractSeason) GetGuaranteed() int64 {
+	if x != nil {
+		return x.Guaranteed
+	}
+	return 0
+}
+
+func (x *ContractSeason) GetOption() ContractOption {
+	if x != nil {
+		return x.Option
+	}
+	return ContractOption_OPTION_NONE
+}
+
+// 新建合同请求 (球员需在球队阵容中, 与现有合同的赛季不能重叠)
+type CreateContractRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	SignedDate    string                 `protobuf:"bytes,2,opt,name=signed_date,json=signedDate,proto3" json:"signed_date,omitempty"` // 签约日期 YYYY-MM-DD, 默认当天
+	Seasons       []*ContractSeason      `protobuf:"bytes,3,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContractRequest) Reset() {
+	*x = CreateContractRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContractRequest) ProtoMessage() {}
+
+func (x *CreateContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContractRequest.ProtoReflect.Descriptor instead.
+func (*CreateContractRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateContractRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *CreateContractRequest) GetSignedDate() string {
+	if x != nil {
+		return x.SignedDate
+	}
+	return ""
+}
+
+func (x *CreateContractRequest) GetSeasons() []*ContractSeason {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+// 查询球员合同请求
+type GetPlayerContractRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerContractRequest) Reset() {
+	*x = GetPlayerContractRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerContractRequest) ProtoMessage() {}
+
+func (x *GetPlayerContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerContractRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerContractRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPlayerContractRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// 合同响应
+type ContractResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TeamId        int32                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // 承担工资的球队
+	SignedDate    string                 `protobuf:"bytes,4,opt,name=signed_date,json=signedDate,proto3" json:"signed_date,omitempty"`
+	WaivedDate    string                 `protobuf:"bytes,5,opt,name=waived_date,json=waivedDate,proto3" json:"waived_date,omitempty"` // 被裁日期, 之后只有保障部分计入原球队工资
+	Seasons       []*ContractSeason      `protobuf:"bytes,6,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	TotalValue    int64                  `protobuf:"varint,7,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`                    // 合同总额
+	BirdRights    BirdRights             `protobuf:"varint,8,opt,name=bird_rights,json=birdRights,proto3,enum=v1.BirdRights" json:"bird_rights,omitempty"` // 当前球队对该球员的鸟权
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContractResponse) Reset() {
+	*x = ContractResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractResponse) ProtoMessage() {}
+
+func (x *ContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractResponse.ProtoReflect.Descriptor instead.
+func (*ContractResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ContractResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContractResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ContractResponse) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ContractResponse) GetSignedDate() string {
+	if x != nil {
+		return x.SignedDate
+	}
+	return ""
+}
+
+func (x *ContractResponse) GetWaivedDate() string {
+	if x != nil {
+		return x.WaivedDate
+	}
+	return ""
+}
+
+func (x *ContractResponse) GetSeasons() []*ContractSeason {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *ContractResponse) GetTotalValue() int64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *ContractResponse) GetBirdRights() BirdRights {
+	if x != nil {
+		return x.BirdRights
+	}
+	return BirdRights_BIRD_NONE
+}
+
+// 球队工资请求
+type GetTeamPayrollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"` // 默认当前赛季
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamPayrollRequest) Reset() {
+	*x = GetTeamPayrollRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamPayrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamPayrollRequest) ProtoMessage() {}
+
+func (x *GetTeamPayrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamPayrollRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamPayrollRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTeamPayrollRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GetTeamPayrollRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+// 工资明细中的一行
+type PayrollEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContractId    int64                  `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	CapHit        int64                  `protobuf:"varint,4,opt,name=cap_hit,json=capHit,proto3" json:"cap_hit,omitempty"` // 计入工资帽的金额
+	Option        ContractOption         `protobuf:"varint,5,opt,name=option,proto3,enum=v1.ContractOption" json:"option,omitempty"`
+	Dead          bool                   `protobuf:"varint,6,opt,name=dead,proto3" json:"dead,omitempty"` // 已裁球员的保障工资
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayrollEntry) Reset() {
+	*x = PayrollEntry{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayrollEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollEntry) ProtoMessage() {}
+
+func (x *PayrollEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollEntry.ProtoReflect.Descriptor instead.
+func (*PayrollEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PayrollEntry) GetContractId() int64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *PayrollEntry) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PayrollEntry) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PayrollEntry) GetCapHit() int64 {
+	if x != nil {
+		return x.CapHit
+	}
+	return 0
+}
+
+func (x *PayrollEntry) GetOption() ContractOption {
+	if x != nil {
+		return x.Option
+	}
+	return ContractOption_OPTION_NONE
+}
+
+func (x *PayrollEntry) GetDead() bool {
+	if x != nil {
+		return x.Dead
+	}
+	return false
+}
+
+// 球队工资响应
+type TeamPayrollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	SalaryCap     int64                  `protobuf:"varint,4,opt,name=salary_cap,json=salaryCap,proto3" json:"salary_cap,omitempty"`
+	LuxuryTax     int64                  `protobuf:"varint,5,opt,name=luxury_tax,json=luxuryTax,proto3" json:"luxury_tax,omitempty"`
+	FirstApron    int64                  `protobuf:"varint,6,opt,name=first_apron,json=firstApron,proto3" json:"first_apron,omitempty"`
+	SecondApron   int64                  `protobuf:"varint,7,opt,name=second_apron,json=secondApron,proto3" json:"second_apron,omitempty"`
+	CapSpace      int64                  `protobuf:"varint,8,opt,name=cap_space,json=capSpace,proto3" json:"cap_space,omitempty"` // 工资帽空间, 超帽时为负数
+	TaxSpace      int64                  `protobuf:"varint,9,opt,name=tax_space,json=taxSpace,proto3" json:"tax_space,omitempty"` // 距奢侈税线, 超过时为负数
+	Level         PayrollLevel           `protobuf:"varint,10,opt,name=level,proto3,enum=v1.PayrollLevel" json:"level,omitempty"`
+	Entries       []*PayrollEntry        `protobuf:"bytes,11,rep,name=entries,proto3" json:"entries,omitempty"` // 按金额降序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamPayrollResponse) Reset() {
+	*x = TeamPayrollResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamPayrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamPayrollResponse) ProtoMessage() {}
+
+func (x *TeamPayrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamPayrollResponse.ProtoReflect.Descriptor instead.
+func (*TeamPayrollResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TeamPayrollResponse) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *TeamPayrollResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetSalaryCap() int64 {
+	if x != nil {
+		return x.SalaryCap
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetLuxuryTax() int64 {
+	if x != nil {
+		return x.LuxuryTax
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetFirstApron() int64 {
+	if x != nil {
+		return x.FirstApron
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetSecondApron() int64 {
+	if x != nil {
+		return x.SecondApron
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetCapSpace() int64 {
+	if x != nil {
+		return x.CapSpace
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetTaxSpace() int64 {
+	if x != nil {
+		return x.TaxSpace
+	}
+	return 0
+}
+
+func (x *TeamPayrollResponse) GetLevel() PayrollLevel {
+	if x != nil {
+		return x.Level
+	}
+	return PayrollLevel_UNDER_CAP
+}
+
+func (x *TeamPayrollResponse) GetEntries() []*PayrollEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// 新增伤病请求
+type ReportInjuryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId       int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	BodyPart       string                 `protobuf:"bytes,2,opt,name=body_part,json=bodyPart,proto3" json:"body_part,omitempty"`                   // 部位, 如 "左脚踝"
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                             // 伤情, 如 "扭伤"
+	InjuryDate     string                 `protobuf:"bytes,4,opt,name=injury_date,json=injuryDate,proto3" json:"injury_date,omitempty"`             // 受伤日期 YYYY-MM-DD, 默认当天
+	ExpectedReturn string                 `protobuf:"bytes,5,opt,name=expected_return,json=expectedReturn,proto3" json:"expected_return,omitempty"` // 预计复出日期, 可为空
+	Designation    InjuryDesignation      `protobuf:"varint,6,opt,name=designation,proto3,enum=v1.InjuryDesignation" json:"designation,omitempty"`  // 默认 OUT
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportInjuryRequest) Reset() {
+	*x = ReportInjuryRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportInjuryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportInjuryRequest) ProtoMessage() {}
+
+func (x *ReportInjuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportInjuryRequest.ProtoReflect.Descriptor instead.
+func (*ReportInjuryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReportInjuryRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ReportInjuryRequest) GetBodyPart() string {
+	if x != nil {
+		return x.BodyPart
+	}
+	return ""
+}
+
+func (x *ReportInjuryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ReportInjuryRequest) GetInjuryDate() string {
+	if x != nil {
+		return x.InjuryDate
+	}
+	return ""
+}
+
+func (x *ReportInjuryRequest) GetExpectedReturn() string {
+	if x != nil {
+		return x.ExpectedReturn
+	}
+	return ""
+}
+
+func (x *ReportInjuryRequest) GetDesignation() InjuryDesignation {
+	if x != nil {
+		return x.Designation
+	}
+	return InjuryDesignation_DESIGNATION_UNKNOWN
+}
+
+// 更新伤病请求, 未传的字段不修改; 传 returned_date 表示已复出
+type UpdateInjuryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Designation    InjuryDesignation      `protobuf:"varint,2,opt,name=designation,proto3,enum=v1.InjuryDesignation" json:"designation,omitempty"`
+	ExpectedReturn string                 `protobuf:"bytes,3,opt,name=expected_return,json=expectedReturn,proto3" json:"expected_return,omitempty"`
+	ReturnedDate   string                 `protobuf:"bytes,4,opt,name=returned_date,json=returnedDate,proto3" json:"returned_date,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateInjuryRequest) Reset() {
+	*x = UpdateInjuryRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInjuryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInjuryRequest) ProtoMessage() {}
+
+func (x *UpdateInjuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInjuryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInjuryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateInjuryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateInjuryRequest) GetDesignation() InjuryDesignation {
+	if x != nil {
+		return x.Designation
+	}
+	return InjuryDesignation_DESIGNATION_UNKNOWN
+}
+
+func (x *UpdateInjuryRequest) GetExpectedReturn() string {
+	if x != nil {
+		return x.ExpectedReturn
+	}
+	return ""
+}
+
+func (x *UpdateInjuryRequest) GetReturnedDate() string {
+	if x != nil {
+		return x.ReturnedDate
+	}
+	return ""
+}
+
+func (x *UpdateInjuryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 伤病响应
+type InjuryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId       int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName     string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	TeamId         int32                  `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // 受伤时所在球队
+	BodyPart       string                 `protobuf:"bytes,5,opt,name=body_part,json=bodyPart,proto3" json:"body_part,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	InjuryDate     string                 `protobuf:"bytes,7,opt,name=injury_date,json=injuryDate,proto3" json:"injury_date,omitempty"`
+	ExpectedReturn string                 `protobuf:"bytes,8,opt,name=expected_return,json=expectedReturn,proto3" json:"expected_return,omitempty"`
+	ReturnedDate   string                 `protobuf:"bytes,9,opt,name=returned_date,json=returnedDate,proto3" json:"returned_date,omitempty"` // 为空表示尚未复出
+	Designation    InjuryDesignation      `protobuf:"varint,10,opt,name=designation,proto3,enum=v1.InjuryDesignation" json:"designation,omitempty"`
+	GamesMissed    int32                  `protobuf:"varint,11,opt,name=games_missed,json=gamesMissed,proto3" json:"games_missed,omitempty"` // 受伤后球队已结束的比赛中未出场的场次
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InjuryResponse) Reset() {
+	*x = InjuryResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InjuryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjuryResponse) ProtoMessage() {}
+
+func (x *InjuryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjuryResponse.ProtoReflect.Descriptor instead.
+func (*InjuryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InjuryResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InjuryResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *InjuryResponse) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *InjuryResponse) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *InjuryResponse) GetBodyPart() string {
+	if x != nil {
+		return x.BodyPart
+	}
+	return ""
+}
+
+func (x *InjuryResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InjuryResponse) GetInjuryDate() string {
+	if x != nil {
+		return x.InjuryDate
+	}
+	return ""
+}
+
+func (x *InjuryResponse) GetExpectedReturn() string {
+	if x != nil {
+		return x.ExpectedReturn
+	}
+	return ""
+}
+
+func (x *InjuryResponse) GetReturnedDate() string {
+	if x != nil {
+		return x.ReturnedDate
+	}
+	return ""
+}
+
+func (x *InjuryResponse) GetDesignation() InjuryDesignation {
+	if x != nil {
+		return x.Designation
+	}
+	return InjuryDesignation_DESIGNATION_UNKNOWN
+}
+
+func (x *InjuryResponse) GetGamesMissed() int32 {
+	if x != nil {
+		return x.GamesMissed
+	}
+	return 0
+}
+
+// 伤病报告请求
+type GetInjuryReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                    // 默认当天
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // 可选, 只看一支球队
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInjuryReportRequest) Reset() {
+	*x = GetInjuryReportRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInjuryReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInjuryReportRequest) ProtoMessage() {}
+
+func (x *GetInjuryReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInjuryReportRequest.ProtoReflect.Descriptor instead.
+func (*GetInjuryReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetInjuryReportRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetInjuryReportRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+// 伤病报告响应
+type InjuryReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Injuries      []*InjuryResponse      `protobuf:"bytes,2,rep,name=injuries,proto3" json:"injuries,omitempty"` // 按球队、出战状态排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InjuryReportResponse) Reset() {
+	*x = InjuryReportResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InjuryReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjuryReportResponse) ProtoMessage() {}
+
+func (x *InjuryReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjuryReportResponse.ProtoReflect.Descriptor instead.
+func (*InjuryReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InjuryReportResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *InjuryReportResponse) GetInjuries() []*InjuryResponse {
+	if x != nil {
+		return x.Injuries
+	}
+	return nil
+}
+
+// 新建选秀请求
+type CreateDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`                                     // 选秀年份, 如 2026
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`                                  // 决定顺位的常规赛赛季, 默认为 year 之前的赛季 (2026 对应 "2025-26")
+	Rounds        int32                  `protobuf:"varint,3,opt,name=rounds,proto3" json:"rounds,omitempty"`                                 // 轮数, 默认 2
+	LotteryTeams  int32                  `protobuf:"varint,4,opt,name=lottery_teams,json=lotteryTeams,proto3" json:"lottery_teams,omitempty"` // 参加抽签的球队数 (战绩最差的若干队), 默认 14
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDraftRequest) Reset() {
+	*x = CreateDraftRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDraftRequest) ProtoMessage() {}
+
+func (x *CreateDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDraftRequest.ProtoReflect.Descriptor instead.
+func (*CreateDraftRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateDraftRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CreateDraftRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *CreateDraftRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *CreateDraftRequest) GetLotteryTeams() int32 {
+	if x != nil {
+		return x.LotteryTeams
+	}
+	return 0
+}
+
+// 乐透抽签请求
+type RunDraftLotteryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"` // 抽签种子, 0 表示随机生成; 相同的种子和战绩得到相同的结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDraftLotteryRequest) Reset() {
+	*x = RunDraftLotteryRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunDraftLotteryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDraftLotteryRequest) ProtoMessage() {}
+
+func (x *RunDraftLotteryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDraftLotteryRequest.ProtoReflect.Descriptor instead.
+func (*RunDraftLotteryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RunDraftLotteryRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *RunDraftLotteryRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// 新增新秀请求
+type AddProspectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"` // 参加的选秀年份
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position      Position               `protobuf:"varint,3,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`
+	Height        float64                `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Birthday      string                 `protobuf:"bytes,6,opt,name=birthday,proto3" json:"birthday,omitempty"` // YYYY-MM-DD, 可为空
+	School        string                 `protobuf:"bytes,7,opt,name=school,proto3" json:"school,omitempty"`     // 大学/俱乐部
+	Rank          int32                  `protobuf:"varint,8,opt,name=rank,proto3" json:"rank,omitempty"`        // 选秀大板排名, 0 表示未排名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProspectRequest) Reset() {
+	*x = AddProspectRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProspectRequest) ProtoMessage() {}
+
+func (x *AddProspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProspectRequest.ProtoReflect.Descriptor instead.
+func (*AddProspectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddProspectRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *AddProspectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddProspectRequest) GetPosition() Position {
+	if x != nil {
+		return x.Position
+	}
+	return Position_POSITION_UNKNOWN
+}
+
+func (x *AddProspectRequest) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AddProspectRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *AddProspectRequest) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *AddProspectRequest) GetSchool() string {
+	if x != nil {
+		return x.School
+	}
+	return ""
+}
+
+func (x *AddProspectRequest) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// 新秀响应
+type ProspectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position      Position               `protobuf:"varint,4,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"`
+	Height        float64                `protobuf:"fixed64,5,opt,name=height,proto3" json:"height,omitempty"`
+	Weight        float64                `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Birthday      string                 `protobuf:"bytes,7,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	School        string                 `protobuf:"bytes,8,opt,name=school,proto3" json:"school,omitempty"`
+	Rank          int32                  `protobuf:"varint,9,opt,name=rank,proto3" json:"rank,omitempty"`
+	PickId        int32                  `protobuf:"varint,10,opt,name=pick_id,json=pickId,proto3" json:"pick_id,omitempty"`       // 被选中时使用的选秀权, 0 表示未被选中
+	PlayerId      int32                  `protobuf:"varint,11,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // 被选中后生成的球员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProspectResponse) Reset() {
+	*x = ProspectResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProspectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProspectResponse) ProtoMessage() {}
+
+func (x *ProspectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProspectResponse.ProtoReflect.Descriptor instead.
+func (*ProspectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProspectResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProspectResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ProspectResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProspectResponse) GetPosition() Position {
+	if x != nil {
+		return x.Position
+	}
+	return Position_POSITION_UNKNOWN
+}
+
+func (x *ProspectResponse) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProspectResponse) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProspectResponse) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *ProspectResponse) GetSchool() string {
+	if x != nil {
+		return x.School
+	}
+	return ""
+}
+
+func (x *ProspectResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ProspectResponse) GetPickId() int32 {
+	if x != nil {
+		return x.PickId
+	}
+	return 0
+}
+
+func (x *ProspectResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// 选人请求
+type MakePickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickId        int32                  `protobuf:"varint,1,opt,name=pick_id,json=pickId,proto3" json:"pick_id,omitempty"`
+	ProspectId    int32                  `protobuf:"varint,2,opt,name=prospect_id,json=prospectId,proto3" json:"prospect_id,omitempty"`
+	JerseyNumber  int32                  `protobuf:"varint,3,opt,name=jersey_number,json=jerseyNumber,proto3" json:"jersey_number,omitempty"`
+	RosterSlot    RosterSlot             `protobuf:"varint,4,opt,name=roster_slot,json=rosterSlot,proto3,enum=v1.RosterSlot" json:"roster_slot,omitempty"` // 默认 STANDARD
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`                                                   // 签约生效日期, 默认当天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakePickRequest) Reset() {
+	*x = MakePickRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakePickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakePickRequest) ProtoMessage() {}
+
+func (x *MakePickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakePickRequest.ProtoReflect.Descriptor instead.
+func (*MakePickRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MakePickRequest) GetPickId() int32 {
+	if x != nil {
+		return x.PickId
+	}
+	return 0
+}
+
+func (x *MakePickRequest) GetProspectId() int32 {
+	if x != nil {
+		return x.ProspectId
+	}
+	return 0
+}
+
+func (x *MakePickRequest) GetJerseyNumber() int32 {
+	if x != nil {
+		return x.JerseyNumber
+	}
+	return 0
+}
+
+func (x *MakePickRequest) GetRosterSlot() RosterSlot {
+	if x != nil {
+		return x.RosterSlot
+	}
+	return RosterSlot_ROSTER_SLOT_UNKNOWN
+}
+
+func (x *MakePickRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 选人响应
+type MakePickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pick          *DraftPickEntry        `protobuf:"bytes,1,opt,name=pick,proto3" json:"pick,omitempty"`
+	Player        *PlayerResponse        `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakePickResponse) Reset() {
+	*x = MakePickResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakePickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakePickResponse) ProtoMessage() {}
+
+func (x *MakePickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakePickResponse.ProtoReflect.Descriptor instead.
+func (*MakePickResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MakePickResponse) GetPick() *DraftPickEntry {
+	if x != nil {
+		return x.Pick
+	}
+	return nil
+}
+
+func (x *MakePickResponse) GetPlayer() *PlayerResponse {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+// 选秀看板请求
+type GetDraftBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // 可选, 只看该队持有的选秀权
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDraftBoardRequest) Reset() {
+	*x = GetDraftBoardRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDraftBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftBoardRequest) ProtoMessage() {}
+
+func (x *GetDraftBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetDraftBoardRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetDraftBoardRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetDraftBoardRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+// 选秀权
+type DraftPickEntry struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Year                int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Round               int32                  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Number              int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"` // 总顺位, 抽签前为 0
+	OriginalTeamId      int32                  `protobuf:"varint,5,opt,name=original_team_id,json=originalTeamId,proto3" json:"original_team_id,omitempty"`
+	OwnerTeamId         int32                  `protobuf:"varint,6,opt,name=owner_team_id,json=ownerTeamId,proto3" json:"owner_team_id,omitempty"`
+	Protection          int32                  `protobuf:"varint,7,opt,name=protection,proto3" json:"protection,omitempty"`                                              // 前 N 顺位保护, 0 表示无保护
+	ProtectionTriggered bool                   `protobuf:"varint,8,opt,name=protection_triggered,json=protectionTriggered,proto3" json:"protection_triggered,omitempty"` // 保护生效, 选秀权已回到附加保护的球队
+	LotteryRank         int32                  `protobuf:"varint,9,opt,name=lottery_rank,json=lotteryRank,proto3" json:"lottery_rank,omitempty"`                         // 抽签前排名 (1 为战绩最差), 非乐透选秀权为 0
+	LotteryOdds         float64                `protobuf:"fixed64,10,opt,name=lottery_odds,json=lotteryOdds,proto3" json:"lottery_odds,omitempty"`                       // 抽中状元签的概率 (%)
+	PlayerId            int32                  `protobuf:"varint,11,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                                 // 选中的球员
+	PlayerName          string                 `protobuf:"bytes,12,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	ProtectedByTeamId   int32                  `protobuf:"varint,13,opt,name=protected_by_team_id,json=protectedByTeamId,proto3" json:"protected_by_team_id,omitempty"` // 附加保护的球队 (送出该选秀权时附加保护的一方)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DraftPickEntry) Reset() {
+	*x = DraftPickEntry{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftPickEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftPickEntry) ProtoMessage() {}
+
+func (x *DraftPickEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftPickEntry.ProtoReflect.Descriptor instead.
+func (*DraftPickEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DraftPickEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetOriginalTeamId() int32 {
+	if x != nil {
+		return x.OriginalTeamId
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetOwnerTeamId() int32 {
+	if x != nil {
+		return x.OwnerTeamId
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetProtection() int32 {
+	if x != nil {
+		return x.Protection
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetProtectionTriggered() bool {
+	if x != nil {
+		return x.ProtectionTriggered
+	}
+	return false
+}
+
+func (x *DraftPickEntry) GetLotteryRank() int32 {
+	if x != nil {
+		return x.LotteryRank
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetLotteryOdds() float64 {
+	if x != nil {
+		return x.LotteryOdds
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *DraftPickEntry) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *DraftPickEntry) GetProtectedByTeamId() int32 {
+	if x != nil {
+		return x.ProtectedByTeamId
+	}
+	return 0
+}
+
+// 选秀看板
+type DraftBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	Rounds        int32                  `protobuf:"varint,3,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	LotteryTeams  int32                  `protobuf:"varint,4,opt,name=lottery_teams,json=lotteryTeams,proto3" json:"lottery_teams,omitempty"`
+	LotteryDrawn  bool                   `protobuf:"varint,5,opt,name=lottery_drawn,json=lotteryDrawn,proto3" json:"lottery_drawn,omitempty"`
+	Seed          int64                  `protobuf:"varint,6,opt,name=seed,proto3" json:"seed,omitempty"`                                 // 抽签种子, 可用于复现抽签结果
+	OnTheClock    int32                  `protobuf:"varint,7,opt,name=on_the_clock,json=onTheClock,proto3" json:"on_the_clock,omitempty"` // 当前轮到的选秀权ID, 未抽签或已选完为 0
+	Picks         []*DraftPickEntry      `protobuf:"bytes,8,rep,name=picks,proto3" json:"picks,omitempty"`                                // 按顺位排序, 抽签前按轮次、原属球队排序
+	Prospects     []*ProspectResponse    `protobuf:"bytes,9,rep,name=prospects,proto3" json:"prospects,omitempty"`                        // 尚未被选中的新秀, 按大板排名排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftBoardResponse) Reset() {
+	*x = DraftBoardResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftBoardResponse) ProtoMessage() {}
+
+func (x *DraftBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftBoardResponse.ProtoReflect.Descriptor instead.
+func (*DraftBoardResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DraftBoardResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DraftBoardResponse) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *DraftBoardResponse) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *DraftBoardResponse) GetLotteryTeams() int32 {
+	if x != nil {
+		return x.LotteryTeams
+	}
+	return 0
+}
+
+func (x *DraftBoardResponse) GetLotteryDrawn() bool {
+	if x != nil {
+		return x.LotteryDrawn
+	}
+	return false
+}
+
+func (x *DraftBoardResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *DraftBoardResponse) GetOnTheClock() int32 {
+	if x != nil {
+		return x.OnTheClock
+	}
+	return 0
+}
+
+func (x *DraftBoardResponse) GetPicks() []*DraftPickEntry {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+func (x *DraftBoardResponse) GetProspects() []*ProspectResponse {
+	if x != nil {
+		return x.Prospects
+	}
+	return nil
+}
+
+// 球员效力球队的区间
+type TeamStint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"` // 为空表示至今
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamStint) Reset() {
+	*x = TeamStint{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStint) ProtoMessage() {}
+
+func (x *TeamStint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStint.ProtoReflect.Descriptor instead.
+func (*TeamStint) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TeamStint) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamStint) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *TeamStint) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+// 球员异动记录请求
+type GetPlayerTeamHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // 可选, 返回该日期所在球队
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerTeamHistoryRequest) Reset() {
+	*x = GetPlayerTeamHistoryRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerTeamHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerTeamHistoryRequest) ProtoMessage() {}
+
+func (x *GetPlayerTeamHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerTeamHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerTeamHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPlayerTeamHistoryRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GetPlayerTeamHistoryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 球员异动记录响应
+type PlayerTeamHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Transactions  []*PlayerTransaction   `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"` // 按生效日期升序
+	Stints        []*TeamStint           `protobuf:"bytes,3,rep,name=stints,proto3" json:"stints,omitempty"`
+	TeamId        int32                  `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // date 当天所在球队, 0 表示自由球员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerTeamHistoryResponse) Reset() {
+	*x = PlayerTeamHistoryResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerTeamHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerTeamHistoryResponse) ProtoMessage() {}
+
+func (x *PlayerTeamHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerTeamHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PlayerTeamHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PlayerTeamHistoryResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerTeamHistoryResponse) GetTransactions() []*PlayerTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *PlayerTeamHistoryResponse) GetStints() []*TeamStint {
+	if x != nil {
+		return x.Stints
+	}
+	return nil
+}
+
+func (x *PlayerTeamHistoryResponse) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+// 搜索球员请求
+type SearchPlayersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                         // 关键字: 姓名/拼音/别名, 支持拼写错误
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`        // 按球队过滤
+	Position      Position               `protobuf:"varint,3,opt,name=position,proto3,enum=v1.Position" json:"position,omitempty"` // 按位置过滤
+	Status        PlayerStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=v1.PlayerStatus" json:"status,omitempty"` // 按状态过滤
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`                          // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`  // 每页数量，默认20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPlayersRequest) Reset() {
+	*x = SearchPlayersRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPlayersRequest) ProtoMessage() {}
+
+func (x *SearchPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPlayersRequest.ProtoReflect.Descriptor instead.
+func (*SearchPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchPlayersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPlayersRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *SearchPlayersRequest) GetPosition() Position {
+	if x != nil {
+		return x.Position
+	}
+	return Position_POSITION_UNKNOWN
+}
+
+func (x *SearchPlayersRequest) GetStatus() PlayerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PlayerStatus_STATUS_UNKNOWN
+}
+
+func (x *SearchPlayersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchPlayersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 分面统计
+type FacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FacetBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Facet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // team_id / position / status
+	Buckets       []*FacetBucket         `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Facet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Facet) GetBuckets() []*FacetBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+// 搜索球员响应
+type SearchPlayersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*PlayerResponse      `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Facets        []*Facet               `protobuf:"bytes,5,rep,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPlayersResponse) Reset() {
+	*x = SearchPlayersResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPlayersResponse) ProtoMessage() {}
+
+func (x *SearchPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPlayersResponse.ProtoReflect.Descriptor instead.
+func (*SearchPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SearchPlayersResponse) GetPlayers() []*PlayerResponse {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *SearchPlayersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchPlayersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchPlayersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPlayersResponse) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// --- 球队相关 Message ---
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"` // 赛区归属按该赛季, 为空取当前赛季
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetTeamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetTeamRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+type TeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                 // e.g. Lakers
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`                 // e.g. Los Angeles
+	Abbreviation  string                 `protobuf:"bytes,4,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"` // e.g. LAL
+	Conference    string                 `protobuf:"bytes,5,opt,name=conference,proto3" json:"conference,omitempty"`     // East / West
+	LogoUrl       string                 `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	DivisionId    int32                  `protobuf:"varint,7,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"` // 所属赛区 (按赛季), 0 表示未分配
+	Division      string                 `protobuf:"bytes,8,opt,name=division,proto3" json:"division,omitempty"`                        // e.g. Pacific
+	HomeArena     string                 `protobuf:"bytes,9,opt,name=home_arena,json=homeArena,proto3" json:"home_arena,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamResponse) Reset() {
+	*x = TeamResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamResponse) ProtoMessage() {}
+
+func (x *TeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamResponse.ProtoReflect.Descriptor instead.
+func (*TeamResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TeamResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TeamResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamResponse) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *TeamResponse) GetAbbreviation() string {
+	if x != nil {
+		return x.Abbreviation
+	}
+	return ""
+}
+
+func (x *TeamResponse) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+func (x *TeamResponse) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *TeamResponse) GetDivisionId() int32 {
+	if x != nil {
+		return x.DivisionId
+	}
+	return 0
+}
+
+func (x *TeamResponse) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+func (x *TeamResponse) GetHomeArena() string {
+	if x != nil {
+		return x.HomeArena
+	}
+	return ""
+}
+
+// 创建球队
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. Lakers
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Abbreviation  string                 `protobuf:"bytes,3,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"` // 3 个大写字母, 唯一
+	Conference    string                 `protobuf:"bytes,4,opt,name=conference,proto3" json:"conference,omitempty"`     // East / West
+	LogoUrl       string                 `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	HomeArena     string                 `protobuf:"bytes,6,opt,name=home_arena,json=homeArena,proto3" json:"home_arena,omitempty"`
+	DivisionId    int32                  `protobuf:"varint,7,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"` // 所属赛区, 0 表示暂不分配 (联盟以赛区为准)
+	Season        string                 `protobuf:"bytes,8,opt,name=season,proto3" json:"season,omitempty"`                            // 首个赛季, 为空取当前赛季
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetAbbreviation() string {
+	if x != nil {
+		return x.Abbreviation
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetHomeArena() string {
+	if x != nil {
+		return x.HomeArena
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetDivisionId() int32 {
+	if x != nil {
+		return x.DivisionId
+	}
+	return 0
+}
+
+func (x *CreateTeamRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+// 更新球队, 字段为空/0 表示不修改
+// 名称/城市/缩写/场馆/队徽的变更从 season 起生效, 之前赛季的比赛仍显示原来的信息
+type UpdateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Abbreviation  string                 `protobuf:"bytes,4,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+	Conference    string                 `protobuf:"bytes,5,opt,name=conference,proto3" json:"conference,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	HomeArena     string                 `protobuf:"bytes,7,opt,name=home_arena,json=homeArena,proto3" json:"home_arena,omitempty"`
+	DivisionId    int32                  `protobuf:"varint,8,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	Season        string                 `protobuf:"bytes,9,opt,name=season,proto3" json:"season,omitempty"` // 生效赛季, 为空取当前赛季
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateTeamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetAbbreviation() string {
+	if x != nil {
+		return x.Abbreviation
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetHomeArena() string {
+	if x != nil {
+		return x.HomeArena
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetDivisionId() int32 {
+	if x != nil {
+		return x.DivisionId
+	}
+	return 0
+}
+
+func (x *UpdateTeamRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+// 删除球队 (已有比赛或球员时拒绝)
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteTeamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTeamResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 球队按赛季的展示信息
+type TeamSeasonProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"` // 生效赛季
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Abbreviation  string                 `protobuf:"bytes,4,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	HomeArena     string                 `protobuf:"bytes,6,opt,name=home_arena,json=homeArena,proto3" json:"home_arena,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSeasonProfile) Reset() {
+	*x = TeamSeasonProfile{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSeasonProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSeasonProfile) ProtoMessage() {}
+
+func (x *TeamSeasonProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSeasonProfile.ProtoReflect.Descriptor instead.
+func (*TeamSeasonProfile) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TeamSeasonProfile) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *TeamSeasonProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamSeasonProfile) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *TeamSeasonProfile) GetAbbreviation() string {
+	if x != nil {
+		return x.Abbreviation
+	}
+	return ""
+}
+
+func (x *TeamSeasonProfile) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *TeamSeasonProfile) GetHomeArena() string {
+	if x != nil {
+		return x.HomeArena
+	}
+	return ""
+}
+
+type TeamHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Seasons       []*TeamSeasonProfile   `protobuf:"bytes,2,rep,name=seasons,proto3" json:"seasons,omitempty"` // 按生效赛季升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamHistoryResponse) Reset() {
+	*x = TeamHistoryResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamHistoryResponse) ProtoMessage() {}
+
+func (x *TeamHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamHistoryResponse.ProtoReflect.Descriptor instead.
+func (*TeamHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TeamHistoryResponse) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamHistoryResponse) GetSeasons() []*TeamSeasonProfile {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`         // 赛区归属按该赛季, 为空取当前赛季
+	Conference    string                 `protobuf:"bytes,2,opt,name=conference,proto3" json:"conference,omitempty"` // 按联盟过滤
+	Division      string                 `protobuf:"bytes,3,opt,name=division,proto3" json:"division,omitempty"`     // 按赛区过滤
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListTeamsRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *ListTeamsRequest) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+func (x *ListTeamsRequest) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamResponse        `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*TeamResponse {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// 赛区
+type ListDivisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`         // 球队归属按该赛季, 为空取当前赛季
+	Conference    string                 `protobuf:"bytes,2,opt,name=conference,proto3" json:"conference,omitempty"` // 按联盟过滤
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDivisionsRequest) Reset() {
+	*x = ListDivisionsRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDivisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDivisionsRequest) ProtoMessage() {}
+
+func (x *ListDivisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDivisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDivisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListDivisionsRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *ListDivisionsRequest) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+type ListDivisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Divisions     []*DivisionResponse    `protobuf:"bytes,1,rep,name=divisions,proto3" json:"divisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDivisionsResponse) Reset() {
+	*x = ListDivisionsResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDivisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDivisionsResponse) ProtoMessage() {}
+
+func (x *ListDivisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDivisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDivisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListDivisionsResponse) GetDivisions() []*DivisionResponse {
+	if x != nil {
+		return x.Divisions
+	}
+	return nil
+}
+
+type GetDivisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDivisionRequest) Reset() {
+	*x = GetDivisionRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDivisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDivisionRequest) ProtoMessage() {}
+
+func (x *GetDivisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDivisionRequest.ProtoReflect.Descriptor instead.
+func (*GetDivisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDivisionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetDivisionRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+type DivisionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // e.g. Pacific
+	Conference     string                 `protobuf:"bytes,3,opt,name=conference,proto3" json:"conference,omitempty"`                               // East / West
+	ConferenceName string                 `protobuf:"bytes,4,opt,name=conference_name,json=conferenceName,proto3" json:"conference_name,omitempty"` // e.g. Western Conference
+	Season         string                 `protobuf:"bytes,5,opt,name=season,proto3" json:"season,omitempty"`
+	Teams          []*TeamResponse        `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DivisionResponse) Reset() {
+	*x = DivisionResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DivisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DivisionResponse) ProtoMessage() {}
+
+func (x *DivisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DivisionResponse.ProtoReflect.Descriptor instead.
+func (*DivisionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DivisionResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DivisionResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DivisionResponse) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+func (x *DivisionResponse) GetConferenceName() string {
+	if x != nil {
+		return x.ConferenceName
+	}
+	return ""
+}
+
+func (x *DivisionResponse) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *DivisionResponse) GetTeams() []*TeamResponse {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// 联盟排名请求
+type GetStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`         // e.g. 2023-24 (必填)
+	Conference    string                 `protobuf:"bytes,2,opt,name=conference,proto3" json:"conference,omitempty"` // East / West, 为空返回两个联盟
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStandingsRequest) Reset() {
+	*x = GetStandingsRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsRequest) ProtoMessage() {}
+
+func (x *GetStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetStandingsRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *GetStandingsRequest) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+type StandingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	Standings     []*StandingsEntry      `protobuf:"bytes,2,rep,name=standings,proto3" json:"standings,omitempty"` // 按联盟、名次排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandingsResponse) Reset() {
+	*x = StandingsResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsResponse) ProtoMessage() {}
+
+func (x *StandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsResponse.ProtoReflect.Descriptor instead.
+func (*StandingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StandingsResponse) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *StandingsResponse) GetStandings() []*StandingsEntry {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+// 单队战绩, 战绩字段格式为 "胜-负"
+type StandingsEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Rank             int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // 联盟内排名
+	Team             *TeamResponse          `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	Conference       string                 `protobuf:"bytes,3,opt,name=conference,proto3" json:"conference,omitempty"`
+	Wins             int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses           int32                  `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	WinPct           float64                `protobuf:"fixed64,6,opt,name=win_pct,json=winPct,proto3" json:"win_pct,omitempty"`
+	GamesBehind      float64                `protobuf:"fixed64,7,opt,name=games_behind,json=gamesBehind,proto3" json:"games_behind,omitempty"` // 落后联盟第一的胜场差
+	Home             string                 `protobuf:"bytes,8,opt,name=home,proto3" json:"home,omitempty"`
+	Away             string                 `protobuf:"bytes,9,opt,name=away,proto3" json:"away,omitempty"`
+	ConferenceRecord string                 `protobuf:"bytes,10,opt,name=conference_record,json=conferenceRecord,proto3" json:"conference_record,omitempty"`
+	Last_10          string                 `protobuf:"bytes,11,opt,name=last_10,json=last10,proto3" json:"last_10,omitempty"`
+	Streak           string                 `protobuf:"bytes,12,opt,name=streak,proto3" json:"streak,omitempty"` // e.g. W3 / L2
+	PointsFor        int32                  `protobuf:"varint,13,opt,name=points_for,json=pointsFor,proto3" json:"points_for,omitempty"`
+	PointsAgainst    int32                  `protobuf:"varint,14,opt,name=points_against,json=pointsAgainst,proto3" json:"points_against,omitempty"`
+	Tiebreaker       string                 `protobuf:"bytes,15,opt,name=tiebreaker,proto3" json:"tiebreaker,omitempty"` // 与同胜率球队比较时决定名次的规则
+	Division         string                 `protobuf:"bytes,16,opt,name=division,proto3" json:"division,omitempty"`
+	DivisionRank     int32                  `protobuf:"varint,17,opt,name=division_rank,json=divisionRank,proto3" json:"division_rank,omitempty"` // 赛区内排名
+	DivisionRecord   string                 `protobuf:"bytes,18,opt,name=division_record,json=divisionRecord,proto3" json:"division_record,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StandingsEntry) Reset() {
+	*x = StandingsEntry{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsEntry) ProtoMessage() {}
+
+func (x *StandingsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsEntry.ProtoReflect.Descriptor instead.
+func (*StandingsEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *StandingsEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetTeam() *TeamResponse {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *StandingsEntry) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetWinPct() float64 {
+	if x != nil {
+		return x.WinPct
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetGamesBehind() float64 {
+	if x != nil {
+		return x.GamesBehind
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetHome() string {
+	if x != nil {
+		return x.Home
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetAway() string {
+	if x != nil {
+		return x.Away
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetConferenceRecord() string {
+	if x != nil {
+		return x.ConferenceRecord
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetLast_10() string {
+	if x != nil {
+		return x.Last_10
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetStreak() string {
+	if x != nil {
+		return x.Streak
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetPointsFor() int32 {
+	if x != nil {
+		return x.PointsFor
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetPointsAgainst() int32 {
+	if x != nil {
+		return x.PointsAgainst
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetTiebreaker() string {
+	if x != nil {
+		return x.Tiebreaker
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetDivisionRank() int32 {
+	if x != nil {
+		return x.DivisionRank
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetDivisionRecord() string {
+	if x != nil {
+		return x.DivisionRecord
+	}
+	return ""
+}
+
+// --- 比赛相关 Message ---
+type ListMatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 格式 "2023-11-05"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesRequest) Reset() {
+	*x = ListMatchesRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesRequest) ProtoMessage() {}
+
+func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListMatchesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type MatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	HomeTeamId    int32                  `protobuf:"varint,3,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	VisitorTeamId int32                  `protobuf:"varint,4,opt,name=visitor_team_id,json=visitorTeamId,proto3" json:"visitor_team_id,omitempty"`
+	HomeScore     int32                  `protobuf:"varint,5,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`          // 主队得分
+	VisitorScore  int32                  `protobuf:"varint,6,opt,name=visitor_score,json=visitorScore,proto3" json:"visitor_score,omitempty"` // 客队得分
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`                                 // 0:未开始, 1:进行中, 2:已结束, 3:延期, 4:取消
+	StartTime     string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// 这里直接嵌套 TeamResponse，方便前端显示队名
+	HomeTeam         *TeamResponse  `protobuf:"bytes,9,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
+	VisitorTeam      *TeamResponse  `protobuf:"bytes,10,opt,name=visitor_team,json=visitorTeam,proto3" json:"visitor_team,omitempty"`
+	Period           int32          `protobuf:"varint,11,opt,name=period,proto3" json:"period,omitempty"`                              // 当前节次, 0 未开始, 5 及以上为加时
+	PeriodEnded      bool           `protobuf:"varint,12,opt,name=period_ended,json=periodEnded,proto3" json:"period_ended,omitempty"` // 第4节/加时已结束, 等待加时或终场
+	PostponeReason   string         `protobuf:"bytes,13,opt,name=postpone_reason,json=postponeReason,proto3" json:"postpone_reason,omitempty"`
+	TimeRemaining    string         `protobuf:"bytes,14,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`             // 本节剩余时间 "mm:ss" (查询时刻)
+	ClockRunning     bool           `protobuf:"varint,15,opt,name=clock_running,json=clockRunning,proto3" json:"clock_running,omitempty"`               // 时钟是否在走
+	ClockRemainingMs int64          `protobuf:"varint,16,opt,name=clock_remaining_ms,json=clockRemainingMs,proto3" json:"clock_remaining_ms,omitempty"` // 最近一次启动/暂停时的剩余毫秒数
+	ClockStartedAt   string         `protobuf:"bytes,17,opt,name=clock_started_at,json=clockStartedAt,proto3" json:"clock_started_at,omitempty"`        // 最近一次启动时刻 (RFC3339, 毫秒精度), 客户端可据此本地走表
+	PeriodScores     []*PeriodScore `protobuf:"bytes,18,rep,name=period_scores,json=periodScores,proto3" json:"period_scores,omitempty"`                // 单节比分 (line score), 按节次排序, 含加时
+	Season           string         `protobuf:"bytes,19,opt,name=season,proto3" json:"season,omitempty"`                                                // e.g. 2023-24
+	CancelReason     string         `protobuf:"bytes,20,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MatchResponse) Reset() {
+	*x = MatchResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResponse) ProtoMessage() {}
+
+func (x *MatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResponse.ProtoReflect.Descriptor instead.
+func (*MatchResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MatchResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MatchResponse) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetVisitorTeamId() int32 {
+	if x != nil {
+		return x.VisitorTeamId
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetVisitorScore() int32 {
+	if x != nil {
+		return x.VisitorScore
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MatchResponse) GetHomeTeam() *TeamResponse {
+	if x != nil {
+		return x.HomeTeam
+	}
+	return nil
+}
+
+func (x *MatchResponse) GetVisitorTeam() *TeamResponse {
+	if x != nil {
+		return x.VisitorTeam
+	}
+	return nil
+}
+
+func (x *MatchResponse) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetPeriodEnded() bool {
+	if x != nil {
+		return x.PeriodEnded
+	}
+	return false
+}
+
+func (x *MatchResponse) GetPostponeReason() string {
+	if x != nil {
+		return x.PostponeReason
+	}
+	return ""
+}
+
+func (x *MatchResponse) GetTimeRemaining() string {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return ""
+}
+
+func (x *MatchResponse) GetClockRunning() bool {
+	if x != nil {
+		return x.ClockRunning
+	}
+	return false
+}
+
+func (x *MatchResponse) GetClockRemainingMs() int64 {
+	if x != nil {
+		return x.ClockRemainingMs
+	}
+	return 0
+}
+
+func (x *MatchResponse) GetClockStartedAt() string {
+	if x != nil {
+		return x.ClockStartedAt
+	}
+	return ""
+}
+
+func (x *MatchResponse) GetPeriodScores() []*PeriodScore {
+	if x != nil {
+		return x.PeriodScores
+	}
+	return nil
+}
+
+func (x *MatchResponse) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *MatchResponse) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+// 比赛状态流转
+type MatchTransitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Period        int32                  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`                                   // EndPeriod: 要结束的节次, 与当前节次不一致时拒绝; 0 不校验
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                    // PostponeMatch/CancelMatch: 延期/取消原因
+	TimeRemaining string                 `protobuf:"bytes,4,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"` // ResetClock: 校正后的剩余时间 "mm:ss", 为空时重置为整节时长
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchTransitionRequest) Reset() {
+	*x = MatchTransitionRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTransitionRequest) ProtoMessage() {}
+
+func (x *MatchTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTransitionRequest.ProtoReflect.Descriptor instead.
+func (*MatchTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MatchTransitionRequest) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchTransitionRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *MatchTransitionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MatchTransitionRequest) GetTimeRemaining() string {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return ""
+}
+
+// 新建比赛
+type CreateMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                            // YYYY-MM-DD
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 开赛时间 HH:MM
+	HomeTeamId    int32                  `protobuf:"varint,3,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	VisitorTeamId int32                  `protobuf:"varint,4,opt,name=visitor_team_id,json=visitorTeamId,proto3" json:"visitor_team_id,omitempty"`
+	Season        string                 `protobuf:"bytes,5,opt,name=season,proto3" json:"season,omitempty"` // e.g. 2023-24, 为空时按日期推算
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMatchRequest) Reset() {
+	*x = CreateMatchRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMatchRequest) ProtoMessage() {}
+
+func (x *CreateMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateMatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateMatchRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateMatchRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateMatchRequest) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *CreateMatchRequest) GetVisitorTeamId() int32 {
+	if x != nil {
+		return x.VisitorTeamId
+	}
+	return 0
+}
+
+func (x *CreateMatchRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+// 修改赛程, 字段为空/0 表示不修改; 延期的比赛改期后恢复为未开始
+type UpdateMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	HomeTeamId    int32                  `protobuf:"varint,4,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	VisitorTeamId int32                  `protobuf:"varint,5,opt,name=visitor_team_id,json=visitorTeamId,proto3" json:"visitor_team_id,omitempty"`
+	Season        string                 `protobuf:"bytes,6,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMatchRequest) Reset() {
+	*x = UpdateMatchRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMatchRequest) ProtoMessage() {}
+
+func (x *UpdateMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMatchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateMatchRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMatchRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UpdateMatchRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateMatchRequest) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *UpdateMatchRequest) GetVisitorTeamId() int32 {
+	if x != nil {
+		return x.VisitorTeamId
+	}
+	return 0
+}
+
+func (x *UpdateMatchRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+// 批量导入赛程
+// csv: 首行为表头 date,start_time,home,visitor[,season]; json: 同名字段的对象数组
+// home/visitor 可以是球队ID或缩写 (LAL)
+type ImportScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv / json
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只校验不写入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportScheduleRequest) Reset() {
+	*x = ImportScheduleRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportScheduleRequest) ProtoMessage() {}
+
+func (x *ImportScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ImportScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ImportScheduleRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportScheduleRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportScheduleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 导入结果: 任一行有错误时整批不写入
+type ImportScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Errors        []*ScheduleRowError    `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Matches       []*MatchResponse       `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"` // 写入 (或 dry_run 时将写入) 的比赛
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportScheduleResponse) Reset() {
+	*x = ImportScheduleResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportScheduleResponse) ProtoMessage() {}
+
+func (x *ImportScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ImportScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ImportScheduleResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportScheduleResponse) GetErrors() []*ScheduleRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportScheduleResponse) GetMatches() []*MatchResponse {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ImportScheduleResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ScheduleRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 数据行号, 从 1 开始 (不含表头)
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRowError) Reset() {
+	*x = ScheduleRowError{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRowError) ProtoMessage() {}
+
+func (x *ScheduleRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRowError.ProtoReflect.Descriptor instead.
+func (*ScheduleRowError) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ScheduleRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ScheduleRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 生成常规赛赛程, 数值参数为 0 时使用默认值
+type GenerateScheduleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Season           string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`                                               // 为空时按 start_date 推算
+	StartDate        string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                        // 常规赛首日 YYYY-MM-DD (必填)
+	EndDate          string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                              // 常规赛末日, 默认开始后 25 周
+	GamesPerTeam     int32                  `protobuf:"varint,4,opt,name=games_per_team,json=gamesPerTeam,proto3" json:"games_per_team,omitempty"`            // 每队场次, 默认 82
+	DivisionWeight   float64                `protobuf:"fixed64,5,opt,name=division_weight,json=divisionWeight,proto3" json:"division_weight,omitempty"`       // 对手权重 (相对值): 同赛区, 默认 4
+	ConferenceWeight float64                `protobuf:"fixed64,6,opt,name=conference_weight,json=conferenceWeight,proto3" json:"conference_weight,omitempty"` // 同联盟其他赛区, 默认 3
+	OtherWeight      float64                `protobuf:"fixed64,7,opt,name=other_weight,json=otherWeight,proto3" json:"other_weight,omitempty"`                // 另一联盟, 默认 2
+	StartTime        string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                        // 开赛时间 HH:MM, 默认 19:30
+	MaxGamesPerDay   int32                  `protobuf:"varint,9,opt,name=max_games_per_day,json=maxGamesPerDay,proto3" json:"max_games_per_day,omitempty"`    // 单日场次上限, 默认球队数的一半
+	Seed             int64                  `protobuf:"varint,10,opt,name=seed,proto3" json:"seed,omitempty"`                                                 // 随机种子, 0 时随机生成 (响应中返回, 可复现)
+	DryRun           bool                   `protobuf:"varint,11,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                               // 只生成不写入
+	Divisions        []*TeamDivision        `protobuf:"bytes,12,rep,name=divisions,proto3" json:"divisions,omitempty"`                                        // 覆盖球队所属赛区, 未指定的球队按该赛季的赛区归属
+	ArenaBlackouts   []*ArenaBlackout       `protobuf:"bytes,13,rep,name=arena_blackouts,json=arenaBlackouts,proto3" json:"arena_blackouts,omitempty"`        // 场馆不可用日期
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GenerateScheduleRequest) Reset() {
+	*x = GenerateScheduleRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateScheduleRequest) ProtoMessage() {}
+
+func (x *GenerateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GenerateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GenerateScheduleRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *GenerateScheduleRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GenerateScheduleRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GenerateScheduleRequest) GetGamesPerTeam() int32 {
+	if x != nil {
+		return x.GamesPerTeam
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetDivisionWeight() float64 {
+	if x != nil {
+		return x.DivisionWeight
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetConferenceWeight() float64 {
+	if x != nil {
+		return x.ConferenceWeight
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetOtherWeight() float64 {
+	if x != nil {
+		return x.OtherWeight
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GenerateScheduleRequest) GetMaxGamesPerDay() int32 {
+	if x != nil {
+		return x.MaxGamesPerDay
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GenerateScheduleRequest) GetDivisions() []*TeamDivision {
+	if x != nil {
+		return x.Divisions
+	}
+	return nil
+}
+
+func (x *GenerateScheduleRequest) GetArenaBlackouts() []*ArenaBlackout {
+	if x != nil {
+		return x.ArenaBlackouts
+	}
+	return nil
+}
+
+type TeamDivision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Division      string                 `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamDivision) Reset() {
+	*x = TeamDivision{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamDivision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamDivision) ProtoMessage() {}
+
+func (x *TeamDivision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamDivision.ProtoReflect.Descriptor instead.
+func (*TeamDivision) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TeamDivision) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamDivision) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+type ArenaBlackout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Arena         string                 `protobuf:"bytes,1,opt,name=arena,proto3" json:"arena,omitempty"` // 对应 Team.home_arena
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`   // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArenaBlackout) Reset() {
+	*x = ArenaBlackout{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArenaBlackout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArenaBlackout) ProtoMessage() {}
+
+func (x *ArenaBlackout) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArenaBlackout.ProtoReflect.Descriptor instead.
+func (*ArenaBlackout) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ArenaBlackout) GetArena() string {
+	if x != nil {
+		return x.Arena
+	}
+	return ""
+}
+
+func (x *ArenaBlackout) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 生成结果: 报告有违反约束时不写入
+type GenerateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        string                 `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created       int32                  `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Matches       []*MatchResponse       `protobuf:"bytes,5,rep,name=matches,proto3" json:"matches,omitempty"`
+	Report        *ScheduleReport        `protobuf:"bytes,6,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateScheduleResponse) Reset() {
+	*x = GenerateScheduleResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateScheduleResponse) ProtoMessage() {}
+
+func (x *GenerateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GenerateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GenerateScheduleResponse) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *GenerateScheduleResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GenerateScheduleResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GenerateScheduleResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *GenerateScheduleResponse) GetMatches() []*MatchResponse {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GenerateScheduleResponse) GetReport() *ScheduleReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// 赛程约束报告
+type ScheduleReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"` // 没有违反任何约束
+	TotalGames    int32                  `protobuf:"varint,2,opt,name=total_games,json=totalGames,proto3" json:"total_games,omitempty"`
+	DaysUsed      int32                  `protobuf:"varint,3,opt,name=days_used,json=daysUsed,proto3" json:"days_used,omitempty"` // 有比赛的天数
+	FirstDate     string                 `protobuf:"bytes,4,opt,name=first_date,json=firstDate,proto3" json:"first_date,omitempty"`
+	LastDate      string                 `protobuf:"bytes,5,opt,name=last_date,json=lastDate,proto3" json:"last_date,omitempty"`
+	Teams         []*TeamScheduleSummary `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	Violations    []*ScheduleViolation   `protobuf:"bytes,7,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleReport) Reset() {
+	*x = ScheduleReport{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleReport) ProtoMessage() {}
+
+func (x *ScheduleReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleReport.ProtoReflect.Descriptor instead.
+func (*ScheduleReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ScheduleReport) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *ScheduleReport) GetTotalGames() int32 {
+	if x != nil {
+		return x.TotalGames
+	}
+	return 0
+}
+
+func (x *ScheduleReport) GetDaysUsed() int32 {
+	if x != nil {
+		return x.DaysUsed
+	}
+	return 0
+}
+
+func (x *ScheduleReport) GetFirstDate() string {
+	if x != nil {
+		return x.FirstDate
+	}
+	return ""
+}
+
+func (x *ScheduleReport) GetLastDate() string {
+	if x != nil {
+		return x.LastDate
+	}
+	return ""
+}
+
+func (x *ScheduleReport) GetTeams() []*TeamScheduleSummary {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *ScheduleReport) GetViolations() []*ScheduleViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type TeamScheduleSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TeamId          int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Abbreviation    string                 `protobuf:"bytes,2,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+	Games           int32                  `protobuf:"varint,3,opt,name=games,proto3" json:"games,omitempty"`
+	Home            int32                  `protobuf:"varint,4,opt,name=home,proto3" json:"home,omitempty"`
+	Away            int32                  `protobuf:"varint,5,opt,name=away,proto3" json:"away,omitempty"`
+	DivisionGames   int32                  `protobuf:"varint,6,opt,name=division_games,json=divisionGames,proto3" json:"division_games,omitempty"`
+	ConferenceGames int32                  `protobuf:"varint,7,opt,name=conference_games,json=conferenceGames,proto3" json:"conference_games,omitempty"` // 同联盟其他赛区
+	OtherGames      int32                  `protobuf:"varint,8,opt,name=other_games,json=otherGames,proto3" json:"other_games,omitempty"`                // 另一联盟
+	BackToBacks     int32                  `protobuf:"varint,9,opt,name=back_to_backs,json=backToBacks,proto3" json:"back_to_backs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TeamScheduleSummary) Reset() {
+	*x = TeamScheduleSummary{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScheduleSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScheduleSummary) ProtoMessage() {}
+
+func (x *TeamScheduleSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScheduleSummary.ProtoReflect.Descriptor instead.
+func (*TeamScheduleSummary) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TeamScheduleSummary) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamScheduleSummary) GetAbbreviation() string {
+	if x != nil {
+		return x.Abbreviation
+	}
+	return ""
+}
+
+func (x *TeamScheduleSummary) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *TeamScheduleSummary) GetHome() int32 {
+	if x != nil {
+		return x.Home
+	}
+	return 0
+}
+
+func (x *TeamScheduleSummary) GetAway() int32 {
+	if x != nil {
+		return x.Away
+	}
+	return 0
+}
+
+func (x *TeamScheduleSummary) GetDivisionGames() int32 {
+	if x != nil {
+		return x.DivisionGames
+	}
+	return 0
+}
+
+func (x *TeamScheduleSummary) GetConferenceGames() int32 {
+	if x != nil {
+		return x.ConferenceGames
+	}
+	return 0
+}
+
+func (x *TeamScheduleSummary) GetOtherGames() int32 {
+	if x != nil {
+		return x.OtherGames
+	}
+	return 0
+}
+
+func (x *TeamScheduleSummary) GetBackToBacks() int32 {
+	if x != nil {
+		return x.BackToBacks
+	}
+	return 0
+}
+
+type ScheduleViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Constraint    string                 `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"` // game_count / home_away / back_to_back_to_back / double_booked / arena / date_range / unscheduled
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleViolation) Reset() {
+	*x = ScheduleViolation{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleViolation) ProtoMessage() {}
+
+func (x *ScheduleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleViolation.ProtoReflect.Descriptor instead.
+func (*ScheduleViolation) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ScheduleViolation) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *ScheduleViolation) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ScheduleViolation) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ScheduleViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*MatchResponse       `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesResponse) Reset() {
+	*x = ListMatchesResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesResponse) ProtoMessage() {}
+
+func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListMatchesResponse) GetMatches() []*MatchResponse {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type GetMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchRequest) Reset() {
+	*x = GetMatchRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchRequest) ProtoMessage() {}
+
+func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetMatchRequest) GetId() int64 {
 	if x != nil {
-		return x.Id
+		return x.Id
+	}
+	return 0
+}
+
+// 实时比分推送
+type MatchUpdate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MatchId          int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore        int32                  `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	VisitorScore     int32                  `protobuf:"varint,3,opt,name=visitor_score,json=visitorScore,proto3" json:"visitor_score,omitempty"`
+	Status           int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                                                // 0:未开始, 1:进行中, 2:已结束, 3:延期, 4:取消
+	Quarter          int32                  `protobuf:"varint,5,opt,name=quarter,proto3" json:"quarter,omitempty"`                                              // 当前节次
+	TimeRemaining    string                 `protobuf:"bytes,6,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`              // 当前比赛时钟 e.g. "10:23" (权威时钟)
+	LatestPlay       *PlayByPlay            `protobuf:"bytes,7,opt,name=latest_play,json=latestPlay,proto3" json:"latest_play,omitempty"`                       // 最近一次事件 (快照时为空)
+	UpdatedAt        string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                          // RFC3339
+	ClockRunning     bool                   `protobuf:"varint,9,opt,name=clock_running,json=clockRunning,proto3" json:"clock_running,omitempty"`                // 时钟是否在走, 在走时客户端从 updated_at 开始本地倒计时
+	ClockRemainingMs int64                  `protobuf:"varint,10,opt,name=clock_remaining_ms,json=clockRemainingMs,proto3" json:"clock_remaining_ms,omitempty"` // updated_at 时刻的本节剩余毫秒数
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MatchUpdate) Reset() {
+	*x = MatchUpdate{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchUpdate) ProtoMessage() {}
+
+func (x *MatchUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchUpdate.ProtoReflect.Descriptor instead.
+func (*MatchUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *MatchUpdate) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetVisitorScore() int32 {
+	if x != nil {
+		return x.VisitorScore
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetTimeRemaining() string {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return ""
+}
+
+func (x *MatchUpdate) GetLatestPlay() *PlayByPlay {
+	if x != nil {
+		return x.LatestPlay
+	}
+	return nil
+}
+
+func (x *MatchUpdate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *MatchUpdate) GetClockRunning() bool {
+	if x != nil {
+		return x.ClockRunning
+	}
+	return false
+}
+
+func (x *MatchUpdate) GetClockRemainingMs() int64 {
+	if x != nil {
+		return x.ClockRemainingMs
+	}
+	return 0
+}
+
+// 单条比赛事件 (文字直播)
+type PlayByPlay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TeamId        int32                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Type          EventType              `protobuf:"varint,4,opt,name=type,proto3,enum=v1.EventType" json:"type,omitempty"`
+	ShotType      ShotType               `protobuf:"varint,5,opt,name=shot_type,json=shotType,proto3,enum=v1.ShotType" json:"shot_type,omitempty"`
+	SubType       string                 `protobuf:"bytes,6,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	Value         int32                  `protobuf:"varint,7,opt,name=value,proto3" json:"value,omitempty"`
+	Quarter       int32                  `protobuf:"varint,8,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	TimeRemaining string                 `protobuf:"bytes,9,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	Action        string                 `protobuf:"bytes,10,opt,name=action,proto3" json:"action,omitempty"`                                      // 为空: 普通事件, void: 作废, amend: 更正
+	TargetEventId string                 `protobuf:"bytes,11,opt,name=target_event_id,json=targetEventId,proto3" json:"target_event_id,omitempty"` // 作废/更正的原事件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayByPlay) Reset() {
+	*x = PlayByPlay{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayByPlay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayByPlay) ProtoMessage() {}
+
+func (x *PlayByPlay) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayByPlay.ProtoReflect.Descriptor instead.
+func (*PlayByPlay) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PlayByPlay) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PlayByPlay) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayByPlay) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *PlayByPlay) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNKNOWN
+}
+
+func (x *PlayByPlay) GetShotType() ShotType {
+	if x != nil {
+		return x.ShotType
+	}
+	return ShotType_SHOT_TYPE_UNKNOWN
+}
+
+func (x *PlayByPlay) GetSubType() string {
+	if x != nil {
+		return x.SubType
+	}
+	return ""
+}
+
+func (x *PlayByPlay) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PlayByPlay) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
+}
+
+func (x *PlayByPlay) GetTimeRemaining() string {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return ""
+}
+
+func (x *PlayByPlay) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PlayByPlay) GetTargetEventId() string {
+	if x != nil {
+		return x.TargetEventId
+	}
+	return ""
+}
+
+// --- 事件搜索 ---
+type SearchEventsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Query            string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                                  // 全文匹配补充描述, e.g. "step back"
+	MatchId          int64                  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`                              // 按比赛过滤
+	PlayerId         int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                           // 按球员过滤
+	TeamId           int32                  `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                                 // 按球队过滤
+	Season           string                 `protobuf:"bytes,5,opt,name=season,proto3" json:"season,omitempty"`                                                // 按赛季过滤, e.g. "2023-24"
+	Type             EventType              `protobuf:"varint,6,opt,name=type,proto3,enum=v1.EventType" json:"type,omitempty"`                                 // 按事件类型过滤
+	ShotType         ShotType               `protobuf:"varint,7,opt,name=shot_type,json=shotType,proto3,enum=v1.ShotType" json:"shot_type,omitempty"`          // 按出手方式过滤
+	SubType          string                 `protobuf:"bytes,8,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`                               // 按补充描述精确过滤, e.g. "dunk"
+	Quarter          int32                  `protobuf:"varint,9,opt,name=quarter,proto3" json:"quarter,omitempty"`                                             // 按节次过滤, 5 及以上为加时
+	MinTimeRemaining string                 `protobuf:"bytes,10,opt,name=min_time_remaining,json=minTimeRemaining,proto3" json:"min_time_remaining,omitempty"` // 单节剩余时间下限 (含), "mm:ss"
+	MaxTimeRemaining string                 `protobuf:"bytes,11,opt,name=max_time_remaining,json=maxTimeRemaining,proto3" json:"max_time_remaining,omitempty"` // 单节剩余时间上限 (含), "mm:ss", e.g. "02:00" 表示最后两分钟
+	IncludeVoided    bool                   `protobuf:"varint,12,opt,name=include_voided,json=includeVoided,proto3" json:"include_voided,omitempty"`           // 是否包含已作废的事件
+	Page             int32                  `protobuf:"varint,13,opt,name=page,proto3" json:"page,omitempty"`                                                  // 页码，从1开始
+	PageSize         int32                  `protobuf:"varint,14,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                          // 每页数量，默认20
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchEventsRequest) Reset() {
+	*x = SearchEventsRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEventsRequest) ProtoMessage() {}
+
+func (x *SearchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEventsRequest.ProtoReflect.Descriptor instead.
+func (*SearchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SearchEventsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *SearchEventsRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *SearchEventsRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *SearchEventsRequest) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNKNOWN
+}
+
+func (x *SearchEventsRequest) GetShotType() ShotType {
+	if x != nil {
+		return x.ShotType
+	}
+	return ShotType_SHOT_TYPE_UNKNOWN
+}
+
+func (x *SearchEventsRequest) GetSubType() string {
+	if x != nil {
+		return x.SubType
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
+}
+
+func (x *SearchEventsRequest) GetMinTimeRemaining() string {
+	if x != nil {
+		return x.MinTimeRemaining
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetMaxTimeRemaining() string {
+	if x != nil {
+		return x.MaxTimeRemaining
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetIncludeVoided() bool {
+	if x != nil {
+		return x.IncludeVoided
+	}
+	return false
+}
+
+func (x *SearchEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type EventHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	MatchDate     string                 `protobuf:"bytes,3,opt,name=match_date,json=matchDate,proto3" json:"match_date,omitempty"` // YYYY-MM-DD
+	Play          *PlayByPlay            `protobuf:"bytes,4,opt,name=play,proto3" json:"play,omitempty"`
+	Voided        bool                   `protobuf:"varint,5,opt,name=voided,proto3" json:"voided,omitempty"`
+	EventTime     string                 `protobuf:"bytes,6,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"` // 写入时间 (RFC3339)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventHit) Reset() {
+	*x = EventHit{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHit) ProtoMessage() {}
+
+func (x *EventHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventHit.ProtoReflect.Descriptor instead.
+func (*EventHit) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *EventHit) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *EventHit) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *EventHit) GetMatchDate() string {
+	if x != nil {
+		return x.MatchDate
+	}
+	return ""
+}
+
+func (x *EventHit) GetPlay() *PlayByPlay {
+	if x != nil {
+		return x.Play
+	}
+	return nil
+}
+
+func (x *EventHit) GetVoided() bool {
+	if x != nil {
+		return x.Voided
+	}
+	return false
+}
+
+func (x *EventHit) GetEventTime() string {
+	if x != nil {
+		return x.EventTime
+	}
+	return ""
+}
+
+// 搜索事件响应, facets 包含 sub_type/type/shot_type/quarter/player_id/team_id 的计数
+type SearchEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*EventHit            `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Facets        []*Facet               `protobuf:"bytes,5,rep,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEventsResponse) Reset() {
+	*x = SearchEventsResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEventsResponse) ProtoMessage() {}
+
+func (x *SearchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEventsResponse.ProtoReflect.Descriptor instead.
+func (*SearchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SearchEventsResponse) GetEvents() []*EventHit {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SearchEventsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchEventsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchEventsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchEventsResponse) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// --- 事件上报 (Kafka 生产者用) ---
+type RecordMatchEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`                           // 哪场比赛
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                        // 谁
+	Type          EventType              `protobuf:"varint,3,opt,name=type,proto3,enum=v1.EventType" json:"type,omitempty"`                              // 干了什么
+	Value         int32                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`                                              // 分值 (仅命中事件: 三分 3, 两分 2, 罚球 1)
+	EventTime     string                 `protobuf:"bytes,5,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`                      // 发生时间
+	TeamId        int32                  `protobuf:"varint,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                              // 属于哪个队
+	Quarter       int32                  `protobuf:"varint,7,opt,name=quarter,proto3" json:"quarter,omitempty"`                                          // 第几节 (1-4)
+	SubType       string                 `protobuf:"bytes,8,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`                            // 补充描述 (e.g. "step-back"), 统计口径以 shot_type 为准
+	TimeRemaining string                 `protobuf:"bytes,9,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`          // 剩余时间 (e.g. "10:23")
+	ShotType      ShotType               `protobuf:"varint,10,opt,name=shot_type,json=shotType,proto3,enum=v1.ShotType" json:"shot_type,omitempty"`      // 出手方式 (投篮/罚球事件必填)
+	EventId       string                 `protobuf:"bytes,11,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                           // 客户端生成的事件ID (UUID), 重试时保持不变; 为空则由服务端生成
+	HomeLineup    []int32                `protobuf:"varint,12,rep,packed,name=home_lineup,json=homeLineup,proto3" json:"home_lineup,omitempty"`          // 主队本节首发 5 人 (PERIOD_START 必填, 其它事件不填)
+	VisitorLineup []int32                `protobuf:"varint,13,rep,packed,name=visitor_lineup,json=visitorLineup,proto3" json:"visitor_lineup,omitempty"` // 客队本节首发 5 人 (PERIOD_START 必填, 其它事件不填)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordMatchEventRequest) Reset() {
+	*x = RecordMatchEventRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordMatchEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMatchEventRequest) ProtoMessage() {}
+
+func (x *RecordMatchEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMatchEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordMatchEventRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RecordMatchEventRequest) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *RecordMatchEventRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RecordMatchEventRequest) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNKNOWN
+}
+
+func (x *RecordMatchEventRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *RecordMatchEventRequest) GetEventTime() string {
+	if x != nil {
+		return x.EventTime
+	}
+	return ""
+}
+
+func (x *RecordMatchEventRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *RecordMatchEventRequest) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
+}
+
+func (x *RecordMatchEventRequest) GetSubType() string {
+	if x != nil {
+		return x.SubType
+	}
+	return ""
+}
+
+func (x *RecordMatchEventRequest) GetTimeRemaining() string {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return ""
+}
+
+func (x *RecordMatchEventRequest) GetShotType() ShotType {
+	if x != nil {
+		return x.ShotType
+	}
+	return ShotType_SHOT_TYPE_UNKNOWN
+}
+
+func (x *RecordMatchEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RecordMatchEventRequest) GetHomeLineup() []int32 {
+	if x != nil {
+		return x.HomeLineup
+	}
+	return nil
+}
+
+func (x *RecordMatchEventRequest) GetVisitorLineup() []int32 {
+	if x != nil {
+		return x.VisitorLineup
+	}
+	return nil
+}
+
+type RecordMatchEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // 实际使用的事件ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordMatchEventResponse) Reset() {
+	*x = RecordMatchEventResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordMatchEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMatchEventResponse) ProtoMessage() {}
+
+func (x *RecordMatchEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMatchEventResponse.ProtoReflect.Descriptor instead.
+func (*RecordMatchEventResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RecordMatchEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RecordMatchEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecordMatchEventResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type VoidMatchEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	TargetEventId string                 `protobuf:"bytes,2,opt,name=target_event_id,json=targetEventId,proto3" json:"target_event_id,omitempty"` // 要作废的原事件ID
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                      // 作废原因 (审计用)
+	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                     // 本次作废操作的ID (UUID), 重试时保持不变; 为空则由服务端生成
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidMatchEventRequest) Reset() {
+	*x = VoidMatchEventRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidMatchEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidMatchEventRequest) ProtoMessage() {}
+
+func (x *VoidMatchEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidMatchEventRequest.ProtoReflect.Descriptor instead.
+func (*VoidMatchEventRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *VoidMatchEventRequest) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *VoidMatchEventRequest) GetTargetEventId() string {
+	if x != nil {
+		return x.TargetEventId
+	}
+	return ""
+}
+
+func (x *VoidMatchEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VoidMatchEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type AmendMatchEventRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	TargetEventId string                   `protobuf:"bytes,1,opt,name=target_event_id,json=targetEventId,proto3" json:"target_event_id,omitempty"` // 要更正的原事件ID
+	Corrected     *RecordMatchEventRequest `protobuf:"bytes,2,opt,name=corrected,proto3" json:"corrected,omitempty"`                                // 更正后的事件, 其 event_id 即本次更正操作的ID
+	Reason        string                   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                      // 更正原因 (审计用)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmendMatchEventRequest) Reset() {
+	*x = AmendMatchEventRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendMatchEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendMatchEventRequest) ProtoMessage() {}
+
+func (x *AmendMatchEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendMatchEventRequest.ProtoReflect.Descriptor instead.
+func (*AmendMatchEventRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AmendMatchEventRequest) GetTargetEventId() string {
+	if x != nil {
+		return x.TargetEventId
+	}
+	return ""
+}
+
+func (x *AmendMatchEventRequest) GetCorrected() *RecordMatchEventRequest {
+	if x != nil {
+		return x.Corrected
+	}
+	return nil
+}
+
+func (x *AmendMatchEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// --- 技术统计 (Box Score) ---
+type PlayerStatLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	TeamId        int32                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Fgm           int32                  `protobuf:"varint,5,opt,name=fgm,proto3" json:"fgm,omitempty"`    // 投篮命中
+	Fga           int32                  `protobuf:"varint,6,opt,name=fga,proto3" json:"fga,omitempty"`    // 投篮出手
+	Fg3M          int32                  `protobuf:"varint,7,opt,name=fg3m,proto3" json:"fg3m,omitempty"`  // 三分命中
+	Fg3A          int32                  `protobuf:"varint,8,opt,name=fg3a,proto3" json:"fg3a,omitempty"`  // 三分出手
+	Ftm           int32                  `protobuf:"varint,9,opt,name=ftm,proto3" json:"ftm,omitempty"`    // 罚球命中
+	Fta           int32                  `protobuf:"varint,10,opt,name=fta,proto3" json:"fta,omitempty"`   // 罚球出手
+	Oreb          int32                  `protobuf:"varint,11,opt,name=oreb,proto3" json:"oreb,omitempty"` // 前场篮板
+	Dreb          int32                  `protobuf:"varint,12,opt,name=dreb,proto3" json:"dreb,omitempty"` // 后场篮板
+	Reb           int32                  `protobuf:"varint,13,opt,name=reb,proto3" json:"reb,omitempty"`   // 总篮板
+	Ast           int32                  `protobuf:"varint,14,opt,name=ast,proto3" json:"ast,omitempty"`   // 助攻
+	Stl           int32                  `protobuf:"varint,15,opt,name=stl,proto3" json:"stl,omitempty"`   // 抢断
+	Blk           int32                  `protobuf:"varint,16,opt,name=blk,proto3" json:"blk,omitempty"`   // 盖帽
+	Tov           int32                  `protobuf:"varint,17,opt,name=tov,proto3" json:"tov,omitempty"`   // 失误
+	Pf            int32                  `protobuf:"varint,18,opt,name=pf,proto3" json:"pf,omitempty"`     // 犯规
+	SecondsPlayed int32                  `protobuf:"varint,19,opt,name=seconds_played,json=secondsPlayed,proto3" json:"seconds_played,omitempty"`
+	Minutes       string                 `protobuf:"bytes,20,opt,name=minutes,proto3" json:"minutes,omitempty"` // 上场时间 e.g. "34:12"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerStatLine) Reset() {
+	*x = PlayerStatLine{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStatLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStatLine) ProtoMessage() {}
+
+func (x *PlayerStatLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStatLine.ProtoReflect.Descriptor instead.
+func (*PlayerStatLine) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PlayerStatLine) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerStatLine) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetFgm() int32 {
+	if x != nil {
+		return x.Fgm
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetFga() int32 {
+	if x != nil {
+		return x.Fga
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetFg3M() int32 {
+	if x != nil {
+		return x.Fg3M
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetFg3A() int32 {
+	if x != nil {
+		return x.Fg3A
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetFtm() int32 {
+	if x != nil {
+		return x.Ftm
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetFta() int32 {
+	if x != nil {
+		return x.Fta
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetOreb() int32 {
+	if x != nil {
+		return x.Oreb
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetDreb() int32 {
+	if x != nil {
+		return x.Dreb
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetReb() int32 {
+	if x != nil {
+		return x.Reb
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetAst() int32 {
+	if x != nil {
+		return x.Ast
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetStl() int32 {
+	if x != nil {
+		return x.Stl
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetBlk() int32 {
+	if x != nil {
+		return x.Blk
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetTov() int32 {
+	if x != nil {
+		return x.Tov
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetPf() int32 {
+	if x != nil {
+		return x.Pf
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetSecondsPlayed() int32 {
+	if x != nil {
+		return x.SecondsPlayed
+	}
+	return 0
+}
+
+func (x *PlayerStatLine) GetMinutes() string {
+	if x != nil {
+		return x.Minutes
+	}
+	return ""
+}
+
+type TeamBoxScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Players       []*PlayerStatLine      `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	Totals        *PlayerStatLine        `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals,omitempty"` // 全队合计 (player_id 为 0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamBoxScore) Reset() {
+	*x = TeamBoxScore{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamBoxScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamBoxScore) ProtoMessage() {}
+
+func (x *TeamBoxScore) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamBoxScore.ProtoReflect.Descriptor instead.
+func (*TeamBoxScore) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *TeamBoxScore) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamBoxScore) GetPlayers() []*PlayerStatLine {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *TeamBoxScore) GetTotals() *PlayerStatLine {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type BoxScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Home          *TeamBoxScore          `protobuf:"bytes,2,opt,name=home,proto3" json:"home,omitempty"`
+	Visitor       *TeamBoxScore          `protobuf:"bytes,3,opt,name=visitor,proto3" json:"visitor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoxScoreResponse) Reset() {
+	*x = BoxScoreResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoxScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxScoreResponse) ProtoMessage() {}
+
+func (x *BoxScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxScoreResponse.ProtoReflect.Descriptor instead.
+func (*BoxScoreResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *BoxScoreResponse) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *BoxScoreResponse) GetHome() *TeamBoxScore {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *BoxScoreResponse) GetVisitor() *TeamBoxScore {
+	if x != nil {
+		return x.Visitor
+	}
+	return nil
+}
+
+// --- 比赛归档 (MongoDB) ---
+type PeriodScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        int32                  `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"` // 节次, 5 及以上为加时
+	HomeScore     int32                  `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	VisitorScore  int32                  `protobuf:"varint,3,opt,name=visitor_score,json=visitorScore,proto3" json:"visitor_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodScore) Reset() {
+	*x = PeriodScore{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScore) ProtoMessage() {}
+
+func (x *PeriodScore) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScore.ProtoReflect.Descriptor instead.
+func (*PeriodScore) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PeriodScore) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *PeriodScore) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *PeriodScore) GetVisitorScore() int32 {
+	if x != nil {
+		return x.VisitorScore
 	}
 	return 0
 }
 
-// --- 事件上报 (Kafka 生产者用) ---
-type RecordMatchEventRequest struct {
+type ArchivedPlay struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`                  // 哪场比赛
-	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`               // 谁
-	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`                                       // 干了什么 (1:得分, 2:篮板...)
-	Value         int32                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`                                     // 分值 (3分, 2分, 1个板)
-	EventTime     string                 `protobuf:"bytes,5,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`             // 发生时间
-	TeamId        int32                  `protobuf:"varint,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                     // 属于哪个队
-	Quarter       int32                  `protobuf:"varint,7,opt,name=quarter,proto3" json:"quarter,omitempty"`                                 // 第几节 (1-4)
-	SubType       string                 `protobuf:"bytes,8,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`                   // 子类型 (e.g. "3pt", "dunk")
-	TimeRemaining string                 `protobuf:"bytes,9,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"` // 剩余时间 (e.g. "10:23")
+	Seq           int32                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"` // 比赛进程中的序号, 从1开始
+	Play          *PlayByPlay            `protobuf:"bytes,2,opt,name=play,proto3" json:"play,omitempty"`
+	Voided        bool                   `protobuf:"varint,3,opt,name=voided,proto3" json:"voided,omitempty"`                                     // 已作废 (保留用于审计, 不计入比分)
+	AmendsEventId string                 `protobuf:"bytes,4,opt,name=amends_event_id,json=amendsEventId,proto3" json:"amends_event_id,omitempty"` // 本事件更正的原事件ID
+	HomeScore     int32                  `protobuf:"varint,5,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`              // 该事件之后的比分
+	VisitorScore  int32                  `protobuf:"varint,6,opt,name=visitor_score,json=visitorScore,proto3" json:"visitor_score,omitempty"`
+	EventTime     string                 `protobuf:"bytes,7,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"` // 写入时间 (RFC3339)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordMatchEventRequest) Reset() {
-	*x = RecordMatchEventRequest{}
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[17]
+func (x *ArchivedPlay) Reset() {
+	*x = ArchivedPlay{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordMatchEventRequest) String() string {
+func (x *ArchivedPlay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordMatchEventRequest) ProtoMessage() {}
+func (*ArchivedPlay) ProtoMessage() {}
 
-func (x *RecordMatchEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[17]
+func (x *ArchivedPlay) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,97 +8206,223 @@ func (x *RecordMatchEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordMatchEventRequest.ProtoReflect.Descriptor instead.
-func (*RecordMatchEventRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ArchivedPlay.ProtoReflect.Descriptor instead.
+func (*ArchivedPlay) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *RecordMatchEventRequest) GetMatchId() int64 {
+func (x *ArchivedPlay) GetSeq() int32 {
 	if x != nil {
-		return x.MatchId
+		return x.Seq
 	}
 	return 0
 }
 
-func (x *RecordMatchEventRequest) GetPlayerId() int32 {
+func (x *ArchivedPlay) GetPlay() *PlayByPlay {
 	if x != nil {
-		return x.PlayerId
+		return x.Play
 	}
-	return 0
+	return nil
 }
 
-func (x *RecordMatchEventRequest) GetType() int32 {
+func (x *ArchivedPlay) GetVoided() bool {
 	if x != nil {
-		return x.Type
+		return x.Voided
+	}
+	return false
+}
+
+func (x *ArchivedPlay) GetAmendsEventId() string {
+	if x != nil {
+		return x.AmendsEventId
+	}
+	return ""
+}
+
+func (x *ArchivedPlay) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
 	}
 	return 0
 }
 
-func (x *RecordMatchEventRequest) GetValue() int32 {
+func (x *ArchivedPlay) GetVisitorScore() int32 {
 	if x != nil {
-		return x.Value
+		return x.VisitorScore
 	}
 	return 0
 }
 
-func (x *RecordMatchEventRequest) GetEventTime() string {
+func (x *ArchivedPlay) GetEventTime() string {
 	if x != nil {
 		return x.EventTime
 	}
 	return ""
 }
 
-func (x *RecordMatchEventRequest) GetTeamId() int32 {
+type GameArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Season        string                 `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	HomeTeam      *TeamResponse          `protobuf:"bytes,6,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
+	VisitorTeam   *TeamResponse          `protobuf:"bytes,7,opt,name=visitor_team,json=visitorTeam,proto3" json:"visitor_team,omitempty"`
+	HomeScore     int32                  `protobuf:"varint,8,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"` // 最终比分
+	VisitorScore  int32                  `protobuf:"varint,9,opt,name=visitor_score,json=visitorScore,proto3" json:"visitor_score,omitempty"`
+	PeriodScores  []*PeriodScore         `protobuf:"bytes,10,rep,name=period_scores,json=periodScores,proto3" json:"period_scores,omitempty"`
+	BoxScore      *BoxScoreResponse      `protobuf:"bytes,11,opt,name=box_score,json=boxScore,proto3" json:"box_score,omitempty"`
+	Plays         []*ArchivedPlay        `protobuf:"bytes,12,rep,name=plays,proto3" json:"plays,omitempty"`
+	ArchivedAt    string                 `protobuf:"bytes,13,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameArchiveResponse) Reset() {
+	*x = GameArchiveResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameArchiveResponse) ProtoMessage() {}
+
+func (x *GameArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[96]
 	if x != nil {
-		return x.TeamId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RecordMatchEventRequest) GetQuarter() int32 {
+// Deprecated: Use GameArchiveResponse.ProtoReflect.Descriptor instead.
+func (*GameArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GameArchiveResponse) GetMatchId() int64 {
 	if x != nil {
-		return x.Quarter
+		return x.MatchId
 	}
 	return 0
 }
 
-func (x *RecordMatchEventRequest) GetSubType() string {
+func (x *GameArchiveResponse) GetSeason() string {
 	if x != nil {
-		return x.SubType
+		return x.Season
 	}
 	return ""
 }
 
-func (x *RecordMatchEventRequest) GetTimeRemaining() string {
+func (x *GameArchiveResponse) GetDate() string {
 	if x != nil {
-		return x.TimeRemaining
+		return x.Date
 	}
 	return ""
 }
 
-type RecordMatchEventResponse struct {
+func (x *GameArchiveResponse) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GameArchiveResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GameArchiveResponse) GetHomeTeam() *TeamResponse {
+	if x != nil {
+		return x.HomeTeam
+	}
+	return nil
+}
+
+func (x *GameArchiveResponse) GetVisitorTeam() *TeamResponse {
+	if x != nil {
+		return x.VisitorTeam
+	}
+	return nil
+}
+
+func (x *GameArchiveResponse) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *GameArchiveResponse) GetVisitorScore() int32 {
+	if x != nil {
+		return x.VisitorScore
+	}
+	return 0
+}
+
+func (x *GameArchiveResponse) GetPeriodScores() []*PeriodScore {
+	if x != nil {
+		return x.PeriodScores
+	}
+	return nil
+}
+
+func (x *GameArchiveResponse) GetBoxScore() *BoxScoreResponse {
+	if x != nil {
+		return x.BoxScore
+	}
+	return nil
+}
+
+func (x *GameArchiveResponse) GetPlays() []*ArchivedPlay {
+	if x != nil {
+		return x.Plays
+	}
+	return nil
+}
+
+func (x *GameArchiveResponse) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+// --- 运维相关 Message ---
+type ReplayDeadLettersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 最多重放条数, 0 表示全部
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordMatchEventResponse) Reset() {
-	*x = RecordMatchEventResponse{}
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[18]
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordMatchEventResponse) String() string {
+func (x *ReplayDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordMatchEventResponse) ProtoMessage() {}
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
 
-func (x *RecordMatchEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_nba_service_proto_msgTypes[18]
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,30 +8433,75 @@ func (x *RecordMatchEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordMatchEventResponse.ProtoReflect.Descriptor instead.
-func (*RecordMatchEventResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *RecordMatchEventResponse) GetSuccess() bool {
+func (x *ReplayDeadLettersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Success
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-func (x *RecordMatchEventResponse) GetMessage() string {
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replayed      int32                  `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`   // 本次重放条数
+	Remaining     int64                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"` // 死信队列中剩余未重放条数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_nba_service_proto_msgTypes[98]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_nba_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *ReplayDeadLettersResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
 }
 
 var File_api_proto_v1_nba_service_proto protoreflect.FileDescriptor
 
 const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/proto/v1/nba_service.proto\x12\x02v1\"\x87\x02\n" +
+	"\x1eapi/proto/v1/nba_service.proto\x12\x02v1\"\xc9\x02\n" +
 	"\x13CreatePlayerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
 	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\x12#\n" +
@@ -1454,9 +8510,12 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\x06height\x18\x05 \x01(\x01R\x06height\x12\x16\n" +
 	"\x06weight\x18\x06 \x01(\x01R\x06weight\x12\x1a\n" +
 	"\bbirthday\x18\a \x01(\tR\bbirthday\x12(\n" +
-	"\x06status\x18\b \x01(\x0e2\x10.v1.PlayerStatusR\x06status\"\"\n" +
+	"\x06status\x18\b \x01(\x0e2\x10.v1.PlayerStatusR\x06status\x12\x18\n" +
+	"\aaliases\x18\t \x03(\tR\aaliases\x12&\n" +
+	"\x0fno_trade_clause\x18\n" +
+	" \x01(\bR\rnoTradeClause\"\"\n" +
 	"\x10GetPlayerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x97\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xd9\x02\n" +
 	"\x13UpdatePlayerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -1466,12 +8525,15 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\x06height\x18\x06 \x01(\x01R\x06height\x12\x16\n" +
 	"\x06weight\x18\a \x01(\x01R\x06weight\x12\x1a\n" +
 	"\bbirthday\x18\b \x01(\tR\bbirthday\x12(\n" +
-	"\x06status\x18\t \x01(\x0e2\x10.v1.PlayerStatusR\x06status\"%\n" +
+	"\x06status\x18\t \x01(\x0e2\x10.v1.PlayerStatusR\x06status\x12\x18\n" +
+	"\aaliases\x18\n" +
+	" \x03(\tR\aaliases\x12&\n" +
+	"\x0fno_trade_clause\x18\v \x01(\bR\rnoTradeClause\"%\n" +
 	"\x13DeletePlayerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"J\n" +
 	"\x14DeletePlayerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xf1\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe4\x03\n" +
 	"\x0ePlayerResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\x12\x12\n" +
@@ -1488,7 +8550,11 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\"\xc6\x01\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aaliases\x18\r \x03(\tR\aaliases\x12/\n" +
+	"\vroster_slot\x18\x0e \x01(\x0e2\x0e.v1.RosterSlotR\n" +
+	"rosterSlot\x12&\n" +
+	"\x0fno_trade_clause\x18\x0f \x01(\bR\rnoTradeClause\"\xc6\x01\n" +
 	"\x12ListPlayersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
@@ -1502,9 +8568,272 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"2\n" +
 	"\x17GetPlayersByTeamRequest\x12\x17\n" +
-	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\" \n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\"+\n" +
+	"\x10GetRosterRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\"\x8c\x02\n" +
+	"\x0eRosterResponse\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12,\n" +
+	"\aplayers\x18\x02 \x03(\v2\x12.v1.PlayerResponseR\aplayers\x12%\n" +
+	"\x0estandard_count\x18\x03 \x01(\x05R\rstandardCount\x12\"\n" +
+	"\rtwo_way_count\x18\x04 \x01(\x05R\vtwoWayCount\x12%\n" +
+	"\x0eassigned_count\x18\x05 \x01(\x05R\rassignedCount\x12!\n" +
+	"\fmax_standard\x18\x06 \x01(\x05R\vmaxStandard\x12\x1e\n" +
+	"\vmax_two_way\x18\a \x01(\x05R\tmaxTwoWay\"\xa7\x01\n" +
+	"\x12AddToRosterRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\x12#\n" +
+	"\rjersey_number\x18\x03 \x01(\x05R\fjerseyNumber\x12\"\n" +
+	"\x04slot\x18\x04 \x01(\x0e2\x0e.v1.RosterSlotR\x04slot\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\"]\n" +
+	"\x14ReleasePlayerRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x14\n" +
+	"\x05waive\x18\x02 \x01(\bR\x05waive\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"n\n" +
+	"\x17ChangeRosterSlotRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\"\n" +
+	"\x04slot\x18\x02 \x01(\x0e2\x0e.v1.RosterSlotR\x04slot\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"\xa2\x01\n" +
+	"\n" +
+	"TradeAsset\x12 \n" +
+	"\ffrom_team_id\x18\x01 \x01(\x05R\n" +
+	"fromTeamId\x12\x1c\n" +
+	"\n" +
+	"to_team_id\x18\x02 \x01(\x05R\btoTeamId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\apick_id\x18\x04 \x01(\x05R\x06pickId\x12\x1e\n" +
+	"\n" +
+	"protection\x18\x05 \x01(\x05R\n" +
+	"protection\"\x8d\x01\n" +
+	"\x13TradePlayersRequest\x12&\n" +
+	"\x06assets\x18\x01 \x03(\v2\x0e.v1.TradeAssetR\x06assets\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12&\n" +
+	"\x0fno_trade_waived\x18\x04 \x03(\x05R\rnoTradeWaived\"\xa1\x01\n" +
+	"\x0eTradeViolation\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\x05R\x06teamId\x12\x1b\n" +
+	"\tplayer_id\x18\x04 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\apick_id\x18\x05 \x01(\x05R\x06pickId\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"a\n" +
+	"\x15ValidateTradeResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x122\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x12.v1.TradeViolationR\n" +
+	"violations\"\xec\x01\n" +
+	"\x11PlayerTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x13.v1.TransactionTypeR\x04type\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12 \n" +
+	"\ffrom_team_id\x18\x04 \x01(\x05R\n" +
+	"fromTeamId\x12\x1c\n" +
+	"\n" +
+	"to_team_id\x18\x05 \x01(\x05R\btoTeamId\x12\x12\n" +
+	"\x04date\x18\x06 \x01(\tR\x04date\x12\x19\n" +
+	"\btrade_id\x18\a \x01(\x03R\atradeId\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\"\xa1\x01\n" +
+	"\rTradeResponse\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\x03R\atradeId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12&\n" +
+	"\x06assets\x18\x03 \x03(\v2\x0e.v1.TradeAssetR\x06assets\x129\n" +
+	"\ftransactions\x18\x04 \x03(\v2\x15.v1.PlayerTransactionR\ftransactions\"\x8c\x01\n" +
+	"\x0eContractSeason\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x12\x16\n" +
+	"\x06salary\x18\x02 \x01(\x03R\x06salary\x12\x1e\n" +
+	"\n" +
+	"guaranteed\x18\x03 \x01(\x03R\n" +
+	"guaranteed\x12*\n" +
+	"\x06option\x18\x04 \x01(\x0e2\x12.v1.ContractOptionR\x06option\"\x83\x01\n" +
+	"\x15CreateContractRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1f\n" +
+	"\vsigned_date\x18\x02 \x01(\tR\n" +
+	"signedDate\x12,\n" +
+	"\aseasons\x18\x03 \x03(\v2\x12.v1.ContractSeasonR\aseasons\"7\n" +
+	"\x18GetPlayerContractRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\"\x9a\x02\n" +
+	"\x10ContractResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\x05R\x06teamId\x12\x1f\n" +
+	"\vsigned_date\x18\x04 \x01(\tR\n" +
+	"signedDate\x12\x1f\n" +
+	"\vwaived_date\x18\x05 \x01(\tR\n" +
+	"waivedDate\x12,\n" +
+	"\aseasons\x18\x06 \x03(\v2\x12.v1.ContractSeasonR\aseasons\x12\x1f\n" +
+	"\vtotal_value\x18\a \x01(\x03R\n" +
+	"totalValue\x12/\n" +
+	"\vbird_rights\x18\b \x01(\x0e2\x0e.v1.BirdRightsR\n" +
+	"birdRights\"H\n" +
+	"\x15GetTeamPayrollRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\"\xc6\x01\n" +
+	"\fPayrollEntry\x12\x1f\n" +
+	"\vcontract_id\x18\x01 \x01(\x03R\n" +
+	"contractId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\x12\x17\n" +
+	"\acap_hit\x18\x04 \x01(\x03R\x06capHit\x12*\n" +
+	"\x06option\x18\x05 \x01(\x0e2\x12.v1.ContractOptionR\x06option\x12\x12\n" +
+	"\x04dead\x18\x06 \x01(\bR\x04dead\"\xec\x02\n" +
+	"\x13TeamPayrollResponse\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x1d\n" +
+	"\n" +
+	"salary_cap\x18\x04 \x01(\x03R\tsalaryCap\x12\x1d\n" +
+	"\n" +
+	"luxury_tax\x18\x05 \x01(\x03R\tluxuryTax\x12\x1f\n" +
+	"\vfirst_apron\x18\x06 \x01(\x03R\n" +
+	"firstApron\x12!\n" +
+	"\fsecond_apron\x18\a \x01(\x03R\vsecondApron\x12\x1b\n" +
+	"\tcap_space\x18\b \x01(\x03R\bcapSpace\x12\x1b\n" +
+	"\ttax_space\x18\t \x01(\x03R\btaxSpace\x12&\n" +
+	"\x05level\x18\n" +
+	" \x01(\x0e2\x10.v1.PayrollLevelR\x05level\x12*\n" +
+	"\aentries\x18\v \x03(\v2\x10.v1.PayrollEntryR\aentries\"\xf4\x01\n" +
+	"\x13ReportInjuryRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1b\n" +
+	"\tbody_part\x18\x02 \x01(\tR\bbodyPart\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vinjury_date\x18\x04 \x01(\tR\n" +
+	"injuryDate\x12'\n" +
+	"\x0fexpected_return\x18\x05 \x01(\tR\x0eexpectedReturn\x127\n" +
+	"\vdesignation\x18\x06 \x01(\x0e2\x15.v1.InjuryDesignationR\vdesignation\"\xce\x01\n" +
+	"\x13UpdateInjuryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\vdesignation\x18\x02 \x01(\x0e2\x15.v1.InjuryDesignationR\vdesignation\x12'\n" +
+	"\x0fexpected_return\x18\x03 \x01(\tR\x0eexpectedReturn\x12#\n" +
+	"\rreturned_date\x18\x04 \x01(\tR\freturnedDate\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x81\x03\n" +
+	"\x0eInjuryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\x05R\x06teamId\x12\x1b\n" +
+	"\tbody_part\x18\x05 \x01(\tR\bbodyPart\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vinjury_date\x18\a \x01(\tR\n" +
+	"injuryDate\x12'\n" +
+	"\x0fexpected_return\x18\b \x01(\tR\x0eexpectedReturn\x12#\n" +
+	"\rreturned_date\x18\t \x01(\tR\freturnedDate\x127\n" +
+	"\vdesignation\x18\n" +
+	" \x01(\x0e2\x15.v1.InjuryDesignationR\vdesignation\x12!\n" +
+	"\fgames_missed\x18\v \x01(\x05R\vgamesMissed\"E\n" +
+	"\x16GetInjuryReportRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\"Z\n" +
+	"\x14InjuryReportResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12.\n" +
+	"\binjuries\x18\x02 \x03(\v2\x12.v1.InjuryResponseR\binjuries\"}\n" +
+	"\x12CreateDraftRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\x12\x16\n" +
+	"\x06rounds\x18\x03 \x01(\x05R\x06rounds\x12#\n" +
+	"\rlottery_teams\x18\x04 \x01(\x05R\flotteryTeams\"@\n" +
+	"\x16RunDraftLotteryRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\"\xde\x01\n" +
+	"\x12AddProspectRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\bposition\x18\x03 \x01(\x0e2\f.v1.PositionR\bposition\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x01R\x06height\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12\x1a\n" +
+	"\bbirthday\x18\x06 \x01(\tR\bbirthday\x12\x16\n" +
+	"\x06school\x18\a \x01(\tR\x06school\x12\x12\n" +
+	"\x04rank\x18\b \x01(\x05R\x04rank\"\xa2\x02\n" +
+	"\x10ProspectResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12(\n" +
+	"\bposition\x18\x04 \x01(\x0e2\f.v1.PositionR\bposition\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x01R\x06height\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x01R\x06weight\x12\x1a\n" +
+	"\bbirthday\x18\a \x01(\tR\bbirthday\x12\x16\n" +
+	"\x06school\x18\b \x01(\tR\x06school\x12\x12\n" +
+	"\x04rank\x18\t \x01(\x05R\x04rank\x12\x17\n" +
+	"\apick_id\x18\n" +
+	" \x01(\x05R\x06pickId\x12\x1b\n" +
+	"\tplayer_id\x18\v \x01(\x05R\bplayerId\"\xb5\x01\n" +
+	"\x0fMakePickRequest\x12\x17\n" +
+	"\apick_id\x18\x01 \x01(\x05R\x06pickId\x12\x1f\n" +
+	"\vprospect_id\x18\x02 \x01(\x05R\n" +
+	"prospectId\x12#\n" +
+	"\rjersey_number\x18\x03 \x01(\x05R\fjerseyNumber\x12/\n" +
+	"\vroster_slot\x18\x04 \x01(\x0e2\x0e.v1.RosterSlotR\n" +
+	"rosterSlot\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\"f\n" +
+	"\x10MakePickResponse\x12&\n" +
+	"\x04pick\x18\x01 \x01(\v2\x12.v1.DraftPickEntryR\x04pick\x12*\n" +
+	"\x06player\x18\x02 \x01(\v2\x12.v1.PlayerResponseR\x06player\"C\n" +
+	"\x14GetDraftBoardRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\"\xb8\x03\n" +
+	"\x0eDraftPickEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05round\x18\x03 \x01(\x05R\x05round\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\x12(\n" +
+	"\x10original_team_id\x18\x05 \x01(\x05R\x0eoriginalTeamId\x12\"\n" +
+	"\rowner_team_id\x18\x06 \x01(\x05R\vownerTeamId\x12\x1e\n" +
+	"\n" +
+	"protection\x18\a \x01(\x05R\n" +
+	"protection\x121\n" +
+	"\x14protection_triggered\x18\b \x01(\bR\x13protectionTriggered\x12!\n" +
+	"\flottery_rank\x18\t \x01(\x05R\vlotteryRank\x12!\n" +
+	"\flottery_odds\x18\n" +
+	" \x01(\x01R\vlotteryOdds\x12\x1b\n" +
+	"\tplayer_id\x18\v \x01(\x05R\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\f \x01(\tR\n" +
+	"playerName\x12/\n" +
+	"\x14protected_by_team_id\x18\r \x01(\x05R\x11protectedByTeamId\"\xb6\x02\n" +
+	"\x12DraftBoardResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\x12\x16\n" +
+	"\x06rounds\x18\x03 \x01(\x05R\x06rounds\x12#\n" +
+	"\rlottery_teams\x18\x04 \x01(\x05R\flotteryTeams\x12#\n" +
+	"\rlottery_drawn\x18\x05 \x01(\bR\flotteryDrawn\x12\x12\n" +
+	"\x04seed\x18\x06 \x01(\x03R\x04seed\x12 \n" +
+	"\fon_the_clock\x18\a \x01(\x05R\n" +
+	"onTheClock\x12(\n" +
+	"\x05picks\x18\b \x03(\v2\x12.v1.DraftPickEntryR\x05picks\x122\n" +
+	"\tprospects\x18\t \x03(\v2\x14.v1.ProspectResponseR\tprospects\"Z\n" +
+	"\tTeamStint\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\"N\n" +
+	"\x1bGetPlayerTeamHistoryRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"\xb3\x01\n" +
+	"\x19PlayerTeamHistoryResponse\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x129\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x15.v1.PlayerTransactionR\ftransactions\x12%\n" +
+	"\x06stints\x18\x03 \x03(\v2\r.v1.TeamStintR\x06stints\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\x05R\x06teamId\"\xca\x01\n" +
+	"\x14SearchPlayersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\x12(\n" +
+	"\bposition\x18\x03 \x01(\x0e2\f.v1.PositionR\bposition\x12(\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.v1.PlayerStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"5\n" +
+	"\vFacetBucket\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"H\n" +
+	"\x05Facet\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12)\n" +
+	"\abuckets\x18\x02 \x03(\v2\x0f.v1.FacetBucketR\abuckets\"\xaf\x01\n" +
+	"\x15SearchPlayersResponse\x12,\n" +
+	"\aplayers\x18\x01 \x03(\v2\x12.v1.PlayerResponseR\aplayers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12!\n" +
+	"\x06facets\x18\x05 \x03(\v2\t.v1.FacetR\x06facets\"8\n" +
 	"\x0eGetTeamRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xa5\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\"\x81\x02\n" +
 	"\fTeamResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -1513,12 +8842,117 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\n" +
 	"conference\x18\x05 \x01(\tR\n" +
 	"conference\x12\x19\n" +
-	"\blogo_url\x18\x06 \x01(\tR\alogoUrl\"\x12\n" +
-	"\x10ListTeamsRequest\";\n" +
+	"\blogo_url\x18\x06 \x01(\tR\alogoUrl\x12\x1f\n" +
+	"\vdivision_id\x18\a \x01(\x05R\n" +
+	"divisionId\x12\x1a\n" +
+	"\bdivision\x18\b \x01(\tR\bdivision\x12\x1d\n" +
+	"\n" +
+	"home_arena\x18\t \x01(\tR\thomeArena\"\xf2\x01\n" +
+	"\x11CreateTeamRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\"\n" +
+	"\fabbreviation\x18\x03 \x01(\tR\fabbreviation\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x04 \x01(\tR\n" +
+	"conference\x12\x19\n" +
+	"\blogo_url\x18\x05 \x01(\tR\alogoUrl\x12\x1d\n" +
+	"\n" +
+	"home_arena\x18\x06 \x01(\tR\thomeArena\x12\x1f\n" +
+	"\vdivision_id\x18\a \x01(\x05R\n" +
+	"divisionId\x12\x16\n" +
+	"\x06season\x18\b \x01(\tR\x06season\"\x82\x02\n" +
+	"\x11UpdateTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\"\n" +
+	"\fabbreviation\x18\x04 \x01(\tR\fabbreviation\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x05 \x01(\tR\n" +
+	"conference\x12\x19\n" +
+	"\blogo_url\x18\x06 \x01(\tR\alogoUrl\x12\x1d\n" +
+	"\n" +
+	"home_arena\x18\a \x01(\tR\thomeArena\x12\x1f\n" +
+	"\vdivision_id\x18\b \x01(\x05R\n" +
+	"divisionId\x12\x16\n" +
+	"\x06season\x18\t \x01(\tR\x06season\"#\n" +
+	"\x11DeleteTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"H\n" +
+	"\x12DeleteTeamResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb1\x01\n" +
+	"\x11TeamSeasonProfile\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\"\n" +
+	"\fabbreviation\x18\x04 \x01(\tR\fabbreviation\x12\x19\n" +
+	"\blogo_url\x18\x05 \x01(\tR\alogoUrl\x12\x1d\n" +
+	"\n" +
+	"home_arena\x18\x06 \x01(\tR\thomeArena\"_\n" +
+	"\x13TeamHistoryResponse\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12/\n" +
+	"\aseasons\x18\x02 \x03(\v2\x15.v1.TeamSeasonProfileR\aseasons\"f\n" +
+	"\x10ListTeamsRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x02 \x01(\tR\n" +
+	"conference\x12\x1a\n" +
+	"\bdivision\x18\x03 \x01(\tR\bdivision\";\n" +
 	"\x11ListTeamsResponse\x12&\n" +
-	"\x05teams\x18\x01 \x03(\v2\x10.v1.TeamResponseR\x05teams\"(\n" +
+	"\x05teams\x18\x01 \x03(\v2\x10.v1.TeamResponseR\x05teams\"N\n" +
+	"\x14ListDivisionsRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x02 \x01(\tR\n" +
+	"conference\"K\n" +
+	"\x15ListDivisionsResponse\x122\n" +
+	"\tdivisions\x18\x01 \x03(\v2\x14.v1.DivisionResponseR\tdivisions\"<\n" +
+	"\x12GetDivisionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\"\xbf\x01\n" +
+	"\x10DivisionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x03 \x01(\tR\n" +
+	"conference\x12'\n" +
+	"\x0fconference_name\x18\x04 \x01(\tR\x0econferenceName\x12\x16\n" +
+	"\x06season\x18\x05 \x01(\tR\x06season\x12&\n" +
+	"\x05teams\x18\x06 \x03(\v2\x10.v1.TeamResponseR\x05teams\"M\n" +
+	"\x13GetStandingsRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x02 \x01(\tR\n" +
+	"conference\"]\n" +
+	"\x11StandingsResponse\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x120\n" +
+	"\tstandings\x18\x02 \x03(\v2\x12.v1.StandingsEntryR\tstandings\"\xa8\x04\n" +
+	"\x0eStandingsEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12$\n" +
+	"\x04team\x18\x02 \x01(\v2\x10.v1.TeamResponseR\x04team\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x03 \x01(\tR\n" +
+	"conference\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x05 \x01(\x05R\x06losses\x12\x17\n" +
+	"\awin_pct\x18\x06 \x01(\x01R\x06winPct\x12!\n" +
+	"\fgames_behind\x18\a \x01(\x01R\vgamesBehind\x12\x12\n" +
+	"\x04home\x18\b \x01(\tR\x04home\x12\x12\n" +
+	"\x04away\x18\t \x01(\tR\x04away\x12+\n" +
+	"\x11conference_record\x18\n" +
+	" \x01(\tR\x10conferenceRecord\x12\x17\n" +
+	"\alast_10\x18\v \x01(\tR\x06last10\x12\x16\n" +
+	"\x06streak\x18\f \x01(\tR\x06streak\x12\x1d\n" +
+	"\n" +
+	"points_for\x18\r \x01(\x05R\tpointsFor\x12%\n" +
+	"\x0epoints_against\x18\x0e \x01(\x05R\rpointsAgainst\x12\x1e\n" +
+	"\n" +
+	"tiebreaker\x18\x0f \x01(\tR\n" +
+	"tiebreaker\x12\x1a\n" +
+	"\bdivision\x18\x10 \x01(\tR\bdivision\x12#\n" +
+	"\rdivision_rank\x18\x11 \x01(\x05R\fdivisionRank\x12'\n" +
+	"\x0fdivision_record\x18\x12 \x01(\tR\x0edivisionRecord\"(\n" +
 	"\x12ListMatchesRequest\x12\x12\n" +
-	"\x04date\x18\x01 \x01(\tR\x04date\"\xdc\x02\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\xd7\x05\n" +
 	"\rMatchResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12 \n" +
@@ -1533,25 +8967,274 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"start_time\x18\b \x01(\tR\tstartTime\x12-\n" +
 	"\thome_team\x18\t \x01(\v2\x10.v1.TeamResponseR\bhomeTeam\x123\n" +
 	"\fvisitor_team\x18\n" +
-	" \x01(\v2\x10.v1.TeamResponseR\vvisitorTeam\"B\n" +
+	" \x01(\v2\x10.v1.TeamResponseR\vvisitorTeam\x12\x16\n" +
+	"\x06period\x18\v \x01(\x05R\x06period\x12!\n" +
+	"\fperiod_ended\x18\f \x01(\bR\vperiodEnded\x12'\n" +
+	"\x0fpostpone_reason\x18\r \x01(\tR\x0epostponeReason\x12%\n" +
+	"\x0etime_remaining\x18\x0e \x01(\tR\rtimeRemaining\x12#\n" +
+	"\rclock_running\x18\x0f \x01(\bR\fclockRunning\x12,\n" +
+	"\x12clock_remaining_ms\x18\x10 \x01(\x03R\x10clockRemainingMs\x12(\n" +
+	"\x10clock_started_at\x18\x11 \x01(\tR\x0eclockStartedAt\x124\n" +
+	"\rperiod_scores\x18\x12 \x03(\v2\x0f.v1.PeriodScoreR\fperiodScores\x12\x16\n" +
+	"\x06season\x18\x13 \x01(\tR\x06season\x12#\n" +
+	"\rcancel_reason\x18\x14 \x01(\tR\fcancelReason\"\x8a\x01\n" +
+	"\x16MatchTransitionRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x05R\x06period\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12%\n" +
+	"\x0etime_remaining\x18\x04 \x01(\tR\rtimeRemaining\"\xa9\x01\n" +
+	"\x12CreateMatchRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12 \n" +
+	"\fhome_team_id\x18\x03 \x01(\x05R\n" +
+	"homeTeamId\x12&\n" +
+	"\x0fvisitor_team_id\x18\x04 \x01(\x05R\rvisitorTeamId\x12\x16\n" +
+	"\x06season\x18\x05 \x01(\tR\x06season\"\xb9\x01\n" +
+	"\x12UpdateMatchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12 \n" +
+	"\fhome_team_id\x18\x04 \x01(\x05R\n" +
+	"homeTeamId\x12&\n" +
+	"\x0fvisitor_team_id\x18\x05 \x01(\x05R\rvisitorTeamId\x12\x16\n" +
+	"\x06season\x18\x06 \x01(\tR\x06season\"\\\n" +
+	"\x15ImportScheduleRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xa6\x01\n" +
+	"\x16ImportScheduleResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12,\n" +
+	"\x06errors\x18\x02 \x03(\v2\x14.v1.ScheduleRowErrorR\x06errors\x12+\n" +
+	"\amatches\x18\x03 \x03(\v2\x11.v1.MatchResponseR\amatches\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\">\n" +
+	"\x10ScheduleRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xed\x03\n" +
+	"\x17GenerateScheduleRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12$\n" +
+	"\x0egames_per_team\x18\x04 \x01(\x05R\fgamesPerTeam\x12'\n" +
+	"\x0fdivision_weight\x18\x05 \x01(\x01R\x0edivisionWeight\x12+\n" +
+	"\x11conference_weight\x18\x06 \x01(\x01R\x10conferenceWeight\x12!\n" +
+	"\fother_weight\x18\a \x01(\x01R\votherWeight\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\b \x01(\tR\tstartTime\x12)\n" +
+	"\x11max_games_per_day\x18\t \x01(\x05R\x0emaxGamesPerDay\x12\x12\n" +
+	"\x04seed\x18\n" +
+	" \x01(\x03R\x04seed\x12\x17\n" +
+	"\adry_run\x18\v \x01(\bR\x06dryRun\x12.\n" +
+	"\tdivisions\x18\f \x03(\v2\x10.v1.TeamDivisionR\tdivisions\x12:\n" +
+	"\x0farena_blackouts\x18\r \x03(\v2\x11.v1.ArenaBlackoutR\x0earenaBlackouts\"C\n" +
+	"\fTeamDivision\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\x1a\n" +
+	"\bdivision\x18\x02 \x01(\tR\bdivision\"9\n" +
+	"\rArenaBlackout\x12\x14\n" +
+	"\x05arena\x18\x01 \x01(\tR\x05arena\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"\xd2\x01\n" +
+	"\x18GenerateScheduleResponse\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\tR\x06season\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\x05R\acreated\x12+\n" +
+	"\amatches\x18\x05 \x03(\v2\x11.v1.MatchResponseR\amatches\x12*\n" +
+	"\x06report\x18\x06 \x01(\v2\x12.v1.ScheduleReportR\x06report\"\x80\x02\n" +
+	"\x0eScheduleReport\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x1f\n" +
+	"\vtotal_games\x18\x02 \x01(\x05R\n" +
+	"totalGames\x12\x1b\n" +
+	"\tdays_used\x18\x03 \x01(\x05R\bdaysUsed\x12\x1d\n" +
+	"\n" +
+	"first_date\x18\x04 \x01(\tR\tfirstDate\x12\x1b\n" +
+	"\tlast_date\x18\x05 \x01(\tR\blastDate\x12-\n" +
+	"\x05teams\x18\x06 \x03(\v2\x17.v1.TeamScheduleSummaryR\x05teams\x125\n" +
+	"\n" +
+	"violations\x18\a \x03(\v2\x15.v1.ScheduleViolationR\n" +
+	"violations\"\xa7\x02\n" +
+	"\x13TeamScheduleSummary\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\"\n" +
+	"\fabbreviation\x18\x02 \x01(\tR\fabbreviation\x12\x14\n" +
+	"\x05games\x18\x03 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04home\x18\x04 \x01(\x05R\x04home\x12\x12\n" +
+	"\x04away\x18\x05 \x01(\x05R\x04away\x12%\n" +
+	"\x0edivision_games\x18\x06 \x01(\x05R\rdivisionGames\x12)\n" +
+	"\x10conference_games\x18\a \x01(\x05R\x0fconferenceGames\x12\x1f\n" +
+	"\vother_games\x18\b \x01(\x05R\n" +
+	"otherGames\x12\"\n" +
+	"\rback_to_backs\x18\t \x01(\x05R\vbackToBacks\"z\n" +
+	"\x11ScheduleViolation\x12\x1e\n" +
+	"\n" +
+	"constraint\x18\x01 \x01(\tR\n" +
+	"constraint\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"B\n" +
 	"\x13ListMatchesResponse\x12+\n" +
 	"\amatches\x18\x01 \x03(\v2\x11.v1.MatchResponseR\amatches\"!\n" +
 	"\x0fGetMatchRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x8f\x02\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xe8\x02\n" +
+	"\vMatchUpdate\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12\x1d\n" +
+	"\n" +
+	"home_score\x18\x02 \x01(\x05R\thomeScore\x12#\n" +
+	"\rvisitor_score\x18\x03 \x01(\x05R\fvisitorScore\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x18\n" +
+	"\aquarter\x18\x05 \x01(\x05R\aquarter\x12%\n" +
+	"\x0etime_remaining\x18\x06 \x01(\tR\rtimeRemaining\x12/\n" +
+	"\vlatest_play\x18\a \x01(\v2\x0e.v1.PlayByPlayR\n" +
+	"latestPlay\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12#\n" +
+	"\rclock_running\x18\t \x01(\bR\fclockRunning\x12,\n" +
+	"\x12clock_remaining_ms\x18\n" +
+	" \x01(\x03R\x10clockRemainingMs\"\xdd\x02\n" +
+	"\n" +
+	"PlayByPlay\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\x05R\x06teamId\x12!\n" +
+	"\x04type\x18\x04 \x01(\x0e2\r.v1.EventTypeR\x04type\x12)\n" +
+	"\tshot_type\x18\x05 \x01(\x0e2\f.v1.ShotTypeR\bshotType\x12\x19\n" +
+	"\bsub_type\x18\x06 \x01(\tR\asubType\x12\x14\n" +
+	"\x05value\x18\a \x01(\x05R\x05value\x12\x18\n" +
+	"\aquarter\x18\b \x01(\x05R\aquarter\x12%\n" +
+	"\x0etime_remaining\x18\t \x01(\tR\rtimeRemaining\x12\x16\n" +
+	"\x06action\x18\n" +
+	" \x01(\tR\x06action\x12&\n" +
+	"\x0ftarget_event_id\x18\v \x01(\tR\rtargetEventId\"\xcb\x03\n" +
+	"\x13SearchEventsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\x03R\amatchId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\x05R\x06teamId\x12\x16\n" +
+	"\x06season\x18\x05 \x01(\tR\x06season\x12!\n" +
+	"\x04type\x18\x06 \x01(\x0e2\r.v1.EventTypeR\x04type\x12)\n" +
+	"\tshot_type\x18\a \x01(\x0e2\f.v1.ShotTypeR\bshotType\x12\x19\n" +
+	"\bsub_type\x18\b \x01(\tR\asubType\x12\x18\n" +
+	"\aquarter\x18\t \x01(\x05R\aquarter\x12,\n" +
+	"\x12min_time_remaining\x18\n" +
+	" \x01(\tR\x10minTimeRemaining\x12,\n" +
+	"\x12max_time_remaining\x18\v \x01(\tR\x10maxTimeRemaining\x12%\n" +
+	"\x0einclude_voided\x18\f \x01(\bR\rincludeVoided\x12\x12\n" +
+	"\x04page\x18\r \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x0e \x01(\x05R\bpageSize\"\xb7\x01\n" +
+	"\bEventHit\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\x12\x1d\n" +
+	"\n" +
+	"match_date\x18\x03 \x01(\tR\tmatchDate\x12\"\n" +
+	"\x04play\x18\x04 \x01(\v2\x0e.v1.PlayByPlayR\x04play\x12\x16\n" +
+	"\x06voided\x18\x05 \x01(\bR\x06voided\x12\x1d\n" +
+	"\n" +
+	"event_time\x18\x06 \x01(\tR\teventTime\"\xa6\x01\n" +
+	"\x14SearchEventsResponse\x12$\n" +
+	"\x06events\x18\x01 \x03(\v2\f.v1.EventHitR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12!\n" +
+	"\x06facets\x18\x05 \x03(\v2\t.v1.FacetR\x06facets\"\xac\x03\n" +
 	"\x17RecordMatchEventRequest\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12\x1b\n" +
-	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\x05R\x04type\x12\x14\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12!\n" +
+	"\x04type\x18\x03 \x01(\x0e2\r.v1.EventTypeR\x04type\x12\x14\n" +
 	"\x05value\x18\x04 \x01(\x05R\x05value\x12\x1d\n" +
 	"\n" +
 	"event_time\x18\x05 \x01(\tR\teventTime\x12\x17\n" +
 	"\ateam_id\x18\x06 \x01(\x05R\x06teamId\x12\x18\n" +
 	"\aquarter\x18\a \x01(\x05R\aquarter\x12\x19\n" +
 	"\bsub_type\x18\b \x01(\tR\asubType\x12%\n" +
-	"\x0etime_remaining\x18\t \x01(\tR\rtimeRemaining\"N\n" +
+	"\x0etime_remaining\x18\t \x01(\tR\rtimeRemaining\x12)\n" +
+	"\tshot_type\x18\n" +
+	" \x01(\x0e2\f.v1.ShotTypeR\bshotType\x12\x19\n" +
+	"\bevent_id\x18\v \x01(\tR\aeventId\x12\x1f\n" +
+	"\vhome_lineup\x18\f \x03(\x05R\n" +
+	"homeLineup\x12%\n" +
+	"\x0evisitor_lineup\x18\r \x03(\x05R\rvisitorLineup\"i\n" +
 	"\x18RecordMatchEventResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*G\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\"\x8d\x01\n" +
+	"\x15VoidMatchEventRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12&\n" +
+	"\x0ftarget_event_id\x18\x02 \x01(\tR\rtargetEventId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\bevent_id\x18\x04 \x01(\tR\aeventId\"\x93\x01\n" +
+	"\x16AmendMatchEventRequest\x12&\n" +
+	"\x0ftarget_event_id\x18\x01 \x01(\tR\rtargetEventId\x129\n" +
+	"\tcorrected\x18\x02 \x01(\v2\x1b.v1.RecordMatchEventRequestR\tcorrected\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc2\x03\n" +
+	"\x0ePlayerStatLine\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\x05R\x06teamId\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\x12\x10\n" +
+	"\x03fgm\x18\x05 \x01(\x05R\x03fgm\x12\x10\n" +
+	"\x03fga\x18\x06 \x01(\x05R\x03fga\x12\x12\n" +
+	"\x04fg3m\x18\a \x01(\x05R\x04fg3m\x12\x12\n" +
+	"\x04fg3a\x18\b \x01(\x05R\x04fg3a\x12\x10\n" +
+	"\x03ftm\x18\t \x01(\x05R\x03ftm\x12\x10\n" +
+	"\x03fta\x18\n" +
+	" \x01(\x05R\x03fta\x12\x12\n" +
+	"\x04oreb\x18\v \x01(\x05R\x04oreb\x12\x12\n" +
+	"\x04dreb\x18\f \x01(\x05R\x04dreb\x12\x10\n" +
+	"\x03reb\x18\r \x01(\x05R\x03reb\x12\x10\n" +
+	"\x03ast\x18\x0e \x01(\x05R\x03ast\x12\x10\n" +
+	"\x03stl\x18\x0f \x01(\x05R\x03stl\x12\x10\n" +
+	"\x03blk\x18\x10 \x01(\x05R\x03blk\x12\x10\n" +
+	"\x03tov\x18\x11 \x01(\x05R\x03tov\x12\x0e\n" +
+	"\x02pf\x18\x12 \x01(\x05R\x02pf\x12%\n" +
+	"\x0eseconds_played\x18\x13 \x01(\x05R\rsecondsPlayed\x12\x18\n" +
+	"\aminutes\x18\x14 \x01(\tR\aminutes\"\x81\x01\n" +
+	"\fTeamBoxScore\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12,\n" +
+	"\aplayers\x18\x02 \x03(\v2\x12.v1.PlayerStatLineR\aplayers\x12*\n" +
+	"\x06totals\x18\x03 \x01(\v2\x12.v1.PlayerStatLineR\x06totals\"\x7f\n" +
+	"\x10BoxScoreResponse\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12$\n" +
+	"\x04home\x18\x02 \x01(\v2\x10.v1.TeamBoxScoreR\x04home\x12*\n" +
+	"\avisitor\x18\x03 \x01(\v2\x10.v1.TeamBoxScoreR\avisitor\"i\n" +
+	"\vPeriodScore\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\x05R\x06period\x12\x1d\n" +
+	"\n" +
+	"home_score\x18\x02 \x01(\x05R\thomeScore\x12#\n" +
+	"\rvisitor_score\x18\x03 \x01(\x05R\fvisitorScore\"\xe7\x01\n" +
+	"\fArchivedPlay\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x05R\x03seq\x12\"\n" +
+	"\x04play\x18\x02 \x01(\v2\x0e.v1.PlayByPlayR\x04play\x12\x16\n" +
+	"\x06voided\x18\x03 \x01(\bR\x06voided\x12&\n" +
+	"\x0famends_event_id\x18\x04 \x01(\tR\ramendsEventId\x12\x1d\n" +
+	"\n" +
+	"home_score\x18\x05 \x01(\x05R\thomeScore\x12#\n" +
+	"\rvisitor_score\x18\x06 \x01(\x05R\fvisitorScore\x12\x1d\n" +
+	"\n" +
+	"event_time\x18\a \x01(\tR\teventTime\"\xed\x03\n" +
+	"\x13GameArchiveResponse\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\tR\x06season\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12-\n" +
+	"\thome_team\x18\x06 \x01(\v2\x10.v1.TeamResponseR\bhomeTeam\x123\n" +
+	"\fvisitor_team\x18\a \x01(\v2\x10.v1.TeamResponseR\vvisitorTeam\x12\x1d\n" +
+	"\n" +
+	"home_score\x18\b \x01(\x05R\thomeScore\x12#\n" +
+	"\rvisitor_score\x18\t \x01(\x05R\fvisitorScore\x124\n" +
+	"\rperiod_scores\x18\n" +
+	" \x03(\v2\x0f.v1.PeriodScoreR\fperiodScores\x121\n" +
+	"\tbox_score\x18\v \x01(\v2\x14.v1.BoxScoreResponseR\bboxScore\x12&\n" +
+	"\x05plays\x18\f \x03(\v2\x10.v1.ArchivedPlayR\x05plays\x12\x1f\n" +
+	"\varchived_at\x18\r \x01(\tR\n" +
+	"archivedAt\"0\n" +
+	"\x18ReplayDeadLettersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"U\n" +
+	"\x19ReplayDeadLettersResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\x05R\breplayed\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x03R\tremaining*G\n" +
 	"\bPosition\x12\x14\n" +
 	"\x10POSITION_UNKNOWN\x10\x00\x12\x06\n" +
 	"\x02PG\x10\x01\x12\x06\n" +
@@ -1565,7 +9248,83 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\n" +
 	"\x06ACTIVE\x10\x02\x12\v\n" +
 	"\aINJURED\x10\x03\x12\f\n" +
-	"\bASSIGNED\x10\x042\xb8\x05\n" +
+	"\bASSIGNED\x10\x04*\xa1\x01\n" +
+	"\x0fTransactionType\x12\x17\n" +
+	"\x13TRANSACTION_UNKNOWN\x10\x00\x12\v\n" +
+	"\aSIGNING\x10\x01\x12\t\n" +
+	"\x05TRADE\x10\x02\x12\n" +
+	"\n" +
+	"\x06WAIVER\x10\x03\x12\v\n" +
+	"\aRELEASE\x10\x04\x12\x16\n" +
+	"\x12TWO_WAY_CONVERSION\x10\x05\x12\x17\n" +
+	"\x13G_LEAGUE_ASSIGNMENT\x10\x06\x12\x13\n" +
+	"\x0fG_LEAGUE_RECALL\x10\a*\\\n" +
+	"\x0eContractOption\x12\x0f\n" +
+	"\vOPTION_NONE\x10\x00\x12\x11\n" +
+	"\rPLAYER_OPTION\x10\x01\x12\x0f\n" +
+	"\vTEAM_OPTION\x10\x02\x12\x15\n" +
+	"\x11EARLY_TERMINATION\x10\x03*H\n" +
+	"\n" +
+	"BirdRights\x12\r\n" +
+	"\tBIRD_NONE\x10\x00\x12\f\n" +
+	"\bNON_BIRD\x10\x01\x12\x0e\n" +
+	"\n" +
+	"EARLY_BIRD\x10\x02\x12\r\n" +
+	"\tFULL_BIRD\x10\x03*f\n" +
+	"\fPayrollLevel\x12\r\n" +
+	"\tUNDER_CAP\x10\x00\x12\f\n" +
+	"\bOVER_CAP\x10\x01\x12\f\n" +
+	"\bOVER_TAX\x10\x02\x12\x14\n" +
+	"\x10OVER_FIRST_APRON\x10\x03\x12\x15\n" +
+	"\x11OVER_SECOND_APRON\x10\x04*c\n" +
+	"\x11InjuryDesignation\x12\x17\n" +
+	"\x13DESIGNATION_UNKNOWN\x10\x00\x12\a\n" +
+	"\x03OUT\x10\x01\x12\f\n" +
+	"\bDOUBTFUL\x10\x02\x12\x10\n" +
+	"\fQUESTIONABLE\x10\x03\x12\f\n" +
+	"\bPROBABLE\x10\x04*@\n" +
+	"\n" +
+	"RosterSlot\x12\x17\n" +
+	"\x13ROSTER_SLOT_UNKNOWN\x10\x00\x12\f\n" +
+	"\bSTANDARD\x10\x01\x12\v\n" +
+	"\aTWO_WAY\x10\x02*\x97\x03\n" +
+	"\tEventType\x12\x16\n" +
+	"\x12EVENT_TYPE_UNKNOWN\x10\x00\x12\r\n" +
+	"\tSHOT_MADE\x10\x01\x12\x0f\n" +
+	"\vSHOT_MISSED\x10\x02\x12\x13\n" +
+	"\x0fFREE_THROW_MADE\x10\x03\x12\x15\n" +
+	"\x11FREE_THROW_MISSED\x10\x04\x12\x15\n" +
+	"\x11REBOUND_OFFENSIVE\x10\x05\x12\x15\n" +
+	"\x11REBOUND_DEFENSIVE\x10\x06\x12\n" +
+	"\n" +
+	"\x06ASSIST\x10\a\x12\t\n" +
+	"\x05STEAL\x10\b\x12\t\n" +
+	"\x05BLOCK\x10\t\x12\f\n" +
+	"\bTURNOVER\x10\n" +
+	"\x12\x11\n" +
+	"\rFOUL_PERSONAL\x10\v\x12\x11\n" +
+	"\rFOUL_SHOOTING\x10\f\x12\x12\n" +
+	"\x0eFOUL_OFFENSIVE\x10\r\x12\x12\n" +
+	"\x0eFOUL_TECHNICAL\x10\x0e\x12\x11\n" +
+	"\rFOUL_FLAGRANT\x10\x0f\x12\x13\n" +
+	"\x0fSUBSTITUTION_IN\x10\x10\x12\x14\n" +
+	"\x10SUBSTITUTION_OUT\x10\x11\x12\v\n" +
+	"\aTIMEOUT\x10\x12\x12\r\n" +
+	"\tJUMP_BALL\x10\x13\x12\x10\n" +
+	"\fPERIOD_START\x10\x14\x12\x0e\n" +
+	"\n" +
+	"PERIOD_END\x10\x15*\x83\x01\n" +
+	"\bShotType\x12\x15\n" +
+	"\x11SHOT_TYPE_UNKNOWN\x10\x00\x12\r\n" +
+	"\tJUMP_SHOT\x10\x01\x12\t\n" +
+	"\x05LAYUP\x10\x02\x12\b\n" +
+	"\x04DUNK\x10\x03\x12\r\n" +
+	"\tHOOK_SHOT\x10\x04\x12\n" +
+	"\n" +
+	"\x06TIP_IN\x10\x05\x12\x11\n" +
+	"\rTHREE_POINTER\x10\x06\x12\x0e\n" +
+	"\n" +
+	"FREE_THROW\x10\a2\xa5\x1d\n" +
 	"\n" +
 	"NBAService\x12;\n" +
 	"\fCreatePlayer\x12\x17.v1.CreatePlayerRequest\x1a\x12.v1.PlayerResponse\x125\n" +
@@ -1573,12 +9332,66 @@ const file_api_proto_v1_nba_service_proto_rawDesc = "" +
 	"\fUpdatePlayer\x12\x17.v1.UpdatePlayerRequest\x1a\x12.v1.PlayerResponse\x12A\n" +
 	"\fDeletePlayer\x12\x17.v1.DeletePlayerRequest\x1a\x18.v1.DeletePlayerResponse\x12>\n" +
 	"\vListPlayers\x12\x16.v1.ListPlayersRequest\x1a\x17.v1.ListPlayersResponse\x12H\n" +
-	"\x10GetPlayersByTeam\x12\x1b.v1.GetPlayersByTeamRequest\x1a\x17.v1.ListPlayersResponse\x12/\n" +
+	"\x10GetPlayersByTeam\x12\x1b.v1.GetPlayersByTeamRequest\x1a\x17.v1.ListPlayersResponse\x125\n" +
+	"\tGetRoster\x12\x14.v1.GetRosterRequest\x1a\x12.v1.RosterResponse\x129\n" +
+	"\vAddToRoster\x12\x16.v1.AddToRosterRequest\x1a\x12.v1.PlayerResponse\x12=\n" +
+	"\rReleasePlayer\x12\x18.v1.ReleasePlayerRequest\x1a\x12.v1.PlayerResponse\x12C\n" +
+	"\x10ChangeRosterSlot\x12\x1b.v1.ChangeRosterSlotRequest\x1a\x12.v1.PlayerResponse\x12:\n" +
+	"\fTradePlayers\x12\x17.v1.TradePlayersRequest\x1a\x11.v1.TradeResponse\x12C\n" +
+	"\rValidateTrade\x12\x17.v1.TradePlayersRequest\x1a\x19.v1.ValidateTradeResponse\x12V\n" +
+	"\x14GetPlayerTeamHistory\x12\x1f.v1.GetPlayerTeamHistoryRequest\x1a\x1d.v1.PlayerTeamHistoryResponse\x12A\n" +
+	"\x0eCreateContract\x12\x19.v1.CreateContractRequest\x1a\x14.v1.ContractResponse\x12G\n" +
+	"\x11GetPlayerContract\x12\x1c.v1.GetPlayerContractRequest\x1a\x14.v1.ContractResponse\x12;\n" +
+	"\fReportInjury\x12\x17.v1.ReportInjuryRequest\x1a\x12.v1.InjuryResponse\x12;\n" +
+	"\fUpdateInjury\x12\x17.v1.UpdateInjuryRequest\x1a\x12.v1.InjuryResponse\x12G\n" +
+	"\x0fGetInjuryReport\x12\x1a.v1.GetInjuryReportRequest\x1a\x18.v1.InjuryReportResponse\x12=\n" +
+	"\vCreateDraft\x12\x16.v1.CreateDraftRequest\x1a\x16.v1.DraftBoardResponse\x12E\n" +
+	"\x0fRunDraftLottery\x12\x1a.v1.RunDraftLotteryRequest\x1a\x16.v1.DraftBoardResponse\x12;\n" +
+	"\vAddProspect\x12\x16.v1.AddProspectRequest\x1a\x14.v1.ProspectResponse\x125\n" +
+	"\bMakePick\x12\x13.v1.MakePickRequest\x1a\x14.v1.MakePickResponse\x12A\n" +
+	"\rGetDraftBoard\x12\x18.v1.GetDraftBoardRequest\x1a\x16.v1.DraftBoardResponse\x12D\n" +
+	"\rSearchPlayers\x12\x18.v1.SearchPlayersRequest\x1a\x19.v1.SearchPlayersResponse\x12/\n" +
 	"\aGetTeam\x12\x12.v1.GetTeamRequest\x1a\x10.v1.TeamResponse\x128\n" +
-	"\tListTeams\x12\x14.v1.ListTeamsRequest\x1a\x15.v1.ListTeamsResponse\x12>\n" +
+	"\tListTeams\x12\x14.v1.ListTeamsRequest\x1a\x15.v1.ListTeamsResponse\x125\n" +
+	"\n" +
+	"CreateTeam\x12\x15.v1.CreateTeamRequest\x1a\x10.v1.TeamResponse\x125\n" +
+	"\n" +
+	"UpdateTeam\x12\x15.v1.UpdateTeamRequest\x1a\x10.v1.TeamResponse\x12;\n" +
+	"\n" +
+	"DeleteTeam\x12\x15.v1.DeleteTeamRequest\x1a\x16.v1.DeleteTeamResponse\x12=\n" +
+	"\x0eGetTeamHistory\x12\x12.v1.GetTeamRequest\x1a\x17.v1.TeamHistoryResponse\x12>\n" +
+	"\fGetStandings\x12\x17.v1.GetStandingsRequest\x1a\x15.v1.StandingsResponse\x12D\n" +
+	"\x0eGetTeamPayroll\x12\x19.v1.GetTeamPayrollRequest\x1a\x17.v1.TeamPayrollResponse\x12D\n" +
+	"\rListDivisions\x12\x18.v1.ListDivisionsRequest\x1a\x19.v1.ListDivisionsResponse\x12;\n" +
+	"\vGetDivision\x12\x16.v1.GetDivisionRequest\x1a\x14.v1.DivisionResponse\x12>\n" +
 	"\vListMatches\x12\x16.v1.ListMatchesRequest\x1a\x17.v1.ListMatchesResponse\x122\n" +
-	"\bGetMatch\x12\x13.v1.GetMatchRequest\x1a\x11.v1.MatchResponse\x12M\n" +
-	"\x10RecordMatchEvent\x12\x1b.v1.RecordMatchEventRequest\x1a\x1c.v1.RecordMatchEventResponseB Z\x1enba_service/api/proto/v1;nba_vb\x06proto3"
+	"\bGetMatch\x12\x13.v1.GetMatchRequest\x1a\x11.v1.MatchResponse\x12;\n" +
+	"\n" +
+	"StartMatch\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12:\n" +
+	"\tEndPeriod\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12>\n" +
+	"\rStartOvertime\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12>\n" +
+	"\rFinalizeMatch\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12>\n" +
+	"\rPostponeMatch\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12;\n" +
+	"\n" +
+	"StartClock\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12:\n" +
+	"\tStopClock\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12;\n" +
+	"\n" +
+	"ResetClock\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x128\n" +
+	"\vCreateMatch\x12\x16.v1.CreateMatchRequest\x1a\x11.v1.MatchResponse\x128\n" +
+	"\vUpdateMatch\x12\x16.v1.UpdateMatchRequest\x1a\x11.v1.MatchResponse\x12<\n" +
+	"\vCancelMatch\x12\x1a.v1.MatchTransitionRequest\x1a\x11.v1.MatchResponse\x12G\n" +
+	"\x0eImportSchedule\x12\x19.v1.ImportScheduleRequest\x1a\x1a.v1.ImportScheduleResponse\x12M\n" +
+	"\x10GenerateSchedule\x12\x1b.v1.GenerateScheduleRequest\x1a\x1c.v1.GenerateScheduleResponse\x124\n" +
+	"\n" +
+	"WatchMatch\x12\x13.v1.GetMatchRequest\x1a\x0f.v1.MatchUpdate0\x01\x12M\n" +
+	"\x10RecordMatchEvent\x12\x1b.v1.RecordMatchEventRequest\x1a\x1c.v1.RecordMatchEventResponse\x12I\n" +
+	"\x0eVoidMatchEvent\x12\x19.v1.VoidMatchEventRequest\x1a\x1c.v1.RecordMatchEventResponse\x12K\n" +
+	"\x0fAmendMatchEvent\x12\x1a.v1.AmendMatchEventRequest\x1a\x1c.v1.RecordMatchEventResponse\x12=\n" +
+	"\x10GetMatchBoxScore\x12\x13.v1.GetMatchRequest\x1a\x14.v1.BoxScoreResponse\x12A\n" +
+	"\fSearchEvents\x12\x17.v1.SearchEventsRequest\x1a\x18.v1.SearchEventsResponse\x12>\n" +
+	"\x0eGetGameArchive\x12\x13.v1.GetMatchRequest\x1a\x17.v1.GameArchiveResponse\x12P\n" +
+	"\x11ReplayDeadLetters\x12\x1c.v1.ReplayDeadLettersRequest\x1a\x1d.v1.ReplayDeadLettersResponse\x12<\n" +
+	"\fArchiveMatch\x12\x13.v1.GetMatchRequest\x1a\x17.v1.GameArchiveResponseB Z\x1enba_service/api/proto/v1;nba_vb\x06proto3"
 
 var (
 	file_api_proto_v1_nba_service_proto_rawDescOnce sync.Once
@@ -1592,72 +9405,323 @@ func file_api_proto_v1_nba_service_proto_rawDescGZIP() []byte {
 	return file_api_proto_v1_nba_service_proto_rawDescData
 }
 
-var file_api_proto_v1_nba_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_proto_v1_nba_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_api_proto_v1_nba_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_api_proto_v1_nba_service_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
 var file_api_proto_v1_nba_service_proto_goTypes = []any{
-	(Position)(0),                    // 0: v1.Position
-	(PlayerStatus)(0),                // 1: v1.PlayerStatus
-	(*CreatePlayerRequest)(nil),      // 2: v1.CreatePlayerRequest
-	(*GetPlayerRequest)(nil),         // 3: v1.GetPlayerRequest
-	(*UpdatePlayerRequest)(nil),      // 4: v1.UpdatePlayerRequest
-	(*DeletePlayerRequest)(nil),      // 5: v1.DeletePlayerRequest
-	(*DeletePlayerResponse)(nil),     // 6: v1.DeletePlayerResponse
-	(*PlayerResponse)(nil),           // 7: v1.PlayerResponse
-	(*ListPlayersRequest)(nil),       // 8: v1.ListPlayersRequest
-	(*ListPlayersResponse)(nil),      // 9: v1.ListPlayersResponse
-	(*GetPlayersByTeamRequest)(nil),  // 10: v1.GetPlayersByTeamRequest
-	(*GetTeamRequest)(nil),           // 11: v1.GetTeamRequest
-	(*TeamResponse)(nil),             // 12: v1.TeamResponse
-	(*ListTeamsRequest)(nil),         // 13: v1.ListTeamsRequest
-	(*ListTeamsResponse)(nil),        // 14: v1.ListTeamsResponse
-	(*ListMatchesRequest)(nil),       // 15: v1.ListMatchesRequest
-	(*MatchResponse)(nil),            // 16: v1.MatchResponse
-	(*ListMatchesResponse)(nil),      // 17: v1.ListMatchesResponse
-	(*GetMatchRequest)(nil),          // 18: v1.GetMatchRequest
-	(*RecordMatchEventRequest)(nil),  // 19: v1.RecordMatchEventRequest
-	(*RecordMatchEventResponse)(nil), // 20: v1.RecordMatchEventResponse
+	(Position)(0),                       // 0: v1.Position
+	(PlayerStatus)(0),                   // 1: v1.PlayerStatus
+	(TransactionType)(0),                // 2: v1.TransactionType
+	(ContractOption)(0),                 // 3: v1.ContractOption
+	(BirdRights)(0),                     // 4: v1.BirdRights
+	(PayrollLevel)(0),                   // 5: v1.PayrollLevel
+	(InjuryDesignation)(0),              // 6: v1.InjuryDesignation
+	(RosterSlot)(0),                     // 7: v1.RosterSlot
+	(EventType)(0),                      // 8: v1.EventType
+	(ShotType)(0),                       // 9: v1.ShotType
+	(*CreatePlayerRequest)(nil),         // 10: v1.CreatePlayerRequest
+	(*GetPlayerRequest)(nil),            // 11: v1.GetPlayerRequest
+	(*UpdatePlayerRequest)(nil),         // 12: v1.UpdatePlayerRequest
+	(*DeletePlayerRequest)(nil),         // 13: v1.DeletePlayerRequest
+	(*DeletePlayerResponse)(nil),        // 14: v1.DeletePlayerResponse
+	(*PlayerResponse)(nil),              // 15: v1.PlayerResponse
+	(*ListPlayersRequest)(nil),          // 16: v1.ListPlayersRequest
+	(*ListPlayersResponse)(nil),         // 17: v1.ListPlayersResponse
+	(*GetPlayersByTeamRequest)(nil),     // 18: v1.GetPlayersByTeamRequest
+	(*GetRosterRequest)(nil),            // 19: v1.GetRosterRequest
+	(*RosterResponse)(nil),              // 20: v1.RosterResponse
+	(*AddToRosterRequest)(nil),          // 21: v1.AddToRosterRequest
+	(*ReleasePlayerRequest)(nil),        // 22: v1.ReleasePlayerRequest
+	(*ChangeRosterSlotRequest)(nil),     // 23: v1.ChangeRosterSlotRequest
+	(*TradeAsset)(nil),                  // 24: v1.TradeAsset
+	(*TradePlayersRequest)(nil),         // 25: v1.TradePlayersRequest
+	(*TradeViolation)(nil),              // 26: v1.TradeViolation
+	(*ValidateTradeResponse)(nil),       // 27: v1.ValidateTradeResponse
+	(*PlayerTransaction)(nil),           // 28: v1.PlayerTransaction
+	(*TradeResponse)(nil),               // 29: v1.TradeResponse
+	(*ContractSeason)(nil),              // 30: v1.ContractSeason
+	(*CreateContractRequest)(nil),       // 31: v1.CreateContractRequest
+	(*GetPlayerContractRequest)(nil),    // 32: v1.GetPlayerContractRequest
+	(*ContractResponse)(nil),            // 33: v1.ContractResponse
+	(*GetTeamPayrollRequest)(nil),       // 34: v1.GetTeamPayrollRequest
+	(*PayrollEntry)(nil),                // 35: v1.PayrollEntry
+	(*TeamPayrollResponse)(nil),         // 36: v1.TeamPayrollResponse
+	(*ReportInjuryRequest)(nil),         // 37: v1.ReportInjuryRequest
+	(*UpdateInjuryRequest)(nil),         // 38: v1.UpdateInjuryRequest
+	(*InjuryResponse)(nil),              // 39: v1.InjuryResponse
+	(*GetInjuryReportRequest)(nil),      // 40: v1.GetInjuryReportRequest
+	(*InjuryReportResponse)(nil),        // 41: v1.InjuryReportResponse
+	(*CreateDraftRequest)(nil),          // 42: v1.CreateDraftRequest
+	(*RunDraftLotteryRequest)(nil),      // 43: v1.RunDraftLotteryRequest
+	(*AddProspectRequest)(nil),          // 44: v1.AddProspectRequest
+	(*ProspectResponse)(nil),            // 45: v1.ProspectResponse
+	(*MakePickRequest)(nil),             // 46: v1.MakePickRequest
+	(*MakePickResponse)(nil),            // 47: v1.MakePickResponse
+	(*GetDraftBoardRequest)(nil),        // 48: v1.GetDraftBoardRequest
+	(*DraftPickEntry)(nil),              // 49: v1.DraftPickEntry
+	(*DraftBoardResponse)(nil),          // 50: v1.DraftBoardResponse
+	(*TeamStint)(nil),                   // 51: v1.TeamStint
+	(*GetPlayerTeamHistoryRequest)(nil), // 52: v1.GetPlayerTeamHistoryRequest
+	(*PlayerTeamHistoryResponse)(nil),   // 53: v1.PlayerTeamHistoryResponse
+	(*SearchPlayersRequest)(nil),        // 54: v1.SearchPlayersRequest
+	(*FacetBucket)(nil),                 // 55: v1.FacetBucket
+	(*Facet)(nil),                       // 56: v1.Facet
+	(*SearchPlayersResponse)(nil),       // 57: v1.SearchPlayersResponse
+	(*GetTeamRequest)(nil),              // 58: v1.GetTeamRequest
+	(*TeamResponse)(nil),                // 59: v1.TeamResponse
+	(*CreateTeamRequest)(nil),           // 60: v1.CreateTeamRequest
+	(*UpdateTeamRequest)(nil),           // 61: v1.UpdateTeamRequest
+	(*DeleteTeamRequest)(nil),           // 62: v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),          // 63: v1.DeleteTeamResponse
+	(*TeamSeasonProfile)(nil),           // 64: v1.TeamSeasonProfile
+	(*TeamHistoryResponse)(nil),         // 65: v1.TeamHistoryResponse
+	(*ListTeamsRequest)(nil),            // 66: v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),           // 67: v1.ListTeamsResponse
+	(*ListDivisionsRequest)(nil),        // 68: v1.ListDivisionsRequest
+	(*ListDivisionsResponse)(nil),       // 69: v1.ListDivisionsResponse
+	(*GetDivisionRequest)(nil),          // 70: v1.GetDivisionRequest
+	(*DivisionResponse)(nil),            // 71: v1.DivisionResponse
+	(*GetStandingsRequest)(nil),         // 72: v1.GetStandingsRequest
+	(*StandingsResponse)(nil),           // 73: v1.StandingsResponse
+	(*StandingsEntry)(nil),              // 74: v1.StandingsEntry
+	(*ListMatchesRequest)(nil),          // 75: v1.ListMatchesRequest
+	(*MatchResponse)(nil),               // 76: v1.MatchResponse
+	(*MatchTransitionRequest)(nil),      // 77: v1.MatchTransitionRequest
+	(*CreateMatchRequest)(nil),          // 78: v1.CreateMatchRequest
+	(*UpdateMatchRequest)(nil),          // 79: v1.UpdateMatchRequest
+	(*ImportScheduleRequest)(nil),       // 80: v1.ImportScheduleRequest
+	(*ImportScheduleResponse)(nil),      // 81: v1.ImportScheduleResponse
+	(*ScheduleRowError)(nil),            // 82: v1.ScheduleRowError
+	(*GenerateScheduleRequest)(nil),     // 83: v1.GenerateScheduleRequest
+	(*TeamDivision)(nil),                // 84: v1.TeamDivision
+	(*ArenaBlackout)(nil),               // 85: v1.ArenaBlackout
+	(*GenerateScheduleResponse)(nil),    // 86: v1.GenerateScheduleResponse
+	(*ScheduleReport)(nil),              // 87: v1.ScheduleReport
+	(*TeamScheduleSummary)(nil),         // 88: v1.TeamScheduleSummary
+	(*ScheduleViolation)(nil),           // 89: v1.ScheduleViolation
+	(*ListMatchesResponse)(nil),         // 90: v1.ListMatchesResponse
+	(*GetMatchRequest)(nil),             // 91: v1.GetMatchRequest
+	(*MatchUpdate)(nil),                 // 92: v1.MatchUpdate
+	(*PlayByPlay)(nil),                  // 93: v1.PlayByPlay
+	(*SearchEventsRequest)(nil),         // 94: v1.SearchEventsRequest
+	(*EventHit)(nil),                    // 95: v1.EventHit
+	(*SearchEventsResponse)(nil),        // 96: v1.SearchEventsResponse
+	(*RecordMatchEventRequest)(nil),     // 97: v1.RecordMatchEventRequest
+	(*RecordMatchEventResponse)(nil),    // 98: v1.RecordMatchEventResponse
+	(*VoidMatchEventRequest)(nil),       // 99: v1.VoidMatchEventRequest
+	(*AmendMatchEventRequest)(nil),      // 100: v1.AmendMatchEventRequest
+	(*PlayerStatLine)(nil),              // 101: v1.PlayerStatLine
+	(*TeamBoxScore)(nil),                // 102: v1.TeamBoxScore
+	(*BoxScoreResponse)(nil),            // 103: v1.BoxScoreResponse
+	(*PeriodScore)(nil),                 // 104: v1.PeriodScore
+	(*ArchivedPlay)(nil),                // 105: v1.ArchivedPlay
+	(*GameArchiveResponse)(nil),         // 106: v1.GameArchiveResponse
+	(*ReplayDeadLettersRequest)(nil),    // 107: v1.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),   // 108: v1.ReplayDeadLettersResponse
 }
 var file_api_proto_v1_nba_service_proto_depIdxs = []int32{
-	0,  // 0: v1.CreatePlayerRequest.position:type_name -> v1.Position
-	1,  // 1: v1.CreatePlayerRequest.status:type_name -> v1.PlayerStatus
-	0,  // 2: v1.UpdatePlayerRequest.position:type_name -> v1.Position
-	1,  // 3: v1.UpdatePlayerRequest.status:type_name -> v1.PlayerStatus
-	0,  // 4: v1.PlayerResponse.position:type_name -> v1.Position
-	1,  // 5: v1.PlayerResponse.status:type_name -> v1.PlayerStatus
-	0,  // 6: v1.ListPlayersRequest.position:type_name -> v1.Position
-	1,  // 7: v1.ListPlayersRequest.status:type_name -> v1.PlayerStatus
-	7,  // 8: v1.ListPlayersResponse.players:type_name -> v1.PlayerResponse
-	12, // 9: v1.ListTeamsResponse.teams:type_name -> v1.TeamResponse
-	12, // 10: v1.MatchResponse.home_team:type_name -> v1.TeamResponse
-	12, // 11: v1.MatchResponse.visitor_team:type_name -> v1.TeamResponse
-	16, // 12: v1.ListMatchesResponse.matches:type_name -> v1.MatchResponse
-	2,  // 13: v1.NBAService.CreatePlayer:input_type -> v1.CreatePlayerRequest
-	3,  // 14: v1.NBAService.GetPlayer:input_type -> v1.GetPlayerRequest
-	4,  // 15: v1.NBAService.UpdatePlayer:input_type -> v1.UpdatePlayerRequest
-	5,  // 16: v1.NBAService.DeletePlayer:input_type -> v1.DeletePlayerRequest
-	8,  // 17: v1.NBAService.ListPlayers:input_type -> v1.ListPlayersRequest
-	10, // 18: v1.NBAService.GetPlayersByTeam:input_type -> v1.GetPlayersByTeamRequest
-	11, // 19: v1.NBAService.GetTeam:input_type -> v1.GetTeamRequest
-	13, // 20: v1.NBAService.ListTeams:input_type -> v1.ListTeamsRequest
-	15, // 21: v1.NBAService.ListMatches:input_type -> v1.ListMatchesRequest
-	18, // 22: v1.NBAService.GetMatch:input_type -> v1.GetMatchRequest
-	19, // 23: v1.NBAService.RecordMatchEvent:input_type -> v1.RecordMatchEventRequest
-	7,  // 24: v1.NBAService.CreatePlayer:output_type -> v1.PlayerResponse
-	7,  // 25: v1.NBAService.GetPlayer:output_type -> v1.PlayerResponse
-	7,  // 26: v1.NBAService.UpdatePlayer:output_type -> v1.PlayerResponse
-	6,  // 27: v1.NBAService.DeletePlayer:output_type -> v1.DeletePlayerResponse
-	9,  // 28: v1.NBAService.ListPlayers:output_type -> v1.ListPlayersResponse
-	9,  // 29: v1.NBAService.GetPlayersByTeam:output_type -> v1.ListPlayersResponse
-	12, // 30: v1.NBAService.GetTeam:output_type -> v1.TeamResponse
-	14, // 31: v1.NBAService.ListTeams:output_type -> v1.ListTeamsResponse
-	17, // 32: v1.NBAService.ListMatches:output_type -> v1.ListMatchesResponse
-	16, // 33: v1.NBAService.GetMatch:output_type -> v1.MatchResponse
-	20, // 34: v1.NBAService.RecordMatchEvent:output_type -> v1.RecordMatchEventResponse
-	24, // [24:35] is the sub-list for method output_type
-	13, // [13:24] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	0,   // 0: v1.CreatePlayerRequest.position:type_name -> v1.Position
+	1,   // 1: v1.CreatePlayerRequest.status:type_name -> v1.PlayerStatus
+	0,   // 2: v1.UpdatePlayerRequest.position:type_name -> v1.Position
+	1,   // 3: v1.UpdatePlayerRequest.status:type_name -> v1.PlayerStatus
+	0,   // 4: v1.PlayerResponse.position:type_name -> v1.Position
+	1,   // 5: v1.PlayerResponse.status:type_name -> v1.PlayerStatus
+	7,   // 6: v1.PlayerResponse.roster_slot:type_name -> v1.RosterSlot
+	0,   // 7: v1.ListPlayersRequest.position:type_name -> v1.Position
+	1,   // 8: v1.ListPlayersRequest.status:type_name -> v1.PlayerStatus
+	15,  // 9: v1.ListPlayersResponse.players:type_name -> v1.PlayerResponse
+	15,  // 10: v1.RosterResponse.players:type_name -> v1.PlayerResponse
+	7,   // 11: v1.AddToRosterRequest.slot:type_name -> v1.RosterSlot
+	7,   // 12: v1.ChangeRosterSlotRequest.slot:type_name -> v1.RosterSlot
+	24,  // 13: v1.TradePlayersRequest.assets:type_name -> v1.TradeAsset
+	26,  // 14: v1.ValidateTradeResponse.violations:type_name -> v1.TradeViolation
+	2,   // 15: v1.PlayerTransaction.type:type_name -> v1.TransactionType
+	24,  // 16: v1.TradeResponse.assets:type_name -> v1.TradeAsset
+	28,  // 17: v1.TradeResponse.transactions:type_name -> v1.PlayerTransaction
+	3,   // 18: v1.ContractSeason.option:type_name -> v1.ContractOption
+	30,  // 19: v1.CreateContractRequest.seasons:type_name -> v1.ContractSeason
+	30,  // 20: v1.ContractResponse.seasons:type_name -> v1.ContractSeason
+	4,   // 21: v1.ContractResponse.bird_rights:type_name -> v1.BirdRights
+	3,   // 22: v1.PayrollEntry.option:type_name -> v1.ContractOption
+	5,   // 23: v1.TeamPayrollResponse.level:type_name -> v1.PayrollLevel
+	35,  // 24: v1.TeamPayrollResponse.entries:type_name -> v1.PayrollEntry
+	6,   // 25: v1.ReportInjuryRequest.designation:type_name -> v1.InjuryDesignation
+	6,   // 26: v1.UpdateInjuryRequest.designation:type_name -> v1.InjuryDesignation
+	6,   // 27: v1.InjuryResponse.designation:type_name -> v1.InjuryDesignation
+	39,  // 28: v1.InjuryReportResponse.injuries:type_name -> v1.InjuryResponse
+	0,   // 29: v1.AddProspectRequest.position:type_name -> v1.Position
+	0,   // 30: v1.ProspectResponse.position:type_name -> v1.Position
+	7,   // 31: v1.MakePickRequest.roster_slot:type_name -> v1.RosterSlot
+	49,  // 32: v1.MakePickResponse.pick:type_name -> v1.DraftPickEntry
+	15,  // 33: v1.MakePickResponse.player:type_name -> v1.PlayerResponse
+	49,  // 34: v1.DraftBoardResponse.picks:type_name -> v1.DraftPickEntry
+	45,  // 35: v1.DraftBoardResponse.prospects:type_name -> v1.ProspectResponse
+	28,  // 36: v1.PlayerTeamHistoryResponse.transactions:type_name -> v1.PlayerTransaction
+	51,  // 37: v1.PlayerTeamHistoryResponse.stints:type_name -> v1.TeamStint
+	0,   // 38: v1.SearchPlayersRequest.position:type_name -> v1.Position
+	1,   // 39: v1.SearchPlayersRequest.status:type_name -> v1.PlayerStatus
+	55,  // 40: v1.Facet.buckets:type_name -> v1.FacetBucket
+	15,  // 41: v1.SearchPlayersResponse.players:type_name -> v1.PlayerResponse
+	56,  // 42: v1.SearchPlayersResponse.facets:type_name -> v1.Facet
+	64,  // 43: v1.TeamHistoryResponse.seasons:type_name -> v1.TeamSeasonProfile
+	59,  // 44: v1.ListTeamsResponse.teams:type_name -> v1.TeamResponse
+	71,  // 45: v1.ListDivisionsResponse.divisions:type_name -> v1.DivisionResponse
+	59,  // 46: v1.DivisionResponse.teams:type_name -> v1.TeamResponse
+	74,  // 47: v1.StandingsResponse.standings:type_name -> v1.StandingsEntry
+	59,  // 48: v1.StandingsEntry.team:type_name -> v1.TeamResponse
+	59,  // 49: v1.MatchResponse.home_team:type_name -> v1.TeamResponse
+	59,  // 50: v1.MatchResponse.visitor_team:type_name -> v1.TeamResponse
+	104, // 51: v1.MatchResponse.period_scores:type_name -> v1.PeriodScore
+	82,  // 52: v1.ImportScheduleResponse.errors:type_name -> v1.ScheduleRowError
+	76,  // 53: v1.ImportScheduleResponse.matches:type_name -> v1.MatchResponse
+	84,  // 54: v1.GenerateScheduleRequest.divisions:type_name -> v1.TeamDivision
+	85,  // 55: v1.GenerateScheduleRequest.arena_blackouts:type_name -> v1.ArenaBlackout
+	76,  // 56: v1.GenerateScheduleResponse.matches:type_name -> v1.MatchResponse
+	87,  // 57: v1.GenerateScheduleResponse.report:type_name -> v1.ScheduleReport
+	88,  // 58: v1.ScheduleReport.teams:type_name -> v1.TeamScheduleSummary
+	89,  // 59: v1.ScheduleReport.violations:type_name -> v1.ScheduleViolation
+	76,  // 60: v1.ListMatchesResponse.matches:type_name -> v1.MatchResponse
+	93,  // 61: v1.MatchUpdate.latest_play:type_name -> v1.PlayByPlay
+	8,   // 62: v1.PlayByPlay.type:type_name -> v1.EventType
+	9,   // 63: v1.PlayByPlay.shot_type:type_name -> v1.ShotType
+	8,   // 64: v1.SearchEventsRequest.type:type_name -> v1.EventType
+	9,   // 65: v1.SearchEventsRequest.shot_type:type_name -> v1.ShotType
+	93,  // 66: v1.EventHit.play:type_name -> v1.PlayByPlay
+	95,  // 67: v1.SearchEventsResponse.events:type_name -> v1.EventHit
+	56,  // 68: v1.SearchEventsResponse.facets:type_name -> v1.Facet
+	8,   // 69: v1.RecordMatchEventRequest.type:type_name -> v1.EventType
+	9,   // 70: v1.RecordMatchEventRequest.shot_type:type_name -> v1.ShotType
+	97,  // 71: v1.AmendMatchEventRequest.corrected:type_name -> v1.RecordMatchEventRequest
+	101, // 72: v1.TeamBoxScore.players:type_name -> v1.PlayerStatLine
+	101, // 73: v1.TeamBoxScore.totals:type_name -> v1.PlayerStatLine
+	102, // 74: v1.BoxScoreResponse.home:type_name -> v1.TeamBoxScore
+	102, // 75: v1.BoxScoreResponse.visitor:type_name -> v1.TeamBoxScore
+	93,  // 76: v1.ArchivedPlay.play:type_name -> v1.PlayByPlay
+	59,  // 77: v1.GameArchiveResponse.home_team:type_name -> v1.TeamResponse
+	59,  // 78: v1.GameArchiveResponse.visitor_team:type_name -> v1.TeamResponse
+	104, // 79: v1.GameArchiveResponse.period_scores:type_name -> v1.PeriodScore
+	103, // 80: v1.GameArchiveResponse.box_score:type_name -> v1.BoxScoreResponse
+	105, // 81: v1.GameArchiveResponse.plays:type_name -> v1.ArchivedPlay
+	10,  // 82: v1.NBAService.CreatePlayer:input_type -> v1.CreatePlayerRequest
+	11,  // 83: v1.NBAService.GetPlayer:input_type -> v1.GetPlayerRequest
+	12,  // 84: v1.NBAService.UpdatePlayer:input_type -> v1.UpdatePlayerRequest
+	13,  // 85: v1.NBAService.DeletePlayer:input_type -> v1.DeletePlayerRequest
+	16,  // 86: v1.NBAService.ListPlayers:input_type -> v1.ListPlayersRequest
+	18,  // 87: v1.NBAService.GetPlayersByTeam:input_type -> v1.GetPlayersByTeamRequest
+	19,  // 88: v1.NBAService.GetRoster:input_type -> v1.GetRosterRequest
+	21,  // 89: v1.NBAService.AddToRoster:input_type -> v1.AddToRosterRequest
+	22,  // 90: v1.NBAService.ReleasePlayer:input_type -> v1.ReleasePlayerRequest
+	23,  // 91: v1.NBAService.ChangeRosterSlot:input_type -> v1.ChangeRosterSlotRequest
+	25,  // 92: v1.NBAService.TradePlayers:input_type -> v1.TradePlayersRequest
+	25,  // 93: v1.NBAService.ValidateTrade:input_type -> v1.TradePlayersRequest
+	52,  // 94: v1.NBAService.GetPlayerTeamHistory:input_type -> v1.GetPlayerTeamHistoryRequest
+	31,  // 95: v1.NBAService.CreateContract:input_type -> v1.CreateContractRequest
+	32,  // 96: v1.NBAService.GetPlayerContract:input_type -> v1.GetPlayerContractRequest
+	37,  // 97: v1.NBAService.ReportInjury:input_type -> v1.ReportInjuryRequest
+	38,  // 98: v1.NBAService.UpdateInjury:input_type -> v1.UpdateInjuryRequest
+	40,  // 99: v1.NBAService.GetInjuryReport:input_type -> v1.GetInjuryReportRequest
+	42,  // 100: v1.NBAService.CreateDraft:input_type -> v1.CreateDraftRequest
+	43,  // 101: v1.NBAService.RunDraftLottery:input_type -> v1.RunDraftLotteryRequest
+	44,  // 102: v1.NBAService.AddProspect:input_type -> v1.AddProspectRequest
+	46,  // 103: v1.NBAService.MakePick:input_type -> v1.MakePickRequest
+	48,  // 104: v1.NBAService.GetDraftBoard:input_type -> v1.GetDraftBoardRequest
+	54,  // 105: v1.NBAService.SearchPlayers:input_type -> v1.SearchPlayersRequest
+	58,  // 106: v1.NBAService.GetTeam:input_type -> v1.GetTeamRequest
+	66,  // 107: v1.NBAService.ListTeams:input_type -> v1.ListTeamsRequest
+	60,  // 108: v1.NBAService.CreateTeam:input_type -> v1.CreateTeamRequest
+	61,  // 109: v1.NBAService.UpdateTeam:input_type -> v1.UpdateTeamRequest
+	62,  // 110: v1.NBAService.DeleteTeam:input_type -> v1.DeleteTeamRequest
+	58,  // 111: v1.NBAService.GetTeamHistory:input_type -> v1.GetTeamRequest
+	72,  // 112: v1.NBAService.GetStandings:input_type -> v1.GetStandingsRequest
+	34,  // 113: v1.NBAService.GetTeamPayroll:input_type -> v1.GetTeamPayrollRequest
+	68,  // 114: v1.NBAService.ListDivisions:input_type -> v1.ListDivisionsRequest
+	70,  // 115: v1.NBAService.GetDivision:input_type -> v1.GetDivisionRequest
+	75,  // 116: v1.NBAService.ListMatches:input_type -> v1.ListMatchesRequest
+	91,  // 117: v1.NBAService.GetMatch:input_type -> v1.GetMatchRequest
+	77,  // 118: v1.NBAService.StartMatch:input_type -> v1.MatchTransitionRequest
+	77,  // 119: v1.NBAService.EndPeriod:input_type -> v1.MatchTransitionRequest
+	77,  // 120: v1.NBAService.StartOvertime:input_type -> v1.MatchTransitionRequest
+	77,  // 121: v1.NBAService.FinalizeMatch:input_type -> v1.MatchTransitionRequest
+	77,  // 122: v1.NBAService.PostponeMatch:input_type -> v1.MatchTransitionRequest
+	77,  // 123: v1.NBAService.StartClock:input_type -> v1.MatchTransitionRequest
+	77,  // 124: v1.NBAService.StopClock:input_type -> v1.MatchTransitionRequest
+	77,  // 125: v1.NBAService.ResetClock:input_type -> v1.MatchTransitionRequest
+	78,  // 126: v1.NBAService.CreateMatch:input_type -> v1.CreateMatchRequest
+	79,  // 127: v1.NBAService.UpdateMatch:input_type -> v1.UpdateMatchRequest
+	77,  // 128: v1.NBAService.CancelMatch:input_type -> v1.MatchTransitionRequest
+	80,  // 129: v1.NBAService.ImportSchedule:input_type -> v1.ImportScheduleRequest
+	83,  // 130: v1.NBAService.GenerateSchedule:input_type -> v1.GenerateScheduleRequest
+	91,  // 131: v1.NBAService.WatchMatch:input_type -> v1.GetMatchRequest
+	97,  // 132: v1.NBAService.RecordMatchEvent:input_type -> v1.RecordMatchEventRequest
+	99,  // 133: v1.NBAService.VoidMatchEvent:input_type -> v1.VoidMatchEventRequest
+	100, // 134: v1.NBAService.AmendMatchEvent:input_type -> v1.AmendMatchEventRequest
+	91,  // 135: v1.NBAService.GetMatchBoxScore:input_type -> v1.GetMatchRequest
+	94,  // 136: v1.NBAService.SearchEvents:input_type -> v1.SearchEventsRequest
+	91,  // 137: v1.NBAService.GetGameArchive:input_type -> v1.GetMatchRequest
+	107, // 138: v1.NBAService.ReplayDeadLetters:input_type -> v1.ReplayDeadLettersRequest
+	91,  // 139: v1.NBAService.ArchiveMatch:input_type -> v1.GetMatchRequest
+	15,  // 140: v1.NBAService.CreatePlayer:output_type -> v1.PlayerResponse
+	15,  // 141: v1.NBAService.GetPlayer:output_type -> v1.PlayerResponse
+	15,  // 142: v1.NBAService.UpdatePlayer:output_type -> v1.PlayerResponse
+	14,  // 143: v1.NBAService.DeletePlayer:output_type -> v1.DeletePlayerResponse
+	17,  // 144: v1.NBAService.ListPlayers:output_type -> v1.ListPlayersResponse
+	17,  // 145: v1.NBAService.GetPlayersByTeam:output_type -> v1.ListPlayersResponse
+	20,  // 146: v1.NBAService.GetRoster:output_type -> v1.RosterResponse
+	15,  // 147: v1.NBAService.AddToRoster:output_type -> v1.PlayerResponse
+	15,  // 148: v1.NBAService.ReleasePlayer:output_type -> v1.PlayerResponse
+	15,  // 149: v1.NBAService.ChangeRosterSlot:output_type -> v1.PlayerResponse
+	29,  // 150: v1.NBAService.TradePlayers:output_type -> v1.TradeResponse
+	27,  // 151: v1.NBAService.ValidateTrade:output_type -> v1.ValidateTradeResponse
+	53,  // 152: v1.NBAService.GetPlayerTeamHistory:output_type -> v1.PlayerTeamHistoryResponse
+	33,  // 153: v1.NBAService.CreateContract:output_type -> v1.ContractResponse
+	33,  // 154: v1.NBAService.GetPlayerContract:output_type -> v1.ContractResponse
+	39,  // 155: v1.NBAService.ReportInjury:output_type -> v1.InjuryResponse
+	39,  // 156: v1.NBAService.UpdateInjury:output_type -> v1.InjuryResponse
+	41,  // 157: v1.NBAService.GetInjuryReport:output_type -> v1.InjuryReportResponse
+	50,  // 158: v1.NBAService.CreateDraft:output_type -> v1.DraftBoardResponse
+	50,  // 159: v1.NBAService.RunDraftLottery:output_type -> v1.DraftBoardResponse
+	45,  // 160: v1.NBAService.AddProspect:output_type -> v1.ProspectResponse
+	47,  // 161: v1.NBAService.MakePick:output_type -> v1.MakePickResponse
+	50,  // 162: v1.NBAService.GetDraftBoard:output_type -> v1.DraftBoardResponse
+	57,  // 163: v1.NBAService.SearchPlayers:output_type -> v1.SearchPlayersResponse
+	59,  // 164: v1.NBAService.GetTeam:output_type -> v1.TeamResponse
+	67,  // 165: v1.NBAService.ListTeams:output_type -> v1.ListTeamsResponse
+	59,  // 166: v1.NBAService.CreateTeam:output_type -> v1.TeamResponse
+	59,  // 167: v1.NBAService.UpdateTeam:output_type -> v1.TeamResponse
+	63,  // 168: v1.NBAService.DeleteTeam:output_type -> v1.DeleteTeamResponse
+	65,  // 169: v1.NBAService.GetTeamHistory:output_type -> v1.TeamHistoryResponse
+	73,  // 170: v1.NBAService.GetStandings:output_type -> v1.StandingsResponse
+	36,  // 171: v1.NBAService.GetTeamPayroll:output_type -> v1.TeamPayrollResponse
+	69,  // 172: v1.NBAService.ListDivisions:output_type -> v1.ListDivisionsResponse
+	71,  // 173: v1.NBAService.GetDivision:output_type -> v1.DivisionResponse
+	90,  // 174: v1.NBAService.ListMatches:output_type -> v1.ListMatchesResponse
+	76,  // 175: v1.NBAService.GetMatch:output_type -> v1.MatchResponse
+	76,  // 176: v1.NBAService.StartMatch:output_type -> v1.MatchResponse
+	76,  // 177: v1.NBAService.EndPeriod:output_type -> v1.MatchResponse
+	76,  // 178: v1.NBAService.StartOvertime:output_type -> v1.MatchResponse
+	76,  // 179: v1.NBAService.FinalizeMatch:output_type -> v1.MatchResponse
+	76,  // 180: v1.NBAService.PostponeMatch:output_type -> v1.MatchResponse
+	76,  // 181: v1.NBAService.StartClock:output_type -> v1.MatchResponse
+	76,  // 182: v1.NBAService.StopClock:output_type -> v1.MatchResponse
+	76,  // 183: v1.NBAService.ResetClock:output_type -> v1.MatchResponse
+	76,  // 184: v1.NBAService.CreateMatch:output_type -> v1.MatchResponse
+	76,  // 185: v1.NBAService.UpdateMatch:output_type -> v1.MatchResponse
+	76,  // 186: v1.NBAService.CancelMatch:output_type -> v1.MatchResponse
+	81,  // 187: v1.NBAService.ImportSchedule:output_type -> v1.ImportScheduleResponse
+	86,  // 188: v1.NBAService.GenerateSchedule:output_type -> v1.GenerateScheduleResponse
+	92,  // 189: v1.NBAService.WatchMatch:output_type -> v1.MatchUpdate
+	98,  // 190: v1.NBAService.RecordMatchEvent:output_type -> v1.RecordMatchEventResponse
+	98,  // 191: v1.NBAService.VoidMatchEvent:output_type -> v1.RecordMatchEventResponse
+	98,  // 192: v1.NBAService.AmendMatchEvent:output_type -> v1.RecordMatchEventResponse
+	103, // 193: v1.NBAService.GetMatchBoxScore:output_type -> v1.BoxScoreResponse
+	96,  // 194: v1.NBAService.SearchEvents:output_type -> v1.SearchEventsResponse
+	106, // 195: v1.NBAService.GetGameArchive:output_type -> v1.GameArchiveResponse
+	108, // 196: v1.NBAService.ReplayDeadLetters:output_type -> v1.ReplayDeadLettersResponse
+	106, // 197: v1.NBAService.ArchiveMatch:output_type -> v1.GameArchiveResponse
+	140, // [140:198] is the sub-list for method output_type
+	82,  // [82:140] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_nba_service_proto_init() }
@@ -1670,8 +9734,8 @@ func file_api_proto_v1_nba_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_nba_service_proto_rawDesc), len(file_api_proto_v1_nba_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      10,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
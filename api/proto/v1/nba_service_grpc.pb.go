@@ -19,17 +19,64 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NBAService_CreatePlayer_FullMethodName     = "/v1.NBAService/CreatePlayer"
-	NBAService_GetPlayer_FullMethodName        = "/v1.NBAService/GetPlayer"
-	NBAService_UpdatePlayer_FullMethodName     = "/v1.NBAService/UpdatePlayer"
-	NBAService_DeletePlayer_FullMethodName     = "/v1.NBAService/DeletePlayer"
-	NBAService_ListPlayers_FullMethodName      = "/v1.NBAService/ListPlayers"
-	NBAService_GetPlayersByTeam_FullMethodName = "/v1.NBAService/GetPlayersByTeam"
-	NBAService_GetTeam_FullMethodName          = "/v1.NBAService/GetTeam"
-	NBAService_ListTeams_FullMethodName        = "/v1.NBAService/ListTeams"
-	NBAService_ListMatches_FullMethodName      = "/v1.NBAService/ListMatches"
-	NBAService_GetMatch_FullMethodName         = "/v1.NBAService/GetMatch"
-	NBAService_RecordMatchEvent_FullMethodName = "/v1.NBAService/RecordMatchEvent"
+	NBAService_CreatePlayer_FullMethodName         = "/v1.NBAService/CreatePlayer"
+	NBAService_GetPlayer_FullMethodName            = "/v1.NBAService/GetPlayer"
+	NBAService_UpdatePlayer_FullMethodName         = "/v1.NBAService/UpdatePlayer"
+	NBAService_DeletePlayer_FullMethodName         = "/v1.NBAService/DeletePlayer"
+	NBAService_ListPlayers_FullMethodName          = "/v1.NBAService/ListPlayers"
+	NBAService_GetPlayersByTeam_FullMethodName     = "/v1.NBAService/GetPlayersByTeam"
+	NBAService_GetRoster_FullMethodName            = "/v1.NBAService/GetRoster"
+	NBAService_AddToRoster_FullMethodName          = "/v1.NBAService/AddToRoster"
+	NBAService_ReleasePlayer_FullMethodName        = "/v1.NBAService/ReleasePlayer"
+	NBAService_ChangeRosterSlot_FullMethodName     = "/v1.NBAService/ChangeRosterSlot"
+	NBAService_TradePlayers_FullMethodName         = "/v1.NBAService/TradePlayers"
+	NBAService_ValidateTrade_FullMethodName        = "/v1.NBAService/ValidateTrade"
+	NBAService_GetPlayerTeamHistory_FullMethodName = "/v1.NBAService/GetPlayerTeamHistory"
+	NBAService_CreateContract_FullMethodName       = "/v1.NBAService/CreateContract"
+	NBAService_GetPlayerContract_FullMethodName    = "/v1.NBAService/GetPlayerContract"
+	NBAService_ReportInjury_FullMethodName         = "/v1.NBAService/ReportInjury"
+	NBAService_UpdateInjury_FullMethodName         = "/v1.NBAService/UpdateInjury"
+	NBAService_GetInjuryReport_FullMethodName      = "/v1.NBAService/GetInjuryReport"
+	NBAService_CreateDraft_FullMethodName          = "/v1.NBAService/CreateDraft"
+	NBAService_RunDraftLottery_FullMethodName      = "/v1.NBAService/RunDraftLottery"
+	NBAService_AddProspect_FullMethodName          = "/v1.NBAService/AddProspect"
+	NBAService_MakePick_FullMethodName             = "/v1.NBAService/MakePick"
+	NBAService_GetDraftBoard_FullMethodName        = "/v1.NBAService/GetDraftBoard"
+	NBAService_SearchPlayers_FullMethodName        = "/v1.NBAService/SearchPlayers"
+	NBAService_GetTeam_FullMethodName              = "/v1.NBAService/GetTeam"
+	NBAService_ListTeams_FullMethodName            = "/v1.NBAService/ListTeams"
+	NBAService_CreateTeam_FullMethodName           = "/v1.NBAService/CreateTeam"
+	NBAService_UpdateTeam_FullMethodName           = "/v1.NBAService/UpdateTeam"
+	NBAService_DeleteTeam_FullMethodName           = "/v1.NBAService/DeleteTeam"
+	NBAService_GetTeamHistory_FullMethodName       = "/v1.NBAService/GetTeamHistory"
+	NBAService_GetStandings_FullMethodName         = "/v1.NBAService/GetStandings"
+	NBAService_GetTeamPayroll_FullMethodName       = "/v1.NBAService/GetTeamPayroll"
+	NBAService_ListDivisions_FullMethodName        = "/v1.NBAService/ListDivisions"
+	NBAService_GetDivision_FullMethodName          = "/v1.NBAService/GetDivision"
+	NBAService_ListMatches_FullMethodName          = "/v1.NBAService/ListMatches"
+	NBAService_GetMatch_FullMethodName             = "/v1.NBAService/GetMatch"
+	NBAService_StartMatch_FullMethodName           = "/v1.NBAService/StartMatch"
+	NBAService_EndPeriod_FullMethodName            = "/v1.NBAService/EndPeriod"
+	NBAService_StartOvertime_FullMethodName        = "/v1.NBAService/StartOvertime"
+	NBAService_FinalizeMatch_FullMethodName        = "/v1.NBAService/FinalizeMatch"
+	NBAService_PostponeMatch_FullMethodName        = "/v1.NBAService/PostponeMatch"
+	NBAService_StartClock_FullMethodName           = "/v1.NBAService/StartClock"
+	NBAService_StopClock_FullMethodName            = "/v1.NBAService/StopClock"
+	NBAService_ResetClock_FullMethodName           = "/v1.NBAService/ResetClock"
+	NBAService_CreateMatch_FullMethodName          = "/v1.NBAService/CreateMatch"
+	NBAService_UpdateMatch_FullMethodName          = "/v1.NBAService/UpdateMatch"
+	NBAService_CancelMatch_FullMethodName          = "/v1.NBAService/CancelMatch"
+	NBAService_ImportSchedule_FullMethodName       = "/v1.NBAService/ImportSchedule"
+	NBAService_GenerateSchedule_FullMethodName     = "/v1.NBAService/GenerateSchedule"
+	NBAService_WatchMatch_FullMethodName           = "/v1.NBAService/WatchMatch"
+	NBAService_RecordMatchEvent_FullMethodName     = "/v1.NBAService/RecordMatchEvent"
+	NBAService_VoidMatchEvent_FullMethodName       = "/v1.NBAService/VoidMatchEvent"
+	NBAService_AmendMatchEvent_FullMethodName      = "/v1.NBAService/AmendMatchEvent"
+	NBAService_GetMatchBoxScore_FullMethodName     = "/v1.NBAService/GetMatchBoxScore"
+	NBAService_SearchEvents_FullMethodName         = "/v1.NBAService/SearchEvents"
+	NBAService_GetGameArchive_FullMethodName       = "/v1.NBAService/GetGameArchive"
+	NBAService_ReplayDeadLetters_FullMethodName    = "/v1.NBAService/ReplayDeadLetters"
+	NBAService_ArchiveMatch_FullMethodName         = "/v1.NBAService/ArchiveMatch"
 )
 
 // NBAServiceClient is the client API for NBAService service.
@@ -51,13 +98,54 @@ type NBAServiceClient interface {
 	DeletePlayer(ctx context.Context, in *DeletePlayerRequest, opts ...grpc.CallOption) (*DeletePlayerResponse, error)
 	// 按条件查询球员列表
 	ListPlayers(ctx context.Context, in *ListPlayersRequest, opts ...grpc.CallOption) (*ListPlayersResponse, error)
-	// 按球队ID获取球员
+	// 按球队ID获取球员 (当前阵容)
 	GetPlayersByTeam(ctx context.Context, in *GetPlayersByTeamRequest, opts ...grpc.CallOption) (*ListPlayersResponse, error)
+	// 阵容管理: 队内球衣号唯一, 标准名额/双向合同名额有上限 (超出返回 CodeTeamFull)
+	GetRoster(ctx context.Context, in *GetRosterRequest, opts ...grpc.CallOption) (*RosterResponse, error)
+	AddToRoster(ctx context.Context, in *AddToRosterRequest, opts ...grpc.CallOption) (*PlayerResponse, error)
+	ReleasePlayer(ctx context.Context, in *ReleasePlayerRequest, opts ...grpc.CallOption) (*PlayerResponse, error)
+	// 标准合同与双向合同互转
+	ChangeRosterSlot(ctx context.Context, in *ChangeRosterSlotRequest, opts ...grpc.CallOption) (*PlayerResponse, error)
+	// 交易: 多支球队之间的球员和选秀权在同一事务中转移, 写入球员异动记录; 违反交易规则时不生效
+	TradePlayers(ctx context.Context, in *TradePlayersRequest, opts ...grpc.CallOption) (*TradeResponse, error)
+	// 交易预检: 返回全部违反的交易规则, 不修改数据
+	ValidateTrade(ctx context.Context, in *TradePlayersRequest, opts ...grpc.CallOption) (*ValidateTradeResponse, error)
+	// 球员异动记录 (签约/交易/裁掉/下放等) 及效力球队区间, 可查询某天所在球队
+	GetPlayerTeamHistory(ctx context.Context, in *GetPlayerTeamHistoryRequest, opts ...grpc.CallOption) (*PlayerTeamHistoryResponse, error)
+	// 合同: 按赛季的工资/保障金额/选项, 鸟权按异动记录计算; 合同随交易转移, 裁掉后保障部分留在原球队
+	CreateContract(ctx context.Context, in *CreateContractRequest, opts ...grpc.CallOption) (*ContractResponse, error)
+	GetPlayerContract(ctx context.Context, in *GetPlayerContractRequest, opts ...grpc.CallOption) (*ContractResponse, error)
+	// 伤病: 记录部位/日期/预计复出/出战状态, 自动维护球员状态; 缺席场次按比赛表计算
+	ReportInjury(ctx context.Context, in *ReportInjuryRequest, opts ...grpc.CallOption) (*InjuryResponse, error)
+	UpdateInjury(ctx context.Context, in *UpdateInjuryRequest, opts ...grpc.CallOption) (*InjuryResponse, error)
+	// 某天的伤病报告 (当天仍未复出的伤病)
+	GetInjuryReport(ctx context.Context, in *GetInjuryReportRequest, opts ...grpc.CallOption) (*InjuryReportResponse, error)
+	// 选秀: 新建选秀年份 (为每支球队生成各轮选秀权, 可提前若干年创建以便交易) / 乐透抽签 / 新秀名单 / 选人 / 选秀看板
+	CreateDraft(ctx context.Context, in *CreateDraftRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error)
+	RunDraftLottery(ctx context.Context, in *RunDraftLotteryRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error)
+	AddProspect(ctx context.Context, in *AddProspectRequest, opts ...grpc.CallOption) (*ProspectResponse, error)
+	// 用当前轮到的选秀权选中新秀, 新秀作为新球员加入选秀权持有球队的阵容
+	MakePick(ctx context.Context, in *MakePickRequest, opts ...grpc.CallOption) (*MakePickResponse, error)
+	GetDraftBoard(ctx context.Context, in *GetDraftBoardRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error)
+	// 全文搜索球员 (ES: 姓名模糊/拼音/别名, 带分面统计)
+	SearchPlayers(ctx context.Context, in *SearchPlayersRequest, opts ...grpc.CallOption) (*SearchPlayersResponse, error)
 	// -----------------------
 	// 2. 球队模块 (Team)
 	// -----------------------
 	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
 	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// 球队管理: 缩写必须是 3 个大写字母且唯一; 名称/城市/场馆/队徽按赛季保留历史
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	GetTeamHistory(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamHistoryResponse, error)
+	// 联盟排名 (按已结束比赛计算, 同胜率按官方规则决胜)
+	GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error)
+	// 球队工资总额及与工资帽/奢侈税线/土豪线的距离
+	GetTeamPayroll(ctx context.Context, in *GetTeamPayrollRequest, opts ...grpc.CallOption) (*TeamPayrollResponse, error)
+	// 赛区列表 / 详情 (含该赛季的球队)
+	ListDivisions(ctx context.Context, in *ListDivisionsRequest, opts ...grpc.CallOption) (*ListDivisionsResponse, error)
+	GetDivision(ctx context.Context, in *GetDivisionRequest, opts ...grpc.CallOption) (*DivisionResponse, error)
 	// -----------------------
 	// 3. 比赛模块 (Match)
 	// -----------------------
@@ -65,8 +153,44 @@ type NBAServiceClient interface {
 	ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error)
 	// 获取比赛详情 (包括实时比分)
 	GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	// 比赛进程 (状态机): 开赛 / 结束本节 / 开始加时 / 终场 / 延期, 非法流转返回业务错误码
+	StartMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	EndPeriod(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	StartOvertime(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	FinalizeMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	PostponeMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	// 比赛时钟: 启动 / 暂停 / 校正 (持久化, 服务重启后继续走表)
+	StartClock(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	StopClock(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	ResetClock(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	// 赛程管理: 新建 / 修改 (改期) / 取消 / 批量导入 (CSV 或 JSON), 校验球队、赛季及同日冲突
+	CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	UpdateMatch(ctx context.Context, in *UpdateMatchRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	CancelMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error)
+	ImportSchedule(ctx context.Context, in *ImportScheduleRequest, opts ...grpc.CallOption) (*ImportScheduleResponse, error)
+	// 生成整个常规赛赛程 (场次/对手权重/主客场均衡/不连续三天/场馆可用), dry_run 只返回赛程和约束报告
+	GenerateSchedule(ctx context.Context, in *GenerateScheduleRequest, opts ...grpc.CallOption) (*GenerateScheduleResponse, error)
+	// 订阅比赛实时比分 (先推送当前快照, 之后每处理一个事件推送一次)
+	WatchMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchUpdate], error)
 	// [核心] 比赛事件上报 (对接 Kafka)
 	RecordMatchEvent(ctx context.Context, in *RecordMatchEventRequest, opts ...grpc.CallOption) (*RecordMatchEventResponse, error)
+	// 作废已上报的事件 (发送补偿事件, 冲正比分和技术统计)
+	VoidMatchEvent(ctx context.Context, in *VoidMatchEventRequest, opts ...grpc.CallOption) (*RecordMatchEventResponse, error)
+	// 更正已上报的事件 (作废原事件并写入更正后的事件)
+	AmendMatchEvent(ctx context.Context, in *AmendMatchEventRequest, opts ...grpc.CallOption) (*RecordMatchEventResponse, error)
+	// 获取比赛技术统计 (box score)
+	GetMatchBoxScore(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*BoxScoreResponse, error)
+	// 跨比赛搜索事件 (play-by-play), 支持按球员/球队/类型/节次/比赛时钟过滤并返回分面统计
+	SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error)
+	// 获取已结束比赛的完整归档 (MongoDB, 不访问 MySQL)
+	GetGameArchive(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*GameArchiveResponse, error)
+	// -----------------------
+	// 4. 运维模块 (Admin)
+	// -----------------------
+	// 把死信队列中的事件重新投递到主 Topic (问题修复后使用)
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
+	// 生成/重新生成已结束比赛的 MongoDB 归档 (历史数据补录/修复用)
+	ArchiveMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*GameArchiveResponse, error)
 }
 
 type nBAServiceClient struct {
@@ -137,6 +261,186 @@ func (c *nBAServiceClient) GetPlayersByTeam(ctx context.Context, in *GetPlayersB
 	return out, nil
 }
 
+func (c *nBAServiceClient) GetRoster(ctx context.Context, in *GetRosterRequest, opts ...grpc.CallOption) (*RosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RosterResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) AddToRoster(ctx context.Context, in *AddToRosterRequest, opts ...grpc.CallOption) (*PlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerResponse)
+	err := c.cc.Invoke(ctx, NBAService_AddToRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ReleasePlayer(ctx context.Context, in *ReleasePlayerRequest, opts ...grpc.CallOption) (*PlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerResponse)
+	err := c.cc.Invoke(ctx, NBAService_ReleasePlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ChangeRosterSlot(ctx context.Context, in *ChangeRosterSlotRequest, opts ...grpc.CallOption) (*PlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerResponse)
+	err := c.cc.Invoke(ctx, NBAService_ChangeRosterSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) TradePlayers(ctx context.Context, in *TradePlayersRequest, opts ...grpc.CallOption) (*TradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TradeResponse)
+	err := c.cc.Invoke(ctx, NBAService_TradePlayers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ValidateTrade(ctx context.Context, in *TradePlayersRequest, opts ...grpc.CallOption) (*ValidateTradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateTradeResponse)
+	err := c.cc.Invoke(ctx, NBAService_ValidateTrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetPlayerTeamHistory(ctx context.Context, in *GetPlayerTeamHistoryRequest, opts ...grpc.CallOption) (*PlayerTeamHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerTeamHistoryResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetPlayerTeamHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) CreateContract(ctx context.Context, in *CreateContractRequest, opts ...grpc.CallOption) (*ContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContractResponse)
+	err := c.cc.Invoke(ctx, NBAService_CreateContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetPlayerContract(ctx context.Context, in *GetPlayerContractRequest, opts ...grpc.CallOption) (*ContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContractResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetPlayerContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ReportInjury(ctx context.Context, in *ReportInjuryRequest, opts ...grpc.CallOption) (*InjuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InjuryResponse)
+	err := c.cc.Invoke(ctx, NBAService_ReportInjury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) UpdateInjury(ctx context.Context, in *UpdateInjuryRequest, opts ...grpc.CallOption) (*InjuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InjuryResponse)
+	err := c.cc.Invoke(ctx, NBAService_UpdateInjury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetInjuryReport(ctx context.Context, in *GetInjuryReportRequest, opts ...grpc.CallOption) (*InjuryReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InjuryReportResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetInjuryReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) CreateDraft(ctx context.Context, in *CreateDraftRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftBoardResponse)
+	err := c.cc.Invoke(ctx, NBAService_CreateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) RunDraftLottery(ctx context.Context, in *RunDraftLotteryRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftBoardResponse)
+	err := c.cc.Invoke(ctx, NBAService_RunDraftLottery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) AddProspect(ctx context.Context, in *AddProspectRequest, opts ...grpc.CallOption) (*ProspectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProspectResponse)
+	err := c.cc.Invoke(ctx, NBAService_AddProspect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) MakePick(ctx context.Context, in *MakePickRequest, opts ...grpc.CallOption) (*MakePickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MakePickResponse)
+	err := c.cc.Invoke(ctx, NBAService_MakePick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetDraftBoard(ctx context.Context, in *GetDraftBoardRequest, opts ...grpc.CallOption) (*DraftBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftBoardResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetDraftBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) SearchPlayers(ctx context.Context, in *SearchPlayersRequest, opts ...grpc.CallOption) (*SearchPlayersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPlayersResponse)
+	err := c.cc.Invoke(ctx, NBAService_SearchPlayers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nBAServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TeamResponse)
@@ -157,6 +461,86 @@ func (c *nBAServiceClient) ListTeams(ctx context.Context, in *ListTeamsRequest,
 	return out, nil
 }
 
+func (c *nBAServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, NBAService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, NBAService_UpdateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, NBAService_DeleteTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetTeamHistory(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamHistoryResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetTeamHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandingsResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetTeamPayroll(ctx context.Context, in *GetTeamPayrollRequest, opts ...grpc.CallOption) (*TeamPayrollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamPayrollResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetTeamPayroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ListDivisions(ctx context.Context, in *ListDivisionsRequest, opts ...grpc.CallOption) (*ListDivisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDivisionsResponse)
+	err := c.cc.Invoke(ctx, NBAService_ListDivisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetDivision(ctx context.Context, in *GetDivisionRequest, opts ...grpc.CallOption) (*DivisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DivisionResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetDivision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nBAServiceClient) ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMatchesResponse)
@@ -177,65 +561,361 @@ func (c *nBAServiceClient) GetMatch(ctx context.Context, in *GetMatchRequest, op
 	return out, nil
 }
 
-func (c *nBAServiceClient) RecordMatchEvent(ctx context.Context, in *RecordMatchEventRequest, opts ...grpc.CallOption) (*RecordMatchEventResponse, error) {
+func (c *nBAServiceClient) StartMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RecordMatchEventResponse)
-	err := c.cc.Invoke(ctx, NBAService_RecordMatchEvent_FullMethodName, in, out, cOpts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_StartMatch_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// NBAServiceServer is the server API for NBAService service.
-// All implementations must embed UnimplementedNBAServiceServer
-// for forward compatibility.
-//
-// NBA球员管理服务
-type NBAServiceServer interface {
-	// -----------------------
-	// 1. 球员模块
-	// -----------------------
-	// 创建球员
-	CreatePlayer(context.Context, *CreatePlayerRequest) (*PlayerResponse, error)
-	// 获取球员详情
-	GetPlayer(context.Context, *GetPlayerRequest) (*PlayerResponse, error)
-	// 更新球员信息
-	UpdatePlayer(context.Context, *UpdatePlayerRequest) (*PlayerResponse, error)
-	// 删除球员
-	DeletePlayer(context.Context, *DeletePlayerRequest) (*DeletePlayerResponse, error)
-	// 按条件查询球员列表
-	ListPlayers(context.Context, *ListPlayersRequest) (*ListPlayersResponse, error)
-	// 按球队ID获取球员
-	GetPlayersByTeam(context.Context, *GetPlayersByTeamRequest) (*ListPlayersResponse, error)
-	// -----------------------
-	// 2. 球队模块 (Team)
-	// -----------------------
-	GetTeam(context.Context, *GetTeamRequest) (*TeamResponse, error)
-	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
-	// -----------------------
-	// 3. 比赛模块 (Match)
-	// -----------------------
-	// 获取当天的比赛列表
-	ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error)
-	// 获取比赛详情 (包括实时比分)
-	GetMatch(context.Context, *GetMatchRequest) (*MatchResponse, error)
-	// [核心] 比赛事件上报 (对接 Kafka)
-	RecordMatchEvent(context.Context, *RecordMatchEventRequest) (*RecordMatchEventResponse, error)
-	mustEmbedUnimplementedNBAServiceServer()
+func (c *nBAServiceClient) EndPeriod(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_EndPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedNBAServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedNBAServiceServer struct{}
+func (c *nBAServiceClient) StartOvertime(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_StartOvertime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedNBAServiceServer) CreatePlayer(context.Context, *CreatePlayerRequest) (*PlayerResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreatePlayer not implemented")
+func (c *nBAServiceClient) FinalizeMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_FinalizeMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNBAServiceServer) GetPlayer(context.Context, *GetPlayerRequest) (*PlayerResponse, error) {
+
+func (c *nBAServiceClient) PostponeMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_PostponeMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) StartClock(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_StartClock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) StopClock(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_StopClock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ResetClock(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_ResetClock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_CreateMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) UpdateMatch(ctx context.Context, in *UpdateMatchRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_UpdateMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) CancelMatch(ctx context.Context, in *MatchTransitionRequest, opts ...grpc.CallOption) (*MatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchResponse)
+	err := c.cc.Invoke(ctx, NBAService_CancelMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ImportSchedule(ctx context.Context, in *ImportScheduleRequest, opts ...grpc.CallOption) (*ImportScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportScheduleResponse)
+	err := c.cc.Invoke(ctx, NBAService_ImportSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GenerateSchedule(ctx context.Context, in *GenerateScheduleRequest, opts ...grpc.CallOption) (*GenerateScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateScheduleResponse)
+	err := c.cc.Invoke(ctx, NBAService_GenerateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) WatchMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NBAService_ServiceDesc.Streams[0], NBAService_WatchMatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetMatchRequest, MatchUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NBAService_WatchMatchClient = grpc.ServerStreamingClient[MatchUpdate]
+
+func (c *nBAServiceClient) RecordMatchEvent(ctx context.Context, in *RecordMatchEventRequest, opts ...grpc.CallOption) (*RecordMatchEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordMatchEventResponse)
+	err := c.cc.Invoke(ctx, NBAService_RecordMatchEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) VoidMatchEvent(ctx context.Context, in *VoidMatchEventRequest, opts ...grpc.CallOption) (*RecordMatchEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordMatchEventResponse)
+	err := c.cc.Invoke(ctx, NBAService_VoidMatchEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) AmendMatchEvent(ctx context.Context, in *AmendMatchEventRequest, opts ...grpc.CallOption) (*RecordMatchEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordMatchEventResponse)
+	err := c.cc.Invoke(ctx, NBAService_AmendMatchEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetMatchBoxScore(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*BoxScoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoxScoreResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetMatchBoxScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchEventsResponse)
+	err := c.cc.Invoke(ctx, NBAService_SearchEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) GetGameArchive(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*GameArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameArchiveResponse)
+	err := c.cc.Invoke(ctx, NBAService_GetGameArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, NBAService_ReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nBAServiceClient) ArchiveMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*GameArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameArchiveResponse)
+	err := c.cc.Invoke(ctx, NBAService_ArchiveMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NBAServiceServer is the server API for NBAService service.
+// All implementations must embed UnimplementedNBAServiceServer
+// for forward compatibility.
+//
+// NBA球员管理服务
+type NBAServiceServer interface {
+	// -----------------------
+	// 1. 球员模块
+	// -----------------------
+	// 创建球员
+	CreatePlayer(context.Context, *CreatePlayerRequest) (*PlayerResponse, error)
+	// 获取球员详情
+	GetPlayer(context.Context, *GetPlayerRequest) (*PlayerResponse, error)
+	// 更新球员信息
+	UpdatePlayer(context.Context, *UpdatePlayerRequest) (*PlayerResponse, error)
+	// 删除球员
+	DeletePlayer(context.Context, *DeletePlayerRequest) (*DeletePlayerResponse, error)
+	// 按条件查询球员列表
+	ListPlayers(context.Context, *ListPlayersRequest) (*ListPlayersResponse, error)
+	// 按球队ID获取球员 (当前阵容)
+	GetPlayersByTeam(context.Context, *GetPlayersByTeamRequest) (*ListPlayersResponse, error)
+	// 阵容管理: 队内球衣号唯一, 标准名额/双向合同名额有上限 (超出返回 CodeTeamFull)
+	GetRoster(context.Context, *GetRosterRequest) (*RosterResponse, error)
+	AddToRoster(context.Context, *AddToRosterRequest) (*PlayerResponse, error)
+	ReleasePlayer(context.Context, *ReleasePlayerRequest) (*PlayerResponse, error)
+	// 标准合同与双向合同互转
+	ChangeRosterSlot(context.Context, *ChangeRosterSlotRequest) (*PlayerResponse, error)
+	// 交易: 多支球队之间的球员和选秀权在同一事务中转移, 写入球员异动记录; 违反交易规则时不生效
+	TradePlayers(context.Context, *TradePlayersRequest) (*TradeResponse, error)
+	// 交易预检: 返回全部违反的交易规则, 不修改数据
+	ValidateTrade(context.Context, *TradePlayersRequest) (*ValidateTradeResponse, error)
+	// 球员异动记录 (签约/交易/裁掉/下放等) 及效力球队区间, 可查询某天所在球队
+	GetPlayerTeamHistory(context.Context, *GetPlayerTeamHistoryRequest) (*PlayerTeamHistoryResponse, error)
+	// 合同: 按赛季的工资/保障金额/选项, 鸟权按异动记录计算; 合同随交易转移, 裁掉后保障部分留在原球队
+	CreateContract(context.Context, *CreateContractRequest) (*ContractResponse, error)
+	GetPlayerContract(context.Context, *GetPlayerContractRequest) (*ContractResponse, error)
+	// 伤病: 记录部位/日期/预计复出/出战状态, 自动维护球员状态; 缺席场次按比赛表计算
+	ReportInjury(context.Context, *ReportInjuryRequest) (*InjuryResponse, error)
+	UpdateInjury(context.Context, *UpdateInjuryRequest) (*InjuryResponse, error)
+	// 某天的伤病报告 (当天仍未复出的伤病)
+	GetInjuryReport(context.Context, *GetInjuryReportRequest) (*InjuryReportResponse, error)
+	// 选秀: 新建选秀年份 (为每支球队生成各轮选秀权, 可提前若干年创建以便交易) / 乐透抽签 / 新秀名单 / 选人 / 选秀看板
+	CreateDraft(context.Context, *CreateDraftRequest) (*DraftBoardResponse, error)
+	RunDraftLottery(context.Context, *RunDraftLotteryRequest) (*DraftBoardResponse, error)
+	AddProspect(context.Context, *AddProspectRequest) (*ProspectResponse, error)
+	// 用当前轮到的选秀权选中新秀, 新秀作为新球员加入选秀权持有球队的阵容
+	MakePick(context.Context, *MakePickRequest) (*MakePickResponse, error)
+	GetDraftBoard(context.Context, *GetDraftBoardRequest) (*DraftBoardResponse, error)
+	// 全文搜索球员 (ES: 姓名模糊/拼音/别名, 带分面统计)
+	SearchPlayers(context.Context, *SearchPlayersRequest) (*SearchPlayersResponse, error)
+	// -----------------------
+	// 2. 球队模块 (Team)
+	// -----------------------
+	GetTeam(context.Context, *GetTeamRequest) (*TeamResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	// 球队管理: 缩写必须是 3 个大写字母且唯一; 名称/城市/场馆/队徽按赛季保留历史
+	CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error)
+	UpdateTeam(context.Context, *UpdateTeamRequest) (*TeamResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	GetTeamHistory(context.Context, *GetTeamRequest) (*TeamHistoryResponse, error)
+	// 联盟排名 (按已结束比赛计算, 同胜率按官方规则决胜)
+	GetStandings(context.Context, *GetStandingsRequest) (*StandingsResponse, error)
+	// 球队工资总额及与工资帽/奢侈税线/土豪线的距离
+	GetTeamPayroll(context.Context, *GetTeamPayrollRequest) (*TeamPayrollResponse, error)
+	// 赛区列表 / 详情 (含该赛季的球队)
+	ListDivisions(context.Context, *ListDivisionsRequest) (*ListDivisionsResponse, error)
+	GetDivision(context.Context, *GetDivisionRequest) (*DivisionResponse, error)
+	// -----------------------
+	// 3. 比赛模块 (Match)
+	// -----------------------
+	// 获取当天的比赛列表
+	ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error)
+	// 获取比赛详情 (包括实时比分)
+	GetMatch(context.Context, *GetMatchRequest) (*MatchResponse, error)
+	// 比赛进程 (状态机): 开赛 / 结束本节 / 开始加时 / 终场 / 延期, 非法流转返回业务错误码
+	StartMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	EndPeriod(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	StartOvertime(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	FinalizeMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	PostponeMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	// 比赛时钟: 启动 / 暂停 / 校正 (持久化, 服务重启后继续走表)
+	StartClock(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	StopClock(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	ResetClock(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	// 赛程管理: 新建 / 修改 (改期) / 取消 / 批量导入 (CSV 或 JSON), 校验球队、赛季及同日冲突
+	CreateMatch(context.Context, *CreateMatchRequest) (*MatchResponse, error)
+	UpdateMatch(context.Context, *UpdateMatchRequest) (*MatchResponse, error)
+	CancelMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error)
+	ImportSchedule(context.Context, *ImportScheduleRequest) (*ImportScheduleResponse, error)
+	// 生成整个常规赛赛程 (场次/对手权重/主客场均衡/不连续三天/场馆可用), dry_run 只返回赛程和约束报告
+	GenerateSchedule(context.Context, *GenerateScheduleRequest) (*GenerateScheduleResponse, error)
+	// 订阅比赛实时比分 (先推送当前快照, 之后每处理一个事件推送一次)
+	WatchMatch(*GetMatchRequest, grpc.ServerStreamingServer[MatchUpdate]) error
+	// [核心] 比赛事件上报 (对接 Kafka)
+	RecordMatchEvent(context.Context, *RecordMatchEventRequest) (*RecordMatchEventResponse, error)
+	// 作废已上报的事件 (发送补偿事件, 冲正比分和技术统计)
+	VoidMatchEvent(context.Context, *VoidMatchEventRequest) (*RecordMatchEventResponse, error)
+	// 更正已上报的事件 (作废原事件并写入更正后的事件)
+	AmendMatchEvent(context.Context, *AmendMatchEventRequest) (*RecordMatchEventResponse, error)
+	// 获取比赛技术统计 (box score)
+	GetMatchBoxScore(context.Context, *GetMatchRequest) (*BoxScoreResponse, error)
+	// 跨比赛搜索事件 (play-by-play), 支持按球员/球队/类型/节次/比赛时钟过滤并返回分面统计
+	SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error)
+	// 获取已结束比赛的完整归档 (MongoDB, 不访问 MySQL)
+	GetGameArchive(context.Context, *GetMatchRequest) (*GameArchiveResponse, error)
+	// -----------------------
+	// 4. 运维模块 (Admin)
+	// -----------------------
+	// 把死信队列中的事件重新投递到主 Topic (问题修复后使用)
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
+	// 生成/重新生成已结束比赛的 MongoDB 归档 (历史数据补录/修复用)
+	ArchiveMatch(context.Context, *GetMatchRequest) (*GameArchiveResponse, error)
+	mustEmbedUnimplementedNBAServiceServer()
+}
+
+// UnimplementedNBAServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNBAServiceServer struct{}
+
+func (UnimplementedNBAServiceServer) CreatePlayer(context.Context, *CreatePlayerRequest) (*PlayerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePlayer not implemented")
+}
+func (UnimplementedNBAServiceServer) GetPlayer(context.Context, *GetPlayerRequest) (*PlayerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPlayer not implemented")
 }
 func (UnimplementedNBAServiceServer) UpdatePlayer(context.Context, *UpdatePlayerRequest) (*PlayerResponse, error) {
@@ -250,236 +930,1216 @@ func (UnimplementedNBAServiceServer) ListPlayers(context.Context, *ListPlayersRe
 func (UnimplementedNBAServiceServer) GetPlayersByTeam(context.Context, *GetPlayersByTeamRequest) (*ListPlayersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPlayersByTeam not implemented")
 }
+func (UnimplementedNBAServiceServer) GetRoster(context.Context, *GetRosterRequest) (*RosterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoster not implemented")
+}
+func (UnimplementedNBAServiceServer) AddToRoster(context.Context, *AddToRosterRequest) (*PlayerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddToRoster not implemented")
+}
+func (UnimplementedNBAServiceServer) ReleasePlayer(context.Context, *ReleasePlayerRequest) (*PlayerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleasePlayer not implemented")
+}
+func (UnimplementedNBAServiceServer) ChangeRosterSlot(context.Context, *ChangeRosterSlotRequest) (*PlayerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeRosterSlot not implemented")
+}
+func (UnimplementedNBAServiceServer) TradePlayers(context.Context, *TradePlayersRequest) (*TradeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TradePlayers not implemented")
+}
+func (UnimplementedNBAServiceServer) ValidateTrade(context.Context, *TradePlayersRequest) (*ValidateTradeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateTrade not implemented")
+}
+func (UnimplementedNBAServiceServer) GetPlayerTeamHistory(context.Context, *GetPlayerTeamHistoryRequest) (*PlayerTeamHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlayerTeamHistory not implemented")
+}
+func (UnimplementedNBAServiceServer) CreateContract(context.Context, *CreateContractRequest) (*ContractResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateContract not implemented")
+}
+func (UnimplementedNBAServiceServer) GetPlayerContract(context.Context, *GetPlayerContractRequest) (*ContractResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlayerContract not implemented")
+}
+func (UnimplementedNBAServiceServer) ReportInjury(context.Context, *ReportInjuryRequest) (*InjuryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportInjury not implemented")
+}
+func (UnimplementedNBAServiceServer) UpdateInjury(context.Context, *UpdateInjuryRequest) (*InjuryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInjury not implemented")
+}
+func (UnimplementedNBAServiceServer) GetInjuryReport(context.Context, *GetInjuryReportRequest) (*InjuryReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInjuryReport not implemented")
+}
+func (UnimplementedNBAServiceServer) CreateDraft(context.Context, *CreateDraftRequest) (*DraftBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDraft not implemented")
+}
+func (UnimplementedNBAServiceServer) RunDraftLottery(context.Context, *RunDraftLotteryRequest) (*DraftBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunDraftLottery not implemented")
+}
+func (UnimplementedNBAServiceServer) AddProspect(context.Context, *AddProspectRequest) (*ProspectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddProspect not implemented")
+}
+func (UnimplementedNBAServiceServer) MakePick(context.Context, *MakePickRequest) (*MakePickResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MakePick not implemented")
+}
+func (UnimplementedNBAServiceServer) GetDraftBoard(context.Context, *GetDraftBoardRequest) (*DraftBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDraftBoard not implemented")
+}
+func (UnimplementedNBAServiceServer) SearchPlayers(context.Context, *SearchPlayersRequest) (*SearchPlayersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchPlayers not implemented")
+}
 func (UnimplementedNBAServiceServer) GetTeam(context.Context, *GetTeamRequest) (*TeamResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTeam not implemented")
 }
 func (UnimplementedNBAServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTeams not implemented")
 }
-func (UnimplementedNBAServiceServer) ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListMatches not implemented")
+func (UnimplementedNBAServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedNBAServiceServer) UpdateTeam(context.Context, *UpdateTeamRequest) (*TeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTeam not implemented")
+}
+func (UnimplementedNBAServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedNBAServiceServer) GetTeamHistory(context.Context, *GetTeamRequest) (*TeamHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamHistory not implemented")
+}
+func (UnimplementedNBAServiceServer) GetStandings(context.Context, *GetStandingsRequest) (*StandingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedNBAServiceServer) GetTeamPayroll(context.Context, *GetTeamPayrollRequest) (*TeamPayrollResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamPayroll not implemented")
+}
+func (UnimplementedNBAServiceServer) ListDivisions(context.Context, *ListDivisionsRequest) (*ListDivisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDivisions not implemented")
+}
+func (UnimplementedNBAServiceServer) GetDivision(context.Context, *GetDivisionRequest) (*DivisionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDivision not implemented")
+}
+func (UnimplementedNBAServiceServer) ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMatches not implemented")
+}
+func (UnimplementedNBAServiceServer) GetMatch(context.Context, *GetMatchRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) StartMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) EndPeriod(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndPeriod not implemented")
+}
+func (UnimplementedNBAServiceServer) StartOvertime(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartOvertime not implemented")
+}
+func (UnimplementedNBAServiceServer) FinalizeMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinalizeMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) PostponeMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostponeMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) StartClock(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartClock not implemented")
+}
+func (UnimplementedNBAServiceServer) StopClock(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopClock not implemented")
+}
+func (UnimplementedNBAServiceServer) ResetClock(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetClock not implemented")
+}
+func (UnimplementedNBAServiceServer) CreateMatch(context.Context, *CreateMatchRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) UpdateMatch(context.Context, *UpdateMatchRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) CancelMatch(context.Context, *MatchTransitionRequest) (*MatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) ImportSchedule(context.Context, *ImportScheduleRequest) (*ImportScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportSchedule not implemented")
+}
+func (UnimplementedNBAServiceServer) GenerateSchedule(context.Context, *GenerateScheduleRequest) (*GenerateScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateSchedule not implemented")
+}
+func (UnimplementedNBAServiceServer) WatchMatch(*GetMatchRequest, grpc.ServerStreamingServer[MatchUpdate]) error {
+	return status.Error(codes.Unimplemented, "method WatchMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) RecordMatchEvent(context.Context, *RecordMatchEventRequest) (*RecordMatchEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordMatchEvent not implemented")
+}
+func (UnimplementedNBAServiceServer) VoidMatchEvent(context.Context, *VoidMatchEventRequest) (*RecordMatchEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VoidMatchEvent not implemented")
+}
+func (UnimplementedNBAServiceServer) AmendMatchEvent(context.Context, *AmendMatchEventRequest) (*RecordMatchEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AmendMatchEvent not implemented")
+}
+func (UnimplementedNBAServiceServer) GetMatchBoxScore(context.Context, *GetMatchRequest) (*BoxScoreResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMatchBoxScore not implemented")
+}
+func (UnimplementedNBAServiceServer) SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchEvents not implemented")
+}
+func (UnimplementedNBAServiceServer) GetGameArchive(context.Context, *GetMatchRequest) (*GameArchiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGameArchive not implemented")
+}
+func (UnimplementedNBAServiceServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedNBAServiceServer) ArchiveMatch(context.Context, *GetMatchRequest) (*GameArchiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveMatch not implemented")
+}
+func (UnimplementedNBAServiceServer) mustEmbedUnimplementedNBAServiceServer() {}
+func (UnimplementedNBAServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeNBAServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NBAServiceServer will
+// result in compilation errors.
+type UnsafeNBAServiceServer interface {
+	mustEmbedUnimplementedNBAServiceServer()
+}
+
+func RegisterNBAServiceServer(s grpc.ServiceRegistrar, srv NBAServiceServer) {
+	// If the following call panics, it indicates UnimplementedNBAServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NBAService_ServiceDesc, srv)
+}
+
+func _NBAService_CreatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).CreatePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_CreatePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).CreatePlayer(ctx, req.(*CreatePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetPlayer(ctx, req.(*GetPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_UpdatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).UpdatePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_UpdatePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).UpdatePlayer(ctx, req.(*UpdatePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_DeletePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).DeletePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_DeletePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).DeletePlayer(ctx, req.(*DeletePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ListPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ListPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ListPlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ListPlayers(ctx, req.(*ListPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetPlayersByTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayersByTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetPlayersByTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetPlayersByTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetPlayersByTeam(ctx, req.(*GetPlayersByTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetRoster(ctx, req.(*GetRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_AddToRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).AddToRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_AddToRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).AddToRoster(ctx, req.(*AddToRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ReleasePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ReleasePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ReleasePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ReleasePlayer(ctx, req.(*ReleasePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ChangeRosterSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRosterSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ChangeRosterSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ChangeRosterSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ChangeRosterSlot(ctx, req.(*ChangeRosterSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_TradePlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradePlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).TradePlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_TradePlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).TradePlayers(ctx, req.(*TradePlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ValidateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradePlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ValidateTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ValidateTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ValidateTrade(ctx, req.(*TradePlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetPlayerTeamHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerTeamHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetPlayerTeamHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetPlayerTeamHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetPlayerTeamHistory(ctx, req.(*GetPlayerTeamHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).CreateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_CreateContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).CreateContract(ctx, req.(*CreateContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetPlayerContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetPlayerContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetPlayerContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetPlayerContract(ctx, req.(*GetPlayerContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ReportInjury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportInjuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ReportInjury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ReportInjury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ReportInjury(ctx, req.(*ReportInjuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_UpdateInjury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInjuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).UpdateInjury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_UpdateInjury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).UpdateInjury(ctx, req.(*UpdateInjuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetInjuryReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInjuryReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetInjuryReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetInjuryReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetInjuryReport(ctx, req.(*GetInjuryReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_CreateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).CreateDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_CreateDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).CreateDraft(ctx, req.(*CreateDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_RunDraftLottery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDraftLotteryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).RunDraftLottery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_RunDraftLottery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).RunDraftLottery(ctx, req.(*RunDraftLotteryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_AddProspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).AddProspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_AddProspect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).AddProspect(ctx, req.(*AddProspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_MakePick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakePickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).MakePick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_MakePick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).MakePick(ctx, req.(*MakePickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetDraftBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetDraftBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetDraftBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetDraftBoard(ctx, req.(*GetDraftBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_SearchPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).SearchPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_SearchPlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).SearchPlayers(ctx, req.(*SearchPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).UpdateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_UpdateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetTeamHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetTeamHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetTeamHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetTeamHistory(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetStandings(ctx, req.(*GetStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetTeamPayroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamPayrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetTeamPayroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetTeamPayroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetTeamPayroll(ctx, req.(*GetTeamPayrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ListDivisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDivisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ListDivisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ListDivisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ListDivisions(ctx, req.(*ListDivisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDivisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetDivision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetDivision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetDivision(ctx, req.(*GetDivisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ListMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ListMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ListMatches(ctx, req.(*ListMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_GetMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).GetMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_GetMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).GetMatch(ctx, req.(*GetMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_StartMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).StartMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_StartMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).StartMatch(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_EndPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).EndPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_EndPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).EndPeriod(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_StartOvertime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).StartOvertime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_StartOvertime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).StartOvertime(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_FinalizeMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).FinalizeMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_FinalizeMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).FinalizeMatch(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_PostponeMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).PostponeMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_PostponeMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).PostponeMatch(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNBAServiceServer) GetMatch(context.Context, *GetMatchRequest) (*MatchResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetMatch not implemented")
+
+func _NBAService_StartClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).StartClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_StartClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).StartClock(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNBAServiceServer) RecordMatchEvent(context.Context, *RecordMatchEventRequest) (*RecordMatchEventResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RecordMatchEvent not implemented")
+
+func _NBAService_StopClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).StopClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_StopClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).StopClock(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNBAServiceServer) mustEmbedUnimplementedNBAServiceServer() {}
-func (UnimplementedNBAServiceServer) testEmbeddedByValue()                    {}
 
-// UnsafeNBAServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NBAServiceServer will
-// result in compilation errors.
-type UnsafeNBAServiceServer interface {
-	mustEmbedUnimplementedNBAServiceServer()
+func _NBAService_ResetClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ResetClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ResetClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ResetClock(ctx, req.(*MatchTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterNBAServiceServer(s grpc.ServiceRegistrar, srv NBAServiceServer) {
-	// If the following call panics, it indicates UnimplementedNBAServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _NBAService_CreateMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&NBAService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(NBAServiceServer).CreateMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_CreateMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).CreateMatch(ctx, req.(*CreateMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_CreatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePlayerRequest)
+func _NBAService_UpdateMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).CreatePlayer(ctx, in)
+		return srv.(NBAServiceServer).UpdateMatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_CreatePlayer_FullMethodName,
+		FullMethod: NBAService_UpdateMatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).CreatePlayer(ctx, req.(*CreatePlayerRequest))
+		return srv.(NBAServiceServer).UpdateMatch(ctx, req.(*UpdateMatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_GetPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPlayerRequest)
+func _NBAService_CancelMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTransitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).GetPlayer(ctx, in)
+		return srv.(NBAServiceServer).CancelMatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_GetPlayer_FullMethodName,
+		FullMethod: NBAService_CancelMatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).GetPlayer(ctx, req.(*GetPlayerRequest))
+		return srv.(NBAServiceServer).CancelMatch(ctx, req.(*MatchTransitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_UpdatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePlayerRequest)
+func _NBAService_ImportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).UpdatePlayer(ctx, in)
+		return srv.(NBAServiceServer).ImportSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_UpdatePlayer_FullMethodName,
+		FullMethod: NBAService_ImportSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).UpdatePlayer(ctx, req.(*UpdatePlayerRequest))
+		return srv.(NBAServiceServer).ImportSchedule(ctx, req.(*ImportScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_DeletePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePlayerRequest)
+func _NBAService_GenerateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).DeletePlayer(ctx, in)
+		return srv.(NBAServiceServer).GenerateSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_DeletePlayer_FullMethodName,
+		FullMethod: NBAService_GenerateSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).DeletePlayer(ctx, req.(*DeletePlayerRequest))
+		return srv.(NBAServiceServer).GenerateSchedule(ctx, req.(*GenerateScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_ListPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPlayersRequest)
+func _NBAService_WatchMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NBAServiceServer).WatchMatch(m, &grpc.GenericServerStream[GetMatchRequest, MatchUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NBAService_WatchMatchServer = grpc.ServerStreamingServer[MatchUpdate]
+
+func _NBAService_RecordMatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMatchEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).ListPlayers(ctx, in)
+		return srv.(NBAServiceServer).RecordMatchEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_ListPlayers_FullMethodName,
+		FullMethod: NBAService_RecordMatchEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).ListPlayers(ctx, req.(*ListPlayersRequest))
+		return srv.(NBAServiceServer).RecordMatchEvent(ctx, req.(*RecordMatchEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_GetPlayersByTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPlayersByTeamRequest)
+func _NBAService_VoidMatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidMatchEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).GetPlayersByTeam(ctx, in)
+		return srv.(NBAServiceServer).VoidMatchEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_GetPlayersByTeam_FullMethodName,
+		FullMethod: NBAService_VoidMatchEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).GetPlayersByTeam(ctx, req.(*GetPlayersByTeamRequest))
+		return srv.(NBAServiceServer).VoidMatchEvent(ctx, req.(*VoidMatchEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTeamRequest)
+func _NBAService_AmendMatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendMatchEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).GetTeam(ctx, in)
+		return srv.(NBAServiceServer).AmendMatchEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_GetTeam_FullMethodName,
+		FullMethod: NBAService_AmendMatchEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+		return srv.(NBAServiceServer).AmendMatchEvent(ctx, req.(*AmendMatchEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTeamsRequest)
+func _NBAService_GetMatchBoxScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).ListTeams(ctx, in)
+		return srv.(NBAServiceServer).GetMatchBoxScore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_ListTeams_FullMethodName,
+		FullMethod: NBAService_GetMatchBoxScore_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+		return srv.(NBAServiceServer).GetMatchBoxScore(ctx, req.(*GetMatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMatchesRequest)
+func _NBAService_SearchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).ListMatches(ctx, in)
+		return srv.(NBAServiceServer).SearchEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_ListMatches_FullMethodName,
+		FullMethod: NBAService_SearchEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).ListMatches(ctx, req.(*ListMatchesRequest))
+		return srv.(NBAServiceServer).SearchEvents(ctx, req.(*SearchEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_GetMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _NBAService_GetGameArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).GetMatch(ctx, in)
+		return srv.(NBAServiceServer).GetGameArchive(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_GetMatch_FullMethodName,
+		FullMethod: NBAService_GetGameArchive_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).GetMatch(ctx, req.(*GetMatchRequest))
+		return srv.(NBAServiceServer).GetGameArchive(ctx, req.(*GetMatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NBAService_RecordMatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordMatchEventRequest)
+func _NBAService_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NBAServiceServer).RecordMatchEvent(ctx, in)
+		return srv.(NBAServiceServer).ReplayDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NBAService_RecordMatchEvent_FullMethodName,
+		FullMethod: NBAService_ReplayDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NBAServiceServer).RecordMatchEvent(ctx, req.(*RecordMatchEventRequest))
+		return srv.(NBAServiceServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NBAService_ArchiveMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NBAServiceServer).ArchiveMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NBAService_ArchiveMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NBAServiceServer).ArchiveMatch(ctx, req.(*GetMatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -515,6 +2175,78 @@ var NBAService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlayersByTeam",
 			Handler:    _NBAService_GetPlayersByTeam_Handler,
 		},
+		{
+			MethodName: "GetRoster",
+			Handler:    _NBAService_GetRoster_Handler,
+		},
+		{
+			MethodName: "AddToRoster",
+			Handler:    _NBAService_AddToRoster_Handler,
+		},
+		{
+			MethodName: "ReleasePlayer",
+			Handler:    _NBAService_ReleasePlayer_Handler,
+		},
+		{
+			MethodName: "ChangeRosterSlot",
+			Handler:    _NBAService_ChangeRosterSlot_Handler,
+		},
+		{
+			MethodName: "TradePlayers",
+			Handler:    _NBAService_TradePlayers_Handler,
+		},
+		{
+			MethodName: "ValidateTrade",
+			Handler:    _NBAService_ValidateTrade_Handler,
+		},
+		{
+			MethodName: "GetPlayerTeamHistory",
+			Handler:    _NBAService_GetPlayerTeamHistory_Handler,
+		},
+		{
+			MethodName: "CreateContract",
+			Handler:    _NBAService_CreateContract_Handler,
+		},
+		{
+			MethodName: "GetPlayerContract",
+			Handler:    _NBAService_GetPlayerContract_Handler,
+		},
+		{
+			MethodName: "ReportInjury",
+			Handler:    _NBAService_ReportInjury_Handler,
+		},
+		{
+			MethodName: "UpdateInjury",
+			Handler:    _NBAService_UpdateInjury_Handler,
+		},
+		{
+			MethodName: "GetInjuryReport",
+			Handler:    _NBAService_GetInjuryReport_Handler,
+		},
+		{
+			MethodName: "CreateDraft",
+			Handler:    _NBAService_CreateDraft_Handler,
+		},
+		{
+			MethodName: "RunDraftLottery",
+			Handler:    _NBAService_RunDraftLottery_Handler,
+		},
+		{
+			MethodName: "AddProspect",
+			Handler:    _NBAService_AddProspect_Handler,
+		},
+		{
+			MethodName: "MakePick",
+			Handler:    _NBAService_MakePick_Handler,
+		},
+		{
+			MethodName: "GetDraftBoard",
+			Handler:    _NBAService_GetDraftBoard_Handler,
+		},
+		{
+			MethodName: "SearchPlayers",
+			Handler:    _NBAService_SearchPlayers_Handler,
+		},
 		{
 			MethodName: "GetTeam",
 			Handler:    _NBAService_GetTeam_Handler,
@@ -523,6 +2255,38 @@ var NBAService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTeams",
 			Handler:    _NBAService_ListTeams_Handler,
 		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _NBAService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "UpdateTeam",
+			Handler:    _NBAService_UpdateTeam_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _NBAService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "GetTeamHistory",
+			Handler:    _NBAService_GetTeamHistory_Handler,
+		},
+		{
+			MethodName: "GetStandings",
+			Handler:    _NBAService_GetStandings_Handler,
+		},
+		{
+			MethodName: "GetTeamPayroll",
+			Handler:    _NBAService_GetTeamPayroll_Handler,
+		},
+		{
+			MethodName: "ListDivisions",
+			Handler:    _NBAService_ListDivisions_Handler,
+		},
+		{
+			MethodName: "GetDivision",
+			Handler:    _NBAService_GetDivision_Handler,
+		},
 		{
 			MethodName: "ListMatches",
 			Handler:    _NBAService_ListMatches_Handler,
@@ -531,11 +2295,97 @@ var NBAService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMatch",
 			Handler:    _NBAService_GetMatch_Handler,
 		},
+		{
+			MethodName: "StartMatch",
+			Handler:    _NBAService_StartMatch_Handler,
+		},
+		{
+			MethodName: "EndPeriod",
+			Handler:    _NBAService_EndPeriod_Handler,
+		},
+		{
+			MethodName: "StartOvertime",
+			Handler:    _NBAService_StartOvertime_Handler,
+		},
+		{
+			MethodName: "FinalizeMatch",
+			Handler:    _NBAService_FinalizeMatch_Handler,
+		},
+		{
+			MethodName: "PostponeMatch",
+			Handler:    _NBAService_PostponeMatch_Handler,
+		},
+		{
+			MethodName: "StartClock",
+			Handler:    _NBAService_StartClock_Handler,
+		},
+		{
+			MethodName: "StopClock",
+			Handler:    _NBAService_StopClock_Handler,
+		},
+		{
+			MethodName: "ResetClock",
+			Handler:    _NBAService_ResetClock_Handler,
+		},
+		{
+			MethodName: "CreateMatch",
+			Handler:    _NBAService_CreateMatch_Handler,
+		},
+		{
+			MethodName: "UpdateMatch",
+			Handler:    _NBAService_UpdateMatch_Handler,
+		},
+		{
+			MethodName: "CancelMatch",
+			Handler:    _NBAService_CancelMatch_Handler,
+		},
+		{
+			MethodName: "ImportSchedule",
+			Handler:    _NBAService_ImportSchedule_Handler,
+		},
+		{
+			MethodName: "GenerateSchedule",
+			Handler:    _NBAService_GenerateSchedule_Handler,
+		},
 		{
 			MethodName: "RecordMatchEvent",
 			Handler:    _NBAService_RecordMatchEvent_Handler,
 		},
+		{
+			MethodName: "VoidMatchEvent",
+			Handler:    _NBAService_VoidMatchEvent_Handler,
+		},
+		{
+			MethodName: "AmendMatchEvent",
+			Handler:    _NBAService_AmendMatchEvent_Handler,
+		},
+		{
+			MethodName: "GetMatchBoxScore",
+			Handler:    _NBAService_GetMatchBoxScore_Handler,
+		},
+		{
+			MethodName: "SearchEvents",
+			Handler:    _NBAService_SearchEvents_Handler,
+		},
+		{
+			MethodName: "GetGameArchive",
+			Handler:    _NBAService_GetGameArchive_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _NBAService_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "ArchiveMatch",
+			Handler:    _NBAService_ArchiveMatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMatch",
+			Handler:       _NBAService_WatchMatch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/v1/nba_service.proto",
 }
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+
+	pb "nba-remake/api/proto/v1"
+)
+
+// lineScoreRow 单队的逐节得分
+type lineScoreRow struct {
+	TeamID       int32   `json:"team_id"`
+	Abbreviation string  `json:"abbreviation"`
+	Scores       []int32 `json:"scores"` // 与 lineScore.Periods 一一对应
+	Total        int32   `json:"total"`
+}
+
+// lineScore 转播用的逐节比分表
+//
+//	      1   2   3   4  OT1   T
+//	LAL  28  31  25  26   9  119
+//	BOS  30  27  24  29   7  117
+type lineScore struct {
+	MatchID int64         `json:"match_id"`
+	Status  int32         `json:"status"`
+	Periods []string      `json:"periods"` // 表头: "1".."4", 加时为 "OT1", "OT2"...
+	Visitor *lineScoreRow `json:"visitor"` // 习惯上客队在上
+	Home    *lineScoreRow `json:"home"`
+}
+
+// renderLineScore 把 MatchResponse 的单节比分整理成表格; 未开始的比赛也给出 4 节空表头
+func renderLineScore(m *pb.MatchResponse) *lineScore {
+	ls := &lineScore{
+		MatchID: m.Id,
+		Status:  m.Status,
+		Home:    &lineScoreRow{TeamID: m.HomeTeamId, Total: m.HomeScore},
+		Visitor: &lineScoreRow{TeamID: m.VisitorTeamId, Total: m.VisitorScore},
+	}
+	if m.HomeTeam != nil {
+		ls.Home.Abbreviation = m.HomeTeam.Abbreviation
+	}
+	if m.VisitorTeam != nil {
+		ls.Visitor.Abbreviation = m.VisitorTeam.Abbreviation
+	}
+
+	periods := len(m.PeriodScores)
+	if periods < 4 {
+		periods = 4
+	}
+	ls.Home.Scores = make([]int32, periods)
+	ls.Visitor.Scores = make([]int32, periods)
+	for i := 1; i <= periods; i++ {
+		if i <= 4 {
+			ls.Periods = append(ls.Periods, fmt.Sprint(i))
+		} else {
+			ls.Periods = append(ls.Periods, fmt.Sprintf("OT%d", i-4))
+		}
+	}
+	for _, ps := range m.PeriodScores {
+		if ps.Period < 1 || int(ps.Period) > periods {
+			continue
+		}
+		ls.Home.Scores[ps.Period-1] = ps.HomeScore
+		ls.Visitor.Scores[ps.Period-1] = ps.VisitorScore
+	}
+	return ls
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"io"
 	"log"
 	myErrors "nba-remake/errors"
 	"net/http"
@@ -11,7 +12,9 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	pb "nba-remake/api/proto/v1"
 )
@@ -63,6 +66,28 @@ func main() {
 		c.JSON(http.StatusOK, resp)
 	})
 
+	// 球员搜索 (ES): 支持拼音/别名/拼写错误, 返回分面统计
+	r.GET("/api/players/search", func(c *gin.Context) {
+		page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+		pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+		teamID, _ := strconv.Atoi(c.Query("team_id"))
+		status, _ := strconv.Atoi(c.Query("status"))
+
+		resp, err := client.SearchPlayers(context.Background(), &pb.SearchPlayersRequest{
+			Query:    c.Query("q"),
+			TeamId:   int32(teamID),
+			Position: pb.Position(pb.Position_value[c.Query("position")]),
+			Status:   pb.PlayerStatus(status),
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
 	r.GET("/api/players/:id", func(c *gin.Context) {
 		idStr := c.Param("id")
 		id, _ := strconv.ParseInt(idStr, 10, 64)
@@ -109,7 +134,11 @@ func main() {
 
 	// 球队相关路由
 	r.GET("/api/teams", func(c *gin.Context) {
-		resp, err := client.ListTeams(context.Background(), &pb.ListTeamsRequest{})
+		resp, err := client.ListTeams(context.Background(), &pb.ListTeamsRequest{
+			Season:     c.Query("season"),
+			Conference: c.Query("conference"),
+			Division:   c.Query("division"),
+		})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -121,7 +150,7 @@ func main() {
 		idStr := c.Param("id")
 		id, _ := strconv.ParseInt(idStr, 10, 64)
 
-		resp, err := client.GetTeam(context.Background(), &pb.GetTeamRequest{Id: int32(id)})
+		resp, err := client.GetTeam(context.Background(), &pb.GetTeamRequest{Id: int32(id), Season: c.Query("season")})
 		if err != nil {
 			c.JSON(http.StatusNotFound, gin.H{"error": myErrors.NewError(myErrors.CodeTeamNotFound, "球队未找到", "")})
 			return
@@ -129,6 +158,489 @@ func main() {
 		c.JSON(http.StatusOK, resp)
 	})
 
+	// 球队阵容及名额使用情况
+	r.GET("/api/teams/:id/roster", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.GetRoster(context.Background(), &pb.GetRosterRequest{TeamId: int32(id)})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 自由球员签入阵容, slot 为 STANDARD (默认) 或 TWO_WAY
+	r.POST("/api/teams/:id/roster", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		var req struct {
+			PlayerID     int32  `json:"player_id"`
+			JerseyNumber int32  `json:"jersey_number"`
+			Slot         string `json:"slot"`
+			Date         string `json:"date"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.AddToRoster(context.Background(), &pb.AddToRosterRequest{
+			PlayerId:     req.PlayerID,
+			TeamId:       int32(id),
+			JerseyNumber: req.JerseyNumber,
+			Slot:         pb.RosterSlot(pb.RosterSlot_value[req.Slot]),
+			Date:         req.Date,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 裁掉球员: ?waive=true 记为 WAIVER, ?date=2024-01-10 生效日期
+	r.POST("/api/players/:id/release", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.ReleasePlayer(context.Background(), &pb.ReleasePlayerRequest{
+			PlayerId: int32(id),
+			Waive:    c.Query("waive") == "true",
+			Date:     c.Query("date"),
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 标准合同/双向合同互转
+	r.POST("/api/players/:id/roster-slot", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		var req struct {
+			Slot string `json:"slot"`
+			Date string `json:"date"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.ChangeRosterSlot(context.Background(), &pb.ChangeRosterSlotRequest{
+			PlayerId: int32(id),
+			Slot:     pb.RosterSlot(pb.RosterSlot_value[req.Slot]),
+			Date:     req.Date,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 球员异动记录及效力区间, ?date=2024-01-10 返回当天所在球队
+	r.GET("/api/players/:id/history", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.GetPlayerTeamHistory(context.Background(), &pb.GetPlayerTeamHistoryRequest{
+			PlayerId: int32(id),
+			Date:     c.Query("date"),
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 交易: assets 为 [{from_team_id, to_team_id, player_id | pick_id}]
+	r.POST("/api/trades", func(c *gin.Context) {
+		var req tradeBody
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+		resp, err := client.TradePlayers(context.Background(), req.toProto())
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 交易预检: 返回全部违反的交易规则, 不修改数据
+	r.POST("/api/trades/validate", func(c *gin.Context) {
+		var req tradeBody
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+		resp, err := client.ValidateTrade(context.Background(), req.toProto())
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 球队工资: ?season=2023-24 (默认当前赛季)
+	r.GET("/api/teams/:id/payroll", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.GetTeamPayroll(context.Background(), &pb.GetTeamPayrollRequest{TeamId: int32(id), Season: c.Query("season")})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 球员合同
+	r.GET("/api/players/:id/contract", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.GetPlayerContract(context.Background(), &pb.GetPlayerContractRequest{PlayerId: int32(id)})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 新建合同: seasons 为 [{season, salary, guaranteed, option}], option 为 ContractOption 枚举名
+	r.POST("/api/players/:id/contract", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		var req struct {
+			SignedDate string `json:"signed_date"`
+			Seasons    []struct {
+				Season     string `json:"season"`
+				Salary     int64  `json:"salary"`
+				Guaranteed int64  `json:"guaranteed"`
+				Option     string `json:"option"`
+			} `json:"seasons"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		contractReq := &pb.CreateContractRequest{PlayerId: int32(id), SignedDate: req.SignedDate}
+		for _, cs := range req.Seasons {
+			contractReq.Seasons = append(contractReq.Seasons, &pb.ContractSeason{
+				Season:     cs.Season,
+				Salary:     cs.Salary,
+				Guaranteed: cs.Guaranteed,
+				Option:     pb.ContractOption(pb.ContractOption_value[cs.Option]),
+			})
+		}
+		resp, err := client.CreateContract(context.Background(), contractReq)
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	})
+
+	// 伤病报告: date 默认当天, team_id 可选
+	r.GET("/api/injuries", func(c *gin.Context) {
+		teamID, _ := strconv.ParseInt(c.Query("team_id"), 10, 32)
+		resp, err := client.GetInjuryReport(context.Background(), &pb.GetInjuryReportRequest{Date: c.Query("date"), TeamId: int32(teamID)})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 登记伤病: designation 为 InjuryDesignation 枚举名, 默认 OUT
+	r.POST("/api/injuries", func(c *gin.Context) {
+		var req struct {
+			PlayerID       int32  `json:"player_id"`
+			BodyPart       string `json:"body_part"`
+			Description    string `json:"description"`
+			InjuryDate     string `json:"injury_date"`
+			ExpectedReturn string `json:"expected_return"`
+			Designation    string `json:"designation"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.ReportInjury(context.Background(), &pb.ReportInjuryRequest{
+			PlayerId:       req.PlayerID,
+			BodyPart:       req.BodyPart,
+			Description:    req.Description,
+			InjuryDate:     req.InjuryDate,
+			ExpectedReturn: req.ExpectedReturn,
+			Designation:    pb.InjuryDesignation(pb.InjuryDesignation_value[req.Designation]),
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	})
+
+	// 更新伤病, 未传的字段不修改; returned_date 表示复出
+	r.PUT("/api/injuries/:id", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+		var req struct {
+			Designation    string `json:"designation"`
+			ExpectedReturn string `json:"expected_return"`
+			ReturnedDate   string `json:"returned_date"`
+			Description    string `json:"description"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.UpdateInjury(context.Background(), &pb.UpdateInjuryRequest{
+			Id:             id,
+			Designation:    pb.InjuryDesignation(pb.InjuryDesignation_value[req.Designation]),
+			ExpectedReturn: req.ExpectedReturn,
+			ReturnedDate:   req.ReturnedDate,
+			Description:    req.Description,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 新建选秀年份, 为每支球队生成各轮选秀权
+	r.POST("/api/drafts", func(c *gin.Context) {
+		var req struct {
+			Year         int32  `json:"year"`
+			Season       string `json:"season"`
+			Rounds       int32  `json:"rounds"`
+			LotteryTeams int32  `json:"lottery_teams"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+		resp, err := client.CreateDraft(context.Background(), &pb.CreateDraftRequest{
+			Year:         req.Year,
+			Season:       req.Season,
+			Rounds:       req.Rounds,
+			LotteryTeams: req.LotteryTeams,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	})
+
+	// 选秀看板: ?team_id= 只看该队持有的选秀权
+	r.GET("/api/drafts/:year", func(c *gin.Context) {
+		year, _ := strconv.ParseInt(c.Param("year"), 10, 32)
+		teamID, _ := strconv.ParseInt(c.Query("team_id"), 10, 32)
+		resp, err := client.GetDraftBoard(context.Background(), &pb.GetDraftBoardRequest{Year: int32(year), TeamId: int32(teamID)})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 乐透抽签: ?seed= 为空时随机生成, 相同种子可复现结果
+	r.POST("/api/drafts/:year/lottery", func(c *gin.Context) {
+		year, _ := strconv.ParseInt(c.Param("year"), 10, 32)
+		seed, _ := strconv.ParseInt(c.Query("seed"), 10, 64)
+		resp, err := client.RunDraftLottery(context.Background(), &pb.RunDraftLotteryRequest{Year: int32(year), Seed: seed})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 新增新秀: position 为 Position 枚举名
+	r.POST("/api/drafts/:year/prospects", func(c *gin.Context) {
+		year, _ := strconv.ParseInt(c.Param("year"), 10, 32)
+		var req struct {
+			Name     string  `json:"name"`
+			Position string  `json:"position"`
+			Height   float64 `json:"height"`
+			Weight   float64 `json:"weight"`
+			Birthday string  `json:"birthday"`
+			School   string  `json:"school"`
+			Rank     int32   `json:"rank"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+		resp, err := client.AddProspect(context.Background(), &pb.AddProspectRequest{
+			Year:     int32(year),
+			Name:     req.Name,
+			Position: pb.Position(pb.Position_value[req.Position]),
+			Height:   req.Height,
+			Weight:   req.Weight,
+			Birthday: req.Birthday,
+			School:   req.School,
+			Rank:     req.Rank,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	})
+
+	// 选人: 用当前轮到的选秀权选中新秀, 新秀加入持有球队阵容
+	r.POST("/api/picks/:id/select", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		var req struct {
+			ProspectID   int32  `json:"prospect_id"`
+			JerseyNumber int32  `json:"jersey_number"`
+			Slot         string `json:"slot"`
+			Date         string `json:"date"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+		resp, err := client.MakePick(context.Background(), &pb.MakePickRequest{
+			PickId:       int32(id),
+			ProspectId:   req.ProspectID,
+			JerseyNumber: req.JerseyNumber,
+			RosterSlot:   pb.RosterSlot(pb.RosterSlot_value[req.Slot]),
+			Date:         req.Date,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 球队历史: 按赛季的名称/城市/场馆/队徽
+	r.GET("/api/teams/:id/history", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.GetTeamHistory(context.Background(), &pb.GetTeamRequest{Id: int32(id)})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 新建球队, season 为首个赛季 (默认当前赛季)
+	r.POST("/api/teams", func(c *gin.Context) {
+		var req struct {
+			Name         string `json:"name"`
+			City         string `json:"city"`
+			Abbreviation string `json:"abbreviation"`
+			Conference   string `json:"conference"`
+			LogoURL      string `json:"logo_url"`
+			HomeArena    string `json:"home_arena"`
+			DivisionID   int32  `json:"division_id"`
+			Season       string `json:"season"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.CreateTeam(context.Background(), &pb.CreateTeamRequest{
+			Name:         req.Name,
+			City:         req.City,
+			Abbreviation: req.Abbreviation,
+			Conference:   req.Conference,
+			LogoUrl:      req.LogoURL,
+			HomeArena:    req.HomeArena,
+			DivisionId:   req.DivisionID,
+			Season:       req.Season,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	})
+
+	// 修改球队 (更名/迁移等), 从 season 起生效, 未传的字段不修改
+	r.PUT("/api/teams/:id", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		var req struct {
+			Name         string `json:"name"`
+			City         string `json:"city"`
+			Abbreviation string `json:"abbreviation"`
+			Conference   string `json:"conference"`
+			LogoURL      string `json:"logo_url"`
+			HomeArena    string `json:"home_arena"`
+			DivisionID   int32  `json:"division_id"`
+			Season       string `json:"season"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.UpdateTeam(context.Background(), &pb.UpdateTeamRequest{
+			Id:           int32(id),
+			Name:         req.Name,
+			City:         req.City,
+			Abbreviation: req.Abbreviation,
+			Conference:   req.Conference,
+			LogoUrl:      req.LogoURL,
+			HomeArena:    req.HomeArena,
+			DivisionId:   req.DivisionID,
+			Season:       req.Season,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	r.DELETE("/api/teams/:id", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.DeleteTeam(context.Background(), &pb.DeleteTeamRequest{Id: int32(id)})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 赛区: ?season=2023-24&conference=West, 球队归属按赛季
+	r.GET("/api/divisions", func(c *gin.Context) {
+		resp, err := client.ListDivisions(context.Background(), &pb.ListDivisionsRequest{
+			Season:     c.Query("season"),
+			Conference: c.Query("conference"),
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	r.GET("/api/divisions/:id", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 32)
+		resp, err := client.GetDivision(context.Background(), &pb.GetDivisionRequest{Id: int32(id), Season: c.Query("season")})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 联盟排名: ?season=2023-24&conference=East (conference 可省略)
+	r.GET("/api/standings", func(c *gin.Context) {
+		resp, err := client.GetStandings(context.Background(), &pb.GetStandingsRequest{
+			Season:     c.Query("season"),
+			Conference: c.Query("conference"),
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
 	// 比赛相关路由（你原有的代码）
 	r.GET("/api/matches", func(c *gin.Context) {
 		date := c.Query("date")
@@ -156,45 +668,477 @@ func main() {
 		c.JSON(http.StatusOK, resp)
 	})
 
+	r.GET("/api/matches/:id/boxscore", func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, _ := strconv.ParseInt(idStr, 10, 64)
+
+		resp, err := client.GetMatchBoxScore(context.Background(), &pb.GetMatchRequest{Id: id})
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "技术统计未找到"})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 逐节比分 (line score)
+	r.GET("/api/matches/:id/linescore", func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, _ := strconv.ParseInt(idStr, 10, 64)
+
+		resp, err := client.GetMatch(context.Background(), &pb.GetMatchRequest{Id: id})
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "比赛未找到"})
+			return
+		}
+		c.JSON(http.StatusOK, renderLineScore(resp))
+	})
+
+	// 历史比赛完整归档 (MongoDB)
+	r.GET("/api/matches/:id/archive", func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, _ := strconv.ParseInt(idStr, 10, 64)
+
+		resp, err := client.GetGameArchive(context.Background(), &pb.GetMatchRequest{Id: id})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				c.JSON(http.StatusNotFound, gin.H{"error": "比赛归档不存在"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 实时比分 (SSE): 先推一次当前快照, 之后每个事件推一次
+	r.GET("/api/matches/:id/live", func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, _ := strconv.ParseInt(idStr, 10, 64)
+
+		// 客户端断开时 Request.Context 取消, gRPC 流随之关闭
+		stream, err := client.WatchMatch(c.Request.Context(), &pb.GetMatchRequest{Id: id})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+		c.Stream(func(w io.Writer) bool {
+			update, err := stream.Recv()
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					c.SSEvent("error", "比赛未找到")
+				}
+				return false
+			}
+			c.SSEvent("update", update)
+			return true
+		})
+	})
+
+	// 事件搜索 (ES): e.g. /api/events/search?player_id=23&type=SHOT_MADE&sub_type=dunk&quarter=4&max_time_remaining=02:00
+	r.GET("/api/events/search", func(c *gin.Context) {
+		page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+		pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+		matchID, _ := strconv.ParseInt(c.Query("match_id"), 10, 64)
+		playerID, _ := strconv.Atoi(c.Query("player_id"))
+		teamID, _ := strconv.Atoi(c.Query("team_id"))
+		quarter, _ := strconv.Atoi(c.Query("quarter"))
+
+		resp, err := client.SearchEvents(context.Background(), &pb.SearchEventsRequest{
+			Query:            c.Query("q"),
+			MatchId:          matchID,
+			PlayerId:         int32(playerID),
+			TeamId:           int32(teamID),
+			Season:           c.Query("season"),
+			Type:             pb.EventType(pb.EventType_value[c.Query("type")]),
+			ShotType:         pb.ShotType(pb.ShotType_value[c.Query("shot_type")]),
+			SubType:          c.Query("sub_type"),
+			Quarter:          int32(quarter),
+			MinTimeRemaining: c.Query("min_time_remaining"),
+			MaxTimeRemaining: c.Query("max_time_remaining"),
+			IncludeVoided:    c.Query("include_voided") == "true",
+			Page:             int32(page),
+			PageSize:         int32(pageSize),
+		})
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 赛程管理: 新建比赛
+	r.POST("/api/matches", func(c *gin.Context) {
+		var req struct {
+			Date          string `json:"date"`
+			StartTime     string `json:"start_time"`
+			HomeTeamID    int32  `json:"home_team_id"`
+			VisitorTeamID int32  `json:"visitor_team_id"`
+			Season        string `json:"season"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.CreateMatch(context.Background(), &pb.CreateMatchRequest{
+			Date:          req.Date,
+			StartTime:     req.StartTime,
+			HomeTeamId:    req.HomeTeamID,
+			VisitorTeamId: req.VisitorTeamID,
+			Season:        req.Season,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, resp)
+	})
+
+	// 修改赛程 (改期/更换对阵), 未传的字段不修改
+	r.PUT("/api/matches/:id", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+		var req struct {
+			Date          string `json:"date"`
+			StartTime     string `json:"start_time"`
+			HomeTeamID    int32  `json:"home_team_id"`
+			VisitorTeamID int32  `json:"visitor_team_id"`
+			Season        string `json:"season"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.UpdateMatch(context.Background(), &pb.UpdateMatchRequest{
+			Id:            id,
+			Date:          req.Date,
+			StartTime:     req.StartTime,
+			HomeTeamId:    req.HomeTeamID,
+			VisitorTeamId: req.VisitorTeamID,
+			Season:        req.Season,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 批量导入赛程: body 为 CSV 或 JSON 原文, ?format=csv|json (默认按 Content-Type), ?dry_run=true 只校验
+	r.POST("/api/schedule/import", func(c *gin.Context) {
+		data, err := c.GetRawData()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+		format := c.Query("format")
+		if format == "" {
+			format = "csv"
+			if c.ContentType() == "application/json" {
+				format = "json"
+			}
+		}
+		dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+
+		resp, err := client.ImportSchedule(context.Background(), &pb.ImportScheduleRequest{
+			Format: format,
+			Data:   data,
+			DryRun: dryRun,
+		})
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		if len(resp.Errors) > 0 {
+			c.JSON(http.StatusUnprocessableEntity, resp)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 生成常规赛赛程: body 为生成参数, dry_run=true 时只返回赛程和约束报告
+	r.POST("/api/schedule/generate", func(c *gin.Context) {
+		var req struct {
+			Season           string  `json:"season"`
+			StartDate        string  `json:"start_date"`
+			EndDate          string  `json:"end_date"`
+			GamesPerTeam     int32   `json:"games_per_team"`
+			DivisionWeight   float64 `json:"division_weight"`
+			ConferenceWeight float64 `json:"conference_weight"`
+			OtherWeight      float64 `json:"other_weight"`
+			StartTime        string  `json:"start_time"`
+			MaxGamesPerDay   int32   `json:"max_games_per_day"`
+			Seed             int64   `json:"seed"`
+			DryRun           bool    `json:"dry_run"`
+			Divisions        []struct {
+				TeamID   int32  `json:"team_id"`
+				Division string `json:"division"`
+			} `json:"divisions"`
+			ArenaBlackouts []struct {
+				Arena string `json:"arena"`
+				Date  string `json:"date"`
+			} `json:"arena_blackouts"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		in := &pb.GenerateScheduleRequest{
+			Season:           req.Season,
+			StartDate:        req.StartDate,
+			EndDate:          req.EndDate,
+			GamesPerTeam:     req.GamesPerTeam,
+			DivisionWeight:   req.DivisionWeight,
+			ConferenceWeight: req.ConferenceWeight,
+			OtherWeight:      req.OtherWeight,
+			StartTime:        req.StartTime,
+			MaxGamesPerDay:   req.MaxGamesPerDay,
+			Seed:             req.Seed,
+			DryRun:           req.DryRun,
+		}
+		for _, d := range req.Divisions {
+			in.Divisions = append(in.Divisions, &pb.TeamDivision{TeamId: d.TeamID, Division: d.Division})
+		}
+		for _, b := range req.ArenaBlackouts {
+			in.ArenaBlackouts = append(in.ArenaBlackouts, &pb.ArenaBlackout{Arena: b.Arena, Date: b.Date})
+		}
+
+		resp, err := client.GenerateSchedule(context.Background(), in)
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		if !resp.DryRun && !resp.Report.Ok {
+			c.JSON(http.StatusUnprocessableEntity, resp)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 比赛进程: start / end-period / overtime / finalize / postpone / cancel
+	// 比赛时钟: clock-start / clock-stop / clock-reset
+	r.POST("/api/matches/:id/:action", func(c *gin.Context) {
+		id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+		var body struct {
+			Period        int32  `json:"period"`         // end-period: 要结束的节次, 0 不校验
+			Reason        string `json:"reason"`         // postpone/cancel: 延期/取消原因
+			TimeRemaining string `json:"time_remaining"` // clock-reset: 校正后的剩余时间, 为空重置为整节
+		}
+		// body 可以为空
+		if c.Request.ContentLength > 0 {
+			if err := c.BindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+				return
+			}
+		}
+
+		req := &pb.MatchTransitionRequest{MatchId: id, Period: body.Period, Reason: body.Reason, TimeRemaining: body.TimeRemaining}
+		var resp *pb.MatchResponse
+		var err error
+		switch c.Param("action") {
+		case "start":
+			resp, err = client.StartMatch(context.Background(), req)
+		case "end-period":
+			resp, err = client.EndPeriod(context.Background(), req)
+		case "overtime":
+			resp, err = client.StartOvertime(context.Background(), req)
+		case "finalize":
+			resp, err = client.FinalizeMatch(context.Background(), req)
+		case "postpone":
+			resp, err = client.PostponeMatch(context.Background(), req)
+		case "cancel":
+			resp, err = client.CancelMatch(context.Background(), req)
+		case "clock-start":
+			resp, err = client.StartClock(context.Background(), req)
+		case "clock-stop":
+			resp, err = client.StopClock(context.Background(), req)
+		case "clock-reset":
+			resp, err = client.ResetClock(context.Background(), req)
+		default:
+			c.JSON(http.StatusNotFound, gin.H{"error": "未知操作"})
+			return
+		}
+		if err != nil {
+			writeAppError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	// 当日记分板 (WebSocket): 连接后先推全量快照, 之后推增量
+	r.GET("/api/scoreboard/ws", serveScoreboard(newScoreboardManager(client)))
+
 	// 事件路由
 	r.POST("/api/matches/events", func(c *gin.Context) {
-		var req struct {
-			MatchID       int64  `json:"match_id"`
-			PlayerID      int32  `json:"player_id"`
-			TeamID        int32  `json:"team_id"`
-			Type          int32  `json:"type"`
-			SubType       string `json:"sub_type"`
-			Value         int32  `json:"value"`
-			Quarter       int32  `json:"quarter"`
-			TimeRemaining string `json:"time_remaining"`
+		var req matchEventBody
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
 		}
 
+		resp, err := client.RecordMatchEvent(context.Background(), req.toProto())
+		if err != nil {
+			writeEventError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "queued", "event_id": resp.EventId})
+	})
+
+	// 作废事件
+	r.POST("/api/matches/events/:event_id/void", func(c *gin.Context) {
+		var req struct {
+			EventID string `json:"event_id"` // 本次作废操作的 UUID, 重试时复用
+			MatchID int64  `json:"match_id"`
+			Reason  string `json:"reason"`
+		}
 		if err := c.BindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
 			return
 		}
 
-		_, err := client.RecordMatchEvent(context.Background(), &pb.RecordMatchEventRequest{
+		resp, err := client.VoidMatchEvent(context.Background(), &pb.VoidMatchEventRequest{
 			MatchId:       req.MatchID,
-			PlayerId:      req.PlayerID,
-			TeamId:        req.TeamID,
-			Type:          req.Type,
-			SubType:       req.SubType,
-			Value:         req.Value,
-			Quarter:       req.Quarter,
-			TimeRemaining: req.TimeRemaining,
-			EventTime:     time.Now().Format(time.RFC3339),
+			TargetEventId: c.Param("event_id"),
+			Reason:        req.Reason,
+			EventId:       req.EventID,
 		})
+		if err != nil {
+			writeEventError(c, err)
+			return
+		}
 
+		c.JSON(http.StatusOK, gin.H{"status": "queued", "event_id": resp.EventId})
+	})
+
+	// 更正事件: body 为更正后的完整事件, 另可带 reason
+	r.PUT("/api/matches/events/:event_id", func(c *gin.Context) {
+		var req struct {
+			matchEventBody
+			Reason string `json:"reason"`
+		}
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "参数错误"})
+			return
+		}
+
+		resp, err := client.AmendMatchEvent(context.Background(), &pb.AmendMatchEventRequest{
+			TargetEventId: c.Param("event_id"),
+			Corrected:     req.toProto(),
+			Reason:        req.Reason,
+		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "发送失败"})
+			writeEventError(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"status": "queued"})
+		c.JSON(http.StatusOK, gin.H{"status": "queued", "event_id": resp.EventId})
 	})
 
 	// 启动 BFF
 	log.Println("BFF Server 运行在 :8080")
 	r.Run(":8080")
 }
+
+// matchEventBody 事件上报/更正的请求体
+type matchEventBody struct {
+	EventID       string  `json:"event_id"` // 客户端生成的 UUID, 重试时复用以避免重复计分
+	MatchID       int64   `json:"match_id"`
+	PlayerID      int32   `json:"player_id"`
+	TeamID        int32   `json:"team_id"`
+	Type          string  `json:"type"`      // EventType 枚举名, e.g. "SHOT_MADE"
+	ShotType      string  `json:"shot_type"` // ShotType 枚举名, e.g. "THREE_POINTER"
+	SubType       string  `json:"sub_type"`
+	Value         int32   `json:"value"`
+	Quarter       int32   `json:"quarter"`        // 可省略, 由比赛时钟补全
+	TimeRemaining string  `json:"time_remaining"` // 可省略, 由比赛时钟补全
+	HomeLineup    []int32 `json:"home_lineup"`    // 单节开始时主队首发 5 人
+	VisitorLineup []int32 `json:"visitor_lineup"` // 单节开始时客队首发 5 人
+}
+
+func (b *matchEventBody) toProto() *pb.RecordMatchEventRequest {
+	return &pb.RecordMatchEventRequest{
+		EventId:       b.EventID,
+		MatchId:       b.MatchID,
+		PlayerId:      b.PlayerID,
+		TeamId:        b.TeamID,
+		Type:          pb.EventType(pb.EventType_value[b.Type]),
+		ShotType:      pb.ShotType(pb.ShotType_value[b.ShotType]),
+		SubType:       b.SubType,
+		Value:         b.Value,
+		Quarter:       b.Quarter,
+		TimeRemaining: b.TimeRemaining,
+		EventTime:     time.Now().Format(time.RFC3339),
+		HomeLineup:    b.HomeLineup,
+		VisitorLineup: b.VisitorLineup,
+	}
+}
+
+// tradeBody 交易/交易预检的请求体
+type tradeBody struct {
+	Assets []struct {
+		FromTeamID int32 `json:"from_team_id"`
+		ToTeamID   int32 `json:"to_team_id"`
+		PlayerID   int32 `json:"player_id"`
+		PickID     int32 `json:"pick_id"`
+		Protection int32 `json:"protection"` // 随选秀权附加的前 N 顺位保护
+	} `json:"assets"`
+	Date          string  `json:"date"`
+	Note          string  `json:"note"`
+	NoTradeWaived []int32 `json:"no_trade_waived"` // 同意放弃不可交易条款的球员
+}
+
+func (b *tradeBody) toProto() *pb.TradePlayersRequest {
+	req := &pb.TradePlayersRequest{Date: b.Date, Note: b.Note, NoTradeWaived: b.NoTradeWaived}
+	for _, a := range b.Assets {
+		req.Assets = append(req.Assets, &pb.TradeAsset{
+			FromTeamId: a.FromTeamID,
+			ToTeamId:   a.ToTeamID,
+			PlayerId:   a.PlayerID,
+			PickId:     a.PickID,
+			Protection: a.Protection,
+		})
+	}
+	return req
+}
+
+// writeEventError 事件类接口的错误响应: 参数问题返回 400, 其余视为发送失败
+func writeEventError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "发送失败"})
+	}
+}
+
+// writeAppError 业务接口的错误响应: 带业务错误码的返回 {code, message}, HTTP 状态码按 gRPC 状态码映射
+func writeAppError(c *gin.Context, err error) {
+	httpStatus := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		httpStatus = http.StatusBadRequest
+	case codes.NotFound:
+		httpStatus = http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		httpStatus = http.StatusConflict
+	case codes.Unavailable:
+		httpStatus = http.StatusServiceUnavailable
+	}
+
+	if appErr, ok := myErrors.FromError(err); ok {
+		c.JSON(httpStatus, gin.H{"error": appErr})
+		return
+	}
+	c.JSON(httpStatus, gin.H{"error": status.Convert(err).Message()})
+}
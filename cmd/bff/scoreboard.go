@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	pb "nba-remake/api/proto/v1"
+)
+
+const (
+	wsWriteWait   = 10 * time.Second    // 单次写超时
+	wsPongWait    = 60 * time.Second    // 超过这个时间没收到 pong 视为断线
+	wsPingPeriod  = wsPongWait * 9 / 10 // 发送 ping 的间隔, 必须小于 wsPongWait
+	wsMaxReadSize = 512                 // 客户端只会发心跳, 限制读取大小
+	wsSendBuffer  = 64                  // 每个连接最多积压的消息数, 超过则断开该连接
+	watchRetry    = 2 * time.Second     // 单场订阅断开后的重连间隔
+)
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 4096,
+	CheckOrigin: func(r *http.Request) bool {
+		return r.Header.Get("Origin") == "" || r.Header.Get("Origin") == "http://localhost:5173"
+	},
+}
+
+// scoreboardEntry 记分板上的单场比赛 (比赛信息 + 当前节次/时钟)
+type scoreboardEntry struct {
+	*pb.MatchResponse
+	Quarter       int32  `json:"quarter"`
+	TimeRemaining string `json:"time_remaining"`
+}
+
+// scoreboardMessage 推送给客户端的消息
+// type: snapshot 全量快照 (连接建立时), diff 增量变化, pong 心跳回复
+type scoreboardMessage struct {
+	Type       string                 `json:"type"`
+	Date       string                 `json:"date,omitempty"`
+	Matches    []*scoreboardEntry     `json:"matches,omitempty"`
+	MatchID    int64                  `json:"match_id,omitempty"`
+	Changes    map[string]interface{} `json:"changes,omitempty"`
+	LatestPlay *pb.PlayByPlay         `json:"latest_play,omitempty"`
+}
+
+// wsClient 单个 WebSocket 连接
+type wsClient struct {
+	conn *websocket.Conn
+	send chan []byte // 由 hub 关闭
+}
+
+// scoreboardHub 某一天的记分板广播器
+// 对当天每场比赛各开一个 WatchMatch 流, 合并后计算增量广播给所有连接
+type scoreboardHub struct {
+	date    string
+	mu      sync.Mutex
+	entries map[int64]*scoreboardEntry
+	order   []int64 // 保持 ListMatches 的开赛顺序
+	clients map[*wsClient]struct{}
+	cancel  context.CancelFunc
+}
+
+// scoreboardManager 按日期管理 hub, 有连接时启动, 最后一个连接断开时停止
+type scoreboardManager struct {
+	client pb.NBAServiceClient
+	mu     sync.Mutex
+	hubs   map[string]*scoreboardHub
+}
+
+func newScoreboardManager(client pb.NBAServiceClient) *scoreboardManager {
+	return &scoreboardManager{client: client, hubs: make(map[string]*scoreboardHub)}
+}
+
+// join 加入某天的记分板, 快照会作为第一条消息放入发送队列
+func (m *scoreboardManager) join(date string, c *wsClient) (*scoreboardHub, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, ok := m.hubs[date]
+	if !ok {
+		var err error
+		h, err = m.startHub(date)
+		if err != nil {
+			return nil, err
+		}
+		m.hubs[date] = h
+	}
+	h.add(c)
+	return h, nil
+}
+
+// leave 离开记分板, 没有连接时停止 hub
+func (m *scoreboardManager) leave(h *scoreboardHub, c *wsClient) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if h.remove(c) == 0 && m.hubs[h.date] == h {
+		h.cancel()
+		delete(m.hubs, h.date)
+	}
+}
+
+// startHub 拉取当天赛程并为每场比赛开启订阅
+func (m *scoreboardManager) startHub(date string) (*scoreboardHub, error) {
+	resp, err := m.client.ListMatches(context.Background(), &pb.ListMatchesRequest{Date: date})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &scoreboardHub{
+		date:    date,
+		entries: make(map[int64]*scoreboardEntry),
+		clients: make(map[*wsClient]struct{}),
+		cancel:  cancel,
+	}
+	for _, match := range resp.Matches {
+		h.entries[match.Id] = &scoreboardEntry{MatchResponse: match, Quarter: match.Period, TimeRemaining: match.TimeRemaining}
+		h.order = append(h.order, match.Id)
+		go h.watch(ctx, m.client, match.Id)
+	}
+	return h, nil
+}
+
+// watch 订阅单场比赛, 断线后自动重连直到 hub 停止
+func (h *scoreboardHub) watch(ctx context.Context, client pb.NBAServiceClient, matchID int64) {
+	for ctx.Err() == nil {
+		stream, err := client.WatchMatch(ctx, &pb.GetMatchRequest{Id: matchID})
+		if err == nil {
+			for {
+				update, err := stream.Recv()
+				if err != nil {
+					break
+				}
+				h.apply(update)
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("[Scoreboard] 订阅中断, %v 后重连 match_id=%d", watchRetry, matchID)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetry):
+		}
+	}
+}
+
+// apply 合并一条更新, 有变化时广播增量
+func (h *scoreboardHub) apply(update *pb.MatchUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entry, ok := h.entries[update.MatchId]
+	if !ok {
+		return
+	}
+
+	changes := make(map[string]interface{})
+	if entry.HomeScore != update.HomeScore {
+		entry.HomeScore = update.HomeScore
+		changes["home_score"] = update.HomeScore
+	}
+	if entry.VisitorScore != update.VisitorScore {
+		entry.VisitorScore = update.VisitorScore
+		changes["visitor_score"] = update.VisitorScore
+	}
+	if entry.Status != update.Status {
+		entry.Status = update.Status
+		changes["status"] = update.Status
+	}
+	if update.Quarter != 0 && entry.Quarter != update.Quarter {
+		entry.Quarter = update.Quarter
+		changes["quarter"] = update.Quarter
+	}
+	clockChanged := false
+	if update.TimeRemaining != "" && entry.TimeRemaining != update.TimeRemaining {
+		entry.TimeRemaining = update.TimeRemaining
+		changes["time_remaining"] = update.TimeRemaining
+		clockChanged = true
+	}
+	if entry.ClockRunning != update.ClockRunning {
+		entry.ClockRunning = update.ClockRunning
+		changes["clock_running"] = update.ClockRunning
+		clockChanged = true
+	}
+	if clockChanged {
+		// 时钟在走时客户端据此本地倒计时
+		entry.ClockRemainingMs = update.ClockRemainingMs
+		changes["clock_remaining_ms"] = update.ClockRemainingMs
+	}
+	if len(changes) == 0 && update.LatestPlay == nil {
+		return
+	}
+
+	data, err := json.Marshal(&scoreboardMessage{
+		Type:       "diff",
+		MatchID:    update.MatchId,
+		Changes:    changes,
+		LatestPlay: update.LatestPlay,
+	})
+	if err != nil {
+		return
+	}
+	for c := range h.clients {
+		select {
+		case c.send <- data:
+		default:
+			// 积压超过上限的慢连接直接断开, 不阻塞其它连接
+			log.Printf("[Scoreboard] 连接积压过多, 断开 date=%s", h.date)
+			h.removeLocked(c)
+		}
+	}
+}
+
+// add 注册连接并放入快照 (持锁, 保证快照排在所有增量之前)
+func (h *scoreboardHub) add(c *wsClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.clients[c] = struct{}{}
+	matches := make([]*scoreboardEntry, 0, len(h.order))
+	for _, id := range h.order {
+		entry := *h.entries[id]
+		matches = append(matches, &entry)
+	}
+	data, _ := json.Marshal(&scoreboardMessage{Type: "snapshot", Date: h.date, Matches: matches})
+	c.send <- data // 新连接的缓冲区一定是空的
+}
+
+// reply 单独回复某个连接 (如心跳), 连接已注销或积压时丢弃
+func (h *scoreboardHub) reply(c *wsClient, data []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[c]; !ok {
+		return
+	}
+	select {
+	case c.send <- data:
+	default:
+	}
+}
+
+// remove 注销连接, 返回剩余连接数
+func (h *scoreboardHub) remove(c *wsClient) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.removeLocked(c)
+	return len(h.clients)
+}
+
+func (h *scoreboardHub) removeLocked(c *wsClient) {
+	if _, ok := h.clients[c]; ok {
+		delete(h.clients, c)
+		close(c.send)
+	}
+}
+
+// serveScoreboard GET /api/scoreboard/ws?date=2023-11-05
+func serveScoreboard(m *scoreboardManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		date := c.Query("date")
+		if date == "" {
+			date = time.Now().Format("2006-01-02")
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "日期格式错误, 应为 YYYY-MM-DD"})
+			return
+		}
+
+		conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			return
+		}
+		client := &wsClient{conn: conn, send: make(chan []byte, wsSendBuffer)}
+
+		hub, err := m.join(date, client)
+		if err != nil {
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "获取赛程失败"),
+				time.Now().Add(wsWriteWait))
+			conn.Close()
+			return
+		}
+
+		go client.writePump()
+		client.readPump(hub, func() { m.leave(hub, client) })
+	}
+}
+
+// writePump 发送消息和 ping, send 被关闭时断开连接
+func (c *wsClient) writePump() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case data, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "消费过慢"))
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readPump 处理 pong 和应用层心跳 {"type":"ping"}, 连接断开时调用 onClose
+func (c *wsClient) readPump(hub *scoreboardHub, onClose func()) {
+	defer func() {
+		onClose()
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(wsMaxReadSize)
+	c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	pong, _ := json.Marshal(&scoreboardMessage{Type: "pong"})
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+		var msg scoreboardMessage
+		if json.Unmarshal(data, &msg) == nil && msg.Type == "ping" {
+			// 浏览器无法发送 ping 帧, 用应用层心跳代替
+			hub.reply(c, pong)
+		}
+	}
+}
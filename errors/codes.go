@@ -41,6 +41,10 @@ const (
 	CodePlayerExists      ErrorCode = 4001 // 球员已存在
 	CodeInvalidPlayerData ErrorCode = 4002 // 球员数据无效
 	CodePlayerInUse       ErrorCode = 4003 // 球员正在使用中
+	CodePlayerNotOnTeam   ErrorCode = 4004 // 球员不在转出球队阵容中
+	CodePlayerNoTrade     ErrorCode = 4005 // 球员有不可交易条款
+	CodePlayerTradeLocked ErrorCode = 4006 // 新签球员未过交易冷却期
+	CodeJerseyConflict    ErrorCode = 4007 // 球衣号码冲突
 )
 
 // 球队相关错误码 (4100-4199)
@@ -49,6 +53,13 @@ const (
 	CodeTeamExists      ErrorCode = 4101 // 球队已存在
 	CodeInvalidTeamData ErrorCode = 4102 // 球队数据无效
 	CodeTeamFull        ErrorCode = 4103 // 球队人数已满
+	CodeInvalidTrade    ErrorCode = 4104 // 交易内容无效
+	CodePickNotFound    ErrorCode = 4105 // 选秀权不存在
+	CodePickNotOwned    ErrorCode = 4106 // 选秀权不属于转出球队
+	CodeSalaryMismatch  ErrorCode = 4107 // 交易工资不匹配
+	CodePickUsed        ErrorCode = 4108 // 选秀权已使用
+	CodeDraftNotReady   ErrorCode = 4109 // 选秀尚未抽签
+	CodePickNotOnClock  ErrorCode = 4110 // 未轮到该选秀权
 )
 
 // 比赛相关错误码 (4200-4299)
@@ -59,6 +70,7 @@ const (
 	CodeMatchInProgress  ErrorCode = 4203 // 比赛进行中
 	CodeMatchFinished    ErrorCode = 4204 // 比赛已结束
 	CodeInvalidMatchTime ErrorCode = 4205 // 比赛时间无效
+	CodeMatchNotStarted  ErrorCode = 4206 // 比赛未开始
 )
 
 // 第三方服务错误码 (5000-5999)
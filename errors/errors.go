@@ -1,5 +1,17 @@
 package myErrors
 
+import (
+	"errors"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain gRPC ErrorInfo 的 domain, 用于识别本服务的业务错误码
+const errorDomain = "nba-remake"
+
 type AppError struct {
 	Code    ErrorCode `json:"code"`
 	Message string    `json:"message"`
@@ -13,3 +25,82 @@ func NewError(code ErrorCode, msg string, detail string) *AppError {
 		Detail:  detail,
 	}
 }
+
+func (e *AppError) Error() string {
+	if e.Detail != "" {
+		return e.Message + ": " + e.Detail
+	}
+	return e.Message
+}
+
+// GRPCStatus 实现 grpc status 接口, service 层可以直接返回 *AppError
+// 业务错误码放在 ErrorInfo.Metadata["code"] 里, 调用方用 FromError 取回
+func (e *AppError) GRPCStatus() *status.Status {
+	st := status.New(grpcCode(e.Code), e.Message)
+	info := &errdetails.ErrorInfo{
+		Reason:   strconv.Itoa(int(e.Code)),
+		Domain:   errorDomain,
+		Metadata: map[string]string{"code": strconv.Itoa(int(e.Code))},
+	}
+	if e.Detail != "" {
+		info.Metadata["detail"] = e.Detail
+	}
+	if withDetails, err := st.WithDetails(info); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// FromError 从 gRPC 错误中还原业务错误, 不是业务错误时返回 false
+func FromError(err error) (*AppError, bool) {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		info, ok := d.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != errorDomain {
+			continue
+		}
+		code, err := strconv.Atoi(info.Metadata["code"])
+		if err != nil {
+			continue
+		}
+		return NewError(ErrorCode(code), st.Message(), info.Metadata["detail"]), true
+	}
+	return nil, false
+}
+
+// grpcCode 业务错误码对应的 gRPC 状态码
+func grpcCode(code ErrorCode) codes.Code {
+	switch code {
+	case CodeSuccess:
+		return codes.OK
+	case CodeInvalidParam, CodeMissingParam, CodeInvalidFormat, CodeValidationFailed,
+		CodeInvalidPlayerData, CodeInvalidTeamData, CodeInvalidMatchData, CodeInvalidMatchTime, CodeInvalidTrade:
+		return codes.InvalidArgument
+	case CodeDataNotFound, CodeUserNotFound, CodePlayerNotFound, CodeTeamNotFound, CodeMatchNotFound, CodePickNotFound:
+		return codes.NotFound
+	case CodeDuplicateData, CodeUserExists, CodePlayerExists, CodeTeamExists, CodeMatchExists:
+		return codes.AlreadyExists
+	case CodePermissionDenied:
+		return codes.PermissionDenied
+	case CodeTokenExpired, CodeTokenInvalid, CodePasswordError:
+		return codes.Unauthenticated
+	case CodeTimeout:
+		return codes.DeadlineExceeded
+	}
+	switch {
+	case code >= 4000 && code < 5000:
+		// 其余业务规则冲突 (比赛进行中/已结束、球队满员等)
+		return codes.FailedPrecondition
+	case code >= 5000 || code == CodeServiceUnavailable || code == CodeNetworkError || code == CodeCacheError:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "nba-remake/api/proto/v1"
+)
+
+// LiveChannel 比赛实时比分的 Redis pub/sub 频道
+// 消费者处理完事件后发布, 所有 gRPC 副本订阅后推给各自的 WatchMatch 连接
+func LiveChannel(matchID int64) string {
+	return fmt.Sprintf("match:live:%d", matchID)
+}
+
+// PublishMatchUpdate 发布实时比分
+func PublishMatchUpdate(ctx context.Context, rdb *redis.Client, update *pb.MatchUpdate) error {
+	data, err := protojson.Marshal(update)
+	if err != nil {
+		return err
+	}
+	return rdb.Publish(ctx, LiveChannel(update.MatchId), data).Err()
+}
+
+// SubscribeMatch 订阅单场比赛的实时比分, 调用方负责 Close
+func SubscribeMatch(ctx context.Context, rdb *redis.Client, matchID int64) *redis.PubSub {
+	return rdb.Subscribe(ctx, LiveChannel(matchID))
+}
+
+// DecodeMatchUpdate 解析频道消息
+func DecodeMatchUpdate(payload string) (*pb.MatchUpdate, error) {
+	var update pb.MatchUpdate
+	if err := protojson.Unmarshal([]byte(payload), &update); err != nil {
+		return nil, err
+	}
+	return &update, nil
+}
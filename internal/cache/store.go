@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// 缓存过期时间; 写路径会主动删除缓存, TTL 只是兜底
+const (
+	MatchTTL     = 5 * time.Minute
+	TeamTTL      = time.Hour
+	PlayerTTL    = 30 * time.Minute
+	StandingsTTL = time.Hour
+)
+
+// 缓存 Key
+func MatchKey(id int64) string               { return fmt.Sprintf("match:%d", id) }
+func TeamKey(id int32, season string) string { return fmt.Sprintf("team:%d:%s", id, season) }
+func PlayerKey(id int32) string              { return fmt.Sprintf("player:%d", id) }
+func TeamListKey(season string) string       { return "teams:all:" + season }
+func StandingsKey(season string) string      { return "standings:" + season }
+
+// Store 读穿透缓存: 未命中时回源加载并回填, 同一 key 的并发回源只执行一次
+type Store struct {
+	rdb   *redis.Client
+	group singleflight.Group
+}
+
+func NewStore(rdb *redis.Client) *Store {
+	return &Store{rdb: rdb}
+}
+
+// Get 读取缓存, 未命中返回 (nil, false, nil)
+func Get[T any](ctx context.Context, s *Store, key string) (*T, bool, error) {
+	val, err := s.rdb.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var v T
+	if err := json.Unmarshal(val, &v); err != nil {
+		return nil, false, fmt.Errorf("缓存数据反序列化失败: %w", err)
+	}
+	return &v, true, nil
+}
+
+// Set 写入缓存, TTL 加上最多 10% 的随机抖动, 避免同时过期
+func Set[T any](ctx context.Context, s *Store, key string, v *T, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if ttl > 0 {
+		ttl += time.Duration(rand.Int63n(int64(ttl)/10 + 1))
+	}
+	return s.rdb.Set(ctx, key, data, ttl).Err()
+}
+
+// Fetch 先查缓存, 未命中时通过 singleflight 回源并回填
+// Redis 故障时降级为直接回源; load 返回的错误原样透传 (如 gorm.ErrRecordNotFound), 错误结果不缓存
+// 返回值可能被并发请求共享, 调用方不要修改
+func Fetch[T any](ctx context.Context, s *Store, key string, ttl time.Duration, load func() (*T, error)) (*T, error) {
+	if v, ok, err := Get[T](ctx, s, key); err == nil && ok {
+		return v, nil
+	} else if err != nil {
+		log.Printf("[Cache] 读取失败, 降级回源 key=%s err=%v", key, err)
+	}
+
+	v, err, _ := s.group.Do(key, func() (interface{}, error) {
+		v, err := load()
+		if err != nil {
+			return nil, err
+		}
+		if err := Set(ctx, s, key, v, ttl); err != nil {
+			log.Printf("[Cache] 回填失败 key=%s err=%v", key, err)
+		}
+		return v, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*T), nil
+}
+
+// Delete 删除缓存 (写路径在提交后调用)
+func (s *Store) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.rdb.Del(ctx, keys...).Err()
+}
+
+// Invalidate 删除缓存, 失败只记录日志 (依赖 TTL 兜底)
+func (s *Store) Invalidate(ctx context.Context, keys ...string) {
+	if err := s.Delete(ctx, keys...); err != nil {
+		log.Printf("[Cache] 删除失败 keys=%v err=%v", keys, err)
+	}
+}
+
+// InvalidatePattern 按通配符删除缓存 (SCAN 遍历, 只用于低频的管理操作), 失败只记录日志
+func (s *Store) InvalidatePattern(ctx context.Context, patterns ...string) {
+	for _, pattern := range patterns {
+		iter := s.rdb.Scan(ctx, 0, pattern, 100).Iterator()
+		var keys []string
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+		}
+		if err := iter.Err(); err != nil {
+			log.Printf("[Cache] 扫描失败 pattern=%s err=%v", pattern, err)
+			continue
+		}
+		s.Invalidate(ctx, keys...)
+	}
+}
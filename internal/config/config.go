@@ -14,6 +14,7 @@ type Config struct {
 	Redis         RedisConfig         `mapstructure:"redis"`         // 新增Redis配置
 	Elasticsearch ElasticsearchConfig `mapstructure:"elasticsearch"` // 新增ES配置
 	MongoDB       MongoDBConfig       `mapstructure:"mongodb"`       // 新增MongoDB配置
+	SalaryCap     SalaryCapConfig     `mapstructure:"salary_cap"`    // 工资帽/奢侈税线/土豪线
 }
 
 type ServerConfig struct {
@@ -28,9 +29,13 @@ type MySQLConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers []string `mapstructure:"brokers"`
-	Topic   string   `mapstructure:"topic"`
-	GroupID string   `mapstructure:"group_id"`
+	Brokers         []string `mapstructure:"brokers"`
+	Topic           string   `mapstructure:"topic"`
+	GroupID         string   `mapstructure:"group_id"`
+	DeadLetterTopic string   `mapstructure:"dead_letter_topic"` // 死信队列 Topic
+	MaxRetries      int      `mapstructure:"max_retries"`       // 临时性错误最大重试次数
+	RetryBackoff    string   `mapstructure:"retry_backoff"`     // 首次重试间隔（如200ms），之后指数退避
+	MaxRetryBackoff string   `mapstructure:"max_retry_backoff"` // 退避上限（如5s）
 }
 
 type RedisConfig struct {
@@ -65,6 +70,27 @@ type MongoDBConfig struct {
 	MaxIdleTime    string `mapstructure:"max_idle_time"`   // 连接最大空闲时间（如60s）
 }
 
+// SalaryCapConfig 工资帽等阈值 (美元), seasons 中按赛季覆盖, 没有配置的赛季用 default
+type SalaryCapConfig struct {
+	Default CapThresholds            `mapstructure:"default"`
+	Seasons map[string]CapThresholds `mapstructure:"seasons"`
+}
+
+type CapThresholds struct {
+	SalaryCap   int64 `mapstructure:"salary_cap"`   // 工资帽
+	LuxuryTax   int64 `mapstructure:"luxury_tax"`   // 奢侈税线
+	FirstApron  int64 `mapstructure:"first_apron"`  // 第一土豪线
+	SecondApron int64 `mapstructure:"second_apron"` // 第二土豪线
+}
+
+// For 某赛季的阈值
+func (c SalaryCapConfig) For(season string) CapThresholds {
+	if t, ok := c.Seasons[season]; ok {
+		return t
+	}
+	return c.Default
+}
+
 // LoadConfig 读取配置文件
 func LoadConfig() *Config {
 	viper.SetConfigName("config")    // 配置文件名
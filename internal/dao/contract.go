@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"nba-remake/internal/model"
+)
+
+// ContractDao 球员合同
+type ContractDao struct {
+	db *gorm.DB
+}
+
+func NewContractDao(db *gorm.DB) *ContractDao {
+	return &ContractDao{db: db}
+}
+
+// Create 写入合同 (连同各赛季条款)
+func (d *ContractDao) Create(c *model.Contract) error {
+	return d.db.Create(c).Error
+}
+
+// ByPlayer 球员的全部合同, 最近签的在前
+func (d *ContractDao) ByPlayer(playerID uint32) ([]*model.Contract, error) {
+	var contracts []*model.Contract
+	err := d.db.Preload("Seasons", orderBySeason).Where("player_id = ?", playerID).
+		Order("signed_date desc, id desc").Find(&contracts).Error
+	return contracts, err
+}
+
+// TeamContracts 由这些球队承担、包含 season 的合同 (含已裁球员的保障工资)
+func (d *ContractDao) TeamContracts(teamIDs []uint32, season string) ([]*model.Contract, error) {
+	var contracts []*model.Contract
+	if len(teamIDs) == 0 {
+		return contracts, nil
+	}
+	err := d.db.Preload("Seasons", orderBySeason).
+		Where("team_id IN ? AND id IN (?)", teamIDs,
+			d.db.Model(&model.ContractSeason{}).Select("contract_id").Where("season = ?", season)).
+		Find(&contracts).Error
+	return contracts, err
+}
+
+// Payrolls 各球队 season 的工资总额, 返回 team_id -> 金额
+func (d *ContractDao) Payrolls(teamIDs []uint32, season string) (map[uint32]int64, error) {
+	contracts, err := d.TeamContracts(teamIDs, season)
+	if err != nil {
+		return nil, err
+	}
+	payrolls := make(map[uint32]int64, len(teamIDs))
+	for _, c := range contracts {
+		payrolls[c.TeamID] += c.CapHit(season)
+	}
+	return payrolls, nil
+}
+
+// CapHits 球员 season 的工资 (未被裁的合同), 返回 player_id -> 金额
+func (d *ContractDao) CapHits(playerIDs []uint32, season string) (map[uint32]int64, error) {
+	hits := make(map[uint32]int64, len(playerIDs))
+	if len(playerIDs) == 0 {
+		return hits, nil
+	}
+	var contracts []*model.Contract
+	err := d.db.Preload("Seasons", orderBySeason).
+		Where("player_id IN ? AND waived_date IS NULL", playerIDs).Find(&contracts).Error
+	for _, c := range contracts {
+		hits[c.PlayerID] += c.CapHit(season)
+	}
+	return hits, err
+}
+
+// Move 交易后球员未被裁的合同转到新球队
+func (d *ContractDao) Move(playerID, fromTeamID, toTeamID uint32) error {
+	return d.db.Model(&model.Contract{}).
+		Where("player_id = ? AND team_id = ? AND waived_date IS NULL", playerID, fromTeamID).
+		Update("team_id", toTeamID).Error
+}
+
+// Waive 裁掉球员, 合同留在原球队, 之后只计保障部分
+func (d *ContractDao) Waive(playerID, teamID uint32, date time.Time) error {
+	return d.db.Model(&model.Contract{}).
+		Where("player_id = ? AND team_id = ? AND waived_date IS NULL", playerID, teamID).
+		Update("waived_date", date).Error
+}
+
+func orderBySeason(db *gorm.DB) *gorm.DB {
+	return db.Order("season asc")
+}
@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"nba-remake/internal/model"
+)
+
+// DraftDao 选秀年份/选秀权/新秀
+type DraftDao struct {
+	db *gorm.DB
+}
+
+func NewDraftDao(db *gorm.DB) *DraftDao {
+	return &DraftDao{db: db}
+}
+
+// Create 写入选秀年份及各队选秀权, 已存在的选秀权 (year, round, original_team_id) 保持不变
+func (d *DraftDao) Create(draft *model.Draft, picks []*model.DraftPick) error {
+	if err := d.db.Create(draft).Error; err != nil {
+		return err
+	}
+	if len(picks) == 0 {
+		return nil
+	}
+	return d.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&picks).Error
+}
+
+// Get 按年份取选秀, 不存在时返回 gorm.ErrRecordNotFound
+func (d *DraftDao) Get(year int) (*model.Draft, error) {
+	var draft model.Draft
+	if err := d.db.First(&draft, year).Error; err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// GetForUpdate 锁定选秀行, 同一届选秀的抽签和选人串行执行
+func (d *DraftDao) GetForUpdate(year int) (*model.Draft, error) {
+	var draft model.Draft
+	if err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&draft, year).Error; err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// Save 更新选秀年份
+func (d *DraftDao) Save(draft *model.Draft) error {
+	return d.db.Save(draft).Error
+}
+
+// Picks 某年的选秀权, 按轮次、顺位、原属球队排序; ownerTeamID 为 0 时不按持有球队过滤
+func (d *DraftDao) Picks(year int, ownerTeamID uint32) ([]*model.DraftPick, error) {
+	var picks []*model.DraftPick
+	query := d.db.Where("year = ?", year)
+	if ownerTeamID != 0 {
+		query = query.Where("owner_team_id = ?", ownerTeamID)
+	}
+	err := query.Order("round, number, original_team_id").Find(&picks).Error
+	return picks, err
+}
+
+// GetPick 按 ID 取选秀权, 不存在时返回 gorm.ErrRecordNotFound
+func (d *DraftDao) GetPick(id uint32) (*model.DraftPick, error) {
+	var pick model.DraftPick
+	if err := d.db.First(&pick, id).Error; err != nil {
+		return nil, err
+	}
+	return &pick, nil
+}
+
+// SavePick 更新选秀权 (顺位/持有球队/保护/选中球员)
+func (d *DraftDao) SavePick(pick *model.DraftPick) error {
+	return d.db.Save(pick).Error
+}
+
+// NextPick 当前轮到的选秀权 (顺位已确定且未选人中顺位最小的), 没有时返回 gorm.ErrRecordNotFound
+func (d *DraftDao) NextPick(year int) (*model.DraftPick, error) {
+	var pick model.DraftPick
+	err := d.db.Where("year = ? AND number > 0 AND player_id = 0", year).Order("number").First(&pick).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pick, nil
+}
+
+// CreateProspect 写入新秀
+func (d *DraftDao) CreateProspect(p *model.Prospect) error {
+	return d.db.Create(p).Error
+}
+
+// GetProspectForUpdate 锁定新秀行, 不存在时返回 gorm.ErrRecordNotFound
+func (d *DraftDao) GetProspectForUpdate(id uint32) (*model.Prospect, error) {
+	var p model.Prospect
+	if err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&p, id).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// SaveProspect 更新新秀
+func (d *DraftDao) SaveProspect(p *model.Prospect) error {
+	return d.db.Save(p).Error
+}
+
+// AvailableProspects 某年尚未被选中的新秀, 按大板排名 (未排名的在后)
+func (d *DraftDao) AvailableProspects(year int) ([]*model.Prospect, error) {
+	var prospects []*model.Prospect
+	err := d.db.Where("year = ? AND player_id = 0", year).Order("board_rank = 0, board_rank, id").Find(&prospects).Error
+	return prospects, err
+}
@@ -0,0 +1,16 @@
+package dao
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlDuplicateEntry 唯一索引冲突
+const mysqlDuplicateEntry = 1062
+
+// IsDuplicateKey 是否为唯一索引冲突 (并发写入时先检查后写入仍可能冲突, 以数据库为准)
+func IsDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
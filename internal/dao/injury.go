@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"nba-remake/internal/model"
+)
+
+// InjuryDao 球员伤病记录
+type InjuryDao struct {
+	db *gorm.DB
+}
+
+func NewInjuryDao(db *gorm.DB) *InjuryDao {
+	return &InjuryDao{db: db}
+}
+
+// Create 写入伤病记录
+func (d *InjuryDao) Create(i *model.Injury) error {
+	return d.db.Omit("Player").Create(i).Error
+}
+
+// Save 更新伤病记录
+func (d *InjuryDao) Save(i *model.Injury) error {
+	return d.db.Omit("Player").Save(i).Error
+}
+
+// Get 按 ID 取伤病记录 (带球员), 不存在时返回 gorm.ErrRecordNotFound
+func (d *InjuryDao) Get(id uint64) (*model.Injury, error) {
+	var injury model.Injury
+	if err := d.db.Preload("Player").First(&injury, id).Error; err != nil {
+		return nil, err
+	}
+	return &injury, nil
+}
+
+// GetForUpdate 锁定伤病记录行, 同一条伤病的修改串行执行
+func (d *InjuryDao) GetForUpdate(id uint64) (*model.Injury, error) {
+	var injury model.Injury
+	if err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&injury, id).Error; err != nil {
+		return nil, err
+	}
+	return &injury, nil
+}
+
+// Unreturned 球员尚未复出的伤病
+func (d *InjuryDao) Unreturned(playerID uint32) ([]*model.Injury, error) {
+	var injuries []*model.Injury
+	err := d.db.Where("player_id = ? AND returned_date IS NULL", playerID).Find(&injuries).Error
+	return injuries, err
+}
+
+// ActiveOn date 当天仍在伤病中的记录 (带球员), teamID 为 0 时不按球队过滤
+func (d *InjuryDao) ActiveOn(date time.Time, teamID uint32) ([]*model.Injury, error) {
+	var injuries []*model.Injury
+	query := d.db.Preload("Player").
+		Where("injury_date <= ? AND (returned_date IS NULL OR returned_date > ?)", date, date)
+	if teamID != 0 {
+		query = query.Where("team_id = ?", teamID)
+	}
+	err := query.Order("team_id, designation, injury_date, id").Find(&injuries).Error
+	return injuries, err
+}
+
+// GamesMissed 受伤当天起到复出 (或 asOf) 之前, 受伤时所在球队已结束且球员没有技术统计的比赛场数
+func (d *InjuryDao) GamesMissed(i *model.Injury, asOf time.Time) (int64, error) {
+	end := asOf
+	if i.ReturnedDate != nil && i.ReturnedDate.Before(end) {
+		end = *i.ReturnedDate
+	}
+	var n int64
+	err := d.db.Model(&model.Match{}).
+		Where("(home_team_id = ? OR visitor_team_id = ?) AND date >= ? AND date < ? AND status = ?",
+			i.TeamID, i.TeamID, i.InjuryDate, end, model.MatchStatusFinished).
+		Where("NOT EXISTS (?)", d.db.Model(&model.PlayerGameStats{}).Select("1").
+			Where("player_game_stats.match_id = matches.id AND player_game_stats.player_id = ?", i.PlayerID)).
+		Count(&n).Error
+	return n, err
+}
@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"nba-remake/internal/model"
+)
+
+// LeagueDao 联盟/赛区及球队的赛区归属
+type LeagueDao struct {
+	db *gorm.DB
+}
+
+// NewLeagueDao 构造函数
+func NewLeagueDao(db *gorm.DB) *LeagueDao {
+	return &LeagueDao{db: db}
+}
+
+// ListConferences 全部联盟
+func (d *LeagueDao) ListConferences() ([]*model.Conference, error) {
+	var conferences []*model.Conference
+	err := d.db.Order("id asc").Find(&conferences).Error
+	return conferences, err
+}
+
+// ListDivisions 全部赛区 (带所属联盟), conference 不为空时只查该联盟
+func (d *LeagueDao) ListDivisions(conference string) ([]*model.Division, error) {
+	var divisions []*model.Division
+	query := d.db.Joins("Conference").Order("divisions.id asc")
+	if conference != "" {
+		query = query.Where("Conference.name = ?", conference)
+	}
+	err := query.Find(&divisions).Error
+	return divisions, err
+}
+
+// GetDivision 根据ID获取赛区 (带所属联盟)
+func (d *LeagueDao) GetDivision(id uint32) (*model.Division, error) {
+	var division model.Division
+	err := d.db.Joins("Conference").Where("divisions.id = ?", id).First(&division).Error
+	return &division, err
+}
+
+// DivisionsOf 某赛季各球队所属赛区 (带联盟), 返回 team_id -> division; 没有记录的球队不在结果中
+func (d *LeagueDao) DivisionsOf(season string) (map[uint32]*model.Division, error) {
+	var rows []*model.TeamDivision
+	latest := d.db.Model(&model.TeamDivision{}).
+		Select("team_id, MAX(season) AS season").
+		Where("season <= ?", season).
+		Group("team_id")
+	err := d.db.Preload("Division.Conference").
+		Joins("JOIN (?) AS latest ON latest.team_id = team_divisions.team_id AND latest.season = team_divisions.season", latest).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint32]*model.Division, len(rows))
+	for _, row := range rows {
+		division := row.Division
+		result[row.TeamID] = &division
+	}
+	return result, nil
+}
+
+// SetTeamDivision 设置球队从某赛季起所属的赛区 (同赛季重复设置则覆盖)
+func (d *LeagueDao) SetTeamDivision(teamID uint32, season string, divisionID uint32) error {
+	return d.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "team_id"}, {Name: "season"}},
+		DoUpdates: clause.AssignmentColumns([]string{"division_id"}),
+	}).Create(&model.TeamDivision{TeamID: teamID, Season: season, DivisionID: divisionID}).Error
+}
+
+// SeedAlignment 联盟表为空时写入默认的联盟/赛区划分, 并按球队缩写写入赛区归属
+func (d *LeagueDao) SeedAlignment(alignment []model.AlignmentConference, season string) error {
+	var count int64
+	if err := d.db.Model(&model.Conference{}).Count(&count).Error; err != nil || count > 0 {
+		return err
+	}
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		var teams []*model.Team
+		if err := tx.Select("id", "abbreviation").Find(&teams).Error; err != nil {
+			return err
+		}
+		byAbbr := make(map[string]uint32, len(teams))
+		for _, t := range teams {
+			byAbbr[t.Abbreviation] = t.ID
+		}
+
+		for _, ac := range alignment {
+			conference := model.Conference{Name: ac.Name, FullName: ac.FullName}
+			if err := tx.Create(&conference).Error; err != nil {
+				return err
+			}
+			for _, ad := range ac.Divisions {
+				division := model.Division{Name: ad.Name, ConferenceID: conference.ID}
+				if err := tx.Create(&division).Error; err != nil {
+					return err
+				}
+				for _, abbr := range ad.Teams {
+					teamID, ok := byAbbr[abbr]
+					if !ok {
+						continue
+					}
+					if err := tx.Create(&model.TeamDivision{TeamID: teamID, Season: season, DivisionID: division.ID}).Error; err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -2,9 +2,15 @@ package dao
 
 import (
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	pb "nba-remake/api/proto/v1"
 	"nba-remake/internal/model"
 )
 
+// lifecycleColumns 状态流转时允许修改的列
+var lifecycleColumns = []string{"status", "period", "period_ended", "postpone_reason", "cancel_reason",
+	"clock_remaining_ms", "clock_running", "clock_started_at"}
+
 type MatchDao struct {
 	db *gorm.DB
 }
@@ -13,20 +19,207 @@ func NewMatchDao(db *gorm.DB) *MatchDao {
 	return &MatchDao{db: db}
 }
 
-// GetByID 查单场 (Preload 球队)
+// scheduleColumns 修改赛程时允许修改的列
+var scheduleColumns = []string{"date", "start_time", "season", "home_team_id", "visitor_team_id", "status", "postpone_reason"}
+
+// WithTx 在事务中执行, fn 内使用传入的 txDao
+func (d *MatchDao) WithTx(fn func(txDao *MatchDao) error) error {
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&MatchDao{db: tx})
+	})
+}
+
+// orderByPeriod 单节比分按节次排序
+func orderByPeriod(db *gorm.DB) *gorm.DB {
+	return db.Order("period asc")
+}
+
+// GetByID 查单场 (Preload 球队, 队名等按比赛所属赛季)
 func (d *MatchDao) GetByID(id int64) (*model.Match, error) {
 	var match model.Match
-	err := d.db.Preload("HomeTeam").Preload("VisitorTeam").
+	err := d.db.Preload("HomeTeam").Preload("VisitorTeam").Preload("PeriodScores", orderByPeriod).
 		Where("id = ?", id).First(&match).Error
-	return &match, err
+	if err != nil {
+		return &match, err
+	}
+	return &match, applyTeamSeasons(d.db, &match)
 }
 
 // ListByDate 查列表 (按时间排序)
 func (d *MatchDao) ListByDate(date string) ([]*model.Match, error) {
 	var matches []*model.Match
-	err := d.db.Preload("HomeTeam").Preload("VisitorTeam").
+	err := d.db.Preload("HomeTeam").Preload("VisitorTeam").Preload("PeriodScores", orderByPeriod).
 		Where("date = ?", date).
 		Order("start_time asc").
 		Find(&matches).Error
+	if err != nil {
+		return matches, err
+	}
+	return matches, applyTeamSeasons(d.db, matches...)
+}
+
+// GetEventByEventID 根据事件ID(UUID)查流水
+func (d *MatchDao) GetEventByEventID(eventID string) (*model.MatchEvent, error) {
+	var event model.MatchEvent
+	err := d.db.Where("event_id = ?", eventID).First(&event).Error
+	return &event, err
+}
+
+// GetLatestEvent 查单场最近一条有效事件 (已作废的不算)
+func (d *MatchDao) GetLatestEvent(matchID int64) (*model.MatchEvent, error) {
+	var event model.MatchEvent
+	err := d.db.Where("match_id = ? AND voided = ?", matchID, false).
+		Order("id desc").First(&event).Error
+	return &event, err
+}
+
+// GetMatchesByIDs 批量查比赛 (不 Preload 球队), 返回 id -> match
+func (d *MatchDao) GetMatchesByIDs(ids []uint64) (map[uint64]*model.Match, error) {
+	var matches []*model.Match
+	if err := d.db.Where("id IN ?", ids).Find(&matches).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[uint64]*model.Match, len(matches))
+	for _, m := range matches {
+		result[m.ID] = m
+	}
+	return result, nil
+}
+
+// ScanEvents 按主键顺序分批遍历全部事件流水 (重建搜索索引用)
+func (d *MatchDao) ScanEvents(batchSize int, fn func(events []*model.MatchEvent) error) error {
+	var batch []*model.MatchEvent
+	return d.db.Order("id asc").FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
+}
+
+// ListEvents 查单场全部事件流水 (含已作废的), 按写入顺序
+func (d *MatchDao) ListEvents(matchID int64) ([]*model.MatchEvent, error) {
+	var events []*model.MatchEvent
+	err := d.db.Where("match_id = ?", matchID).Order("id asc").Find(&events).Error
+	return events, err
+}
+
+// GetState 查比赛状态 (不 Preload 球队), 用于写入前的快速校验
+func (d *MatchDao) GetState(id int64) (*model.Match, error) {
+	var match model.Match
+	err := d.db.Select("id", "status", "period", "period_ended", "home_score", "visitor_score",
+		"clock_remaining_ms", "clock_running", "clock_started_at").
+		Where("id = ?", id).First(&match).Error
+	return &match, err
+}
+
+// Transition 在行锁内执行状态流转: fn 校验并修改状态, 返回错误时回滚
+func (d *MatchDao) Transition(id int64, fn func(match *model.Match) error) (*model.Match, error) {
+	var match model.Match
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&match).Error; err != nil {
+			return err
+		}
+		if err := fn(&match); err != nil {
+			return err
+		}
+		return tx.Model(&match).Select(lifecycleColumns).Updates(&match).Error
+	})
+	return &match, err
+}
+
+// RebuildPeriodScores 由有效的得分事件重建全部单节比分 (新建 match_period_scores 表后补历史数据)
+func (d *MatchDao) RebuildPeriodScores() (int64, error) {
+	result := d.db.Exec(`
+		INSERT INTO match_period_scores (match_id, period, home_score, visitor_score, updated_at)
+		SELECT e.match_id, e.quarter,
+			SUM(CASE WHEN e.team_id = m.home_team_id THEN e.value ELSE 0 END),
+			SUM(CASE WHEN e.team_id = m.visitor_team_id THEN e.value ELSE 0 END),
+			NOW()
+		FROM match_events e JOIN matches m ON m.id = e.match_id
+		WHERE e.voided = ? AND e.quarter > 0 AND e.type IN ?
+		GROUP BY e.match_id, e.quarter
+		ON DUPLICATE KEY UPDATE home_score = VALUES(home_score), visitor_score = VALUES(visitor_score)`,
+		false, []pb.EventType{pb.EventType_SHOT_MADE, pb.EventType_FREE_THROW_MADE})
+	return result.RowsAffected, result.Error
+}
+
+// RemapLegacyEventTypes 把旧版 match_events.type 取值 (1:得分 2:篮板 3:助攻 4:抢断 5:盖帽 6:失误 7:犯规 8:投篮不中 9:换人,
+// 细分写在 sub_type) 改写为 EventType/ShotType 编号, 只能在 shot_type 列新建时执行一次
+// MySQL 单表 UPDATE 按从左到右赋值, 后面的赋值看到的是新值, 所以 shot_type 和 type 都先于 sub_type 按旧值计算
+func (d *MatchDao) RemapLegacyEventTypes() (int64, error) {
+	result := d.db.Exec(`
+		UPDATE match_events SET
+		shot_type = CASE
+			WHEN type = 1 AND value = 1 THEN ?
+			WHEN type = 1 AND value = 3 THEN ?
+			WHEN type IN (1, 8) AND sub_type = 'dunk' THEN ?
+			WHEN type IN (1, 8) AND sub_type = 'layup' THEN ?
+			WHEN type = 8 AND sub_type = 'ft' THEN ?
+			WHEN type = 8 AND sub_type = '3pt' THEN ?
+			WHEN type IN (1, 8) THEN ?
+			ELSE shot_type END,
+		type = CASE
+			WHEN type = 1 AND value = 1 THEN ?
+			WHEN type = 1 THEN ?
+			WHEN type = 2 AND sub_type = 'off' THEN ?
+			WHEN type = 2 THEN ?
+			WHEN type = 3 THEN ?
+			WHEN type = 4 THEN ?
+			WHEN type = 5 THEN ?
+			WHEN type = 6 THEN ?
+			WHEN type = 7 THEN ?
+			WHEN type = 8 AND sub_type = 'ft' THEN ?
+			WHEN type = 8 THEN ?
+			WHEN type = 9 AND sub_type = 'out' THEN ?
+			WHEN type = 9 THEN ?
+			ELSE ? END,
+		sub_type = CASE
+			WHEN sub_type IN ('off', 'def', 'ft', '2pt', '3pt', 'dunk', 'layup', 'in', 'out') THEN ''
+			ELSE sub_type END`,
+		pb.ShotType_FREE_THROW, pb.ShotType_THREE_POINTER, pb.ShotType_DUNK, pb.ShotType_LAYUP,
+		pb.ShotType_FREE_THROW, pb.ShotType_THREE_POINTER, pb.ShotType_JUMP_SHOT,
+		pb.EventType_FREE_THROW_MADE, pb.EventType_SHOT_MADE,
+		pb.EventType_REBOUND_OFFENSIVE, pb.EventType_REBOUND_DEFENSIVE,
+		pb.EventType_ASSIST, pb.EventType_STEAL, pb.EventType_BLOCK, pb.EventType_TURNOVER, pb.EventType_FOUL_PERSONAL,
+		pb.EventType_FREE_THROW_MISSED, pb.EventType_SHOT_MISSED,
+		pb.EventType_SUBSTITUTION_OUT, pb.EventType_SUBSTITUTION_IN,
+		pb.EventType_EVENT_TYPE_UNKNOWN)
+	return result.RowsAffected, result.Error
+}
+
+// FindTeamGames 查指定日期内涉及这些球队且占用赛程的比赛 (延期/取消的不算), 加行锁防止并发排入冲突比赛
+// excludeID 用于修改赛程时排除自身
+func (d *MatchDao) FindTeamGames(dates []string, teamIDs []uint, excludeID uint64) ([]*model.Match, error) {
+	var matches []*model.Match
+	err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("date IN ? AND id <> ? AND status NOT IN ?", dates, excludeID,
+			[]int{model.MatchStatusPostponed, model.MatchStatusCancelled}).
+		Where("home_team_id IN ? OR visitor_team_id IN ?", teamIDs, teamIDs).
+		Find(&matches).Error
+	return matches, err
+}
+
+// CreateMatches 批量创建比赛
+func (d *MatchDao) CreateMatches(matches []*model.Match) error {
+	return d.db.Omit("HomeTeam", "VisitorTeam", "PeriodScores").CreateInBatches(matches, 200).Error
+}
+
+// UpdateSchedule 修改赛程 (日期/时间/赛季/对阵/延期状态)
+func (d *MatchDao) UpdateSchedule(match *model.Match) error {
+	return d.db.Model(match).Select(scheduleColumns).Updates(match).Error
+}
+
+// GetForUpdate 加行锁查单场 (不 Preload)
+func (d *MatchDao) GetForUpdate(id int64) (*model.Match, error) {
+	var match model.Match
+	err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&match).Error
+	return &match, err
+}
+
+// ListFinished 查某赛季已结束的比赛 (只取计算排名需要的列), 按比赛时间升序
+func (d *MatchDao) ListFinished(season string) ([]*model.Match, error) {
+	var matches []*model.Match
+	err := d.db.Select("id", "date", "start_time", "season", "home_team_id", "visitor_team_id", "home_score", "visitor_score").
+		Where("season = ? AND status = ?", season, model.MatchStatusFinished).
+		Order("date asc, start_time asc, id asc").
+		Find(&matches).Error
 	return matches, err
 }
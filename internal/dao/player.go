@@ -1,22 +1,114 @@
 package dao
 
 import (
+	"log"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	pb "nba-remake/api/proto/v1"
 	"nba-remake/internal/model"
 )
 
+// PlayerIndexer 球员搜索索引 (ES), 写库成功后同步
+type PlayerIndexer interface {
+	IndexPlayer(player *model.Player) error
+	DeletePlayer(id uint32) error
+}
+
 type PlayerDao struct {
-	db *gorm.DB
+	db      *gorm.DB
+	indexer PlayerIndexer
+}
+
+// NewPlayerDao indexer 可以为 nil (不同步搜索索引)
+func NewPlayerDao(db *gorm.DB, indexer PlayerIndexer) *PlayerDao {
+	return &PlayerDao{db: db, indexer: indexer}
+}
+
+// WithTx 在事务中执行, fn 内使用传入的 txDao
+// txDao 不同步搜索索引 (事务可能回滚), 提交后由调用方 SyncIndex
+func (d *PlayerDao) WithTx(fn func(txDao *PlayerDao) error) error {
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&PlayerDao{db: tx})
+	})
+}
+
+// Transactions 同一事务 (或连接) 上的异动记录 DAO
+func (d *PlayerDao) Transactions() *TransactionDao {
+	return &TransactionDao{db: d.db}
+}
+
+// Contracts 同一事务 (或连接) 上的合同 DAO
+func (d *PlayerDao) Contracts() *ContractDao {
+	return &ContractDao{db: d.db}
+}
+
+// Injuries 同一事务 (或连接) 上的伤病 DAO
+func (d *PlayerDao) Injuries() *InjuryDao {
+	return &InjuryDao{db: d.db}
+}
+
+// Drafts 同一事务 (或连接) 上的选秀 DAO
+func (d *PlayerDao) Drafts() *DraftDao {
+	return &DraftDao{db: d.db}
+}
+
+// LockRoster 锁定球队行 (SELECT ... FOR UPDATE) 并返回当前阵容, 同一球队的阵容变更串行执行
+// 球队不存在时返回 gorm.ErrRecordNotFound
+func (d *PlayerDao) LockRoster(teamID uint32) (*model.Roster, error) {
+	var team model.Team
+	if err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&team, teamID).Error; err != nil {
+		return nil, err
+	}
+	return d.GetRoster(teamID)
+}
+
+// GetRoster 球队当前阵容 (不含退役球员), 按名额类型、球衣号排序
+func (d *PlayerDao) GetRoster(teamID uint32) (*model.Roster, error) {
+	var players []*model.Player
+	err := d.db.Where("team_id = ? AND status <> ?", teamID, pb.PlayerStatus_RETIRED).
+		Order("roster_slot asc, jersey_number asc").Find(&players).Error
+	if err != nil {
+		return nil, err
+	}
+	return &model.Roster{TeamID: teamID, Players: players}, nil
+}
+
+// GetForUpdate 加锁查询球员
+func (d *PlayerDao) GetForUpdate(id uint32) (*model.Player, error) {
+	var player model.Player
+	err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&player, id).Error
+	return &player, err
+}
+
+// LockPlayers 按ID升序加锁批量查询球员, 返回 id -> player
+func (d *PlayerDao) LockPlayers(ids []uint32) (map[uint32]*model.Player, error) {
+	players := make(map[uint32]*model.Player, len(ids))
+	if len(ids) == 0 {
+		return players, nil
+	}
+	var rows []*model.Player
+	err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", ids).Order("id asc").Find(&rows).Error
+	for _, p := range rows {
+		players[p.ID] = p
+	}
+	return players, err
 }
 
-func NewPlayerDao(db *gorm.DB) *PlayerDao {
-	return &PlayerDao{db: db}
+// SyncIndex 同步搜索索引 (事务提交后调用)
+func (d *PlayerDao) SyncIndex(players ...*model.Player) {
+	for _, p := range players {
+		d.syncIndex(p)
+	}
 }
 
 // 创建球员
 func (d *PlayerDao) CreatePlayer(player *model.Player) error {
-	return d.db.Create(player).Error
+	if err := d.db.Create(player).Error; err != nil {
+		return err
+	}
+	d.syncIndex(player)
+	return nil
 }
 
 // 根据id查询
@@ -29,14 +121,53 @@ func (d *PlayerDao) GetPlayerByID(id uint32) (*model.Player, error) {
 	return &player, nil
 }
 
+// 根据id批量查询
+func (d *PlayerDao) GetPlayersByIDs(ids []uint32) ([]*model.Player, error) {
+	var players []*model.Player
+	if len(ids) == 0 {
+		return players, nil
+	}
+	err := d.db.Where("id IN ?", ids).Find(&players).Error
+	return players, err
+}
+
 // 更新
 func (d *PlayerDao) UpdatePlayer(player *model.Player) error {
-	return d.db.Save(player).Error
+	if err := d.db.Save(player).Error; err != nil {
+		return err
+	}
+	d.syncIndex(player)
+	return nil
 }
 
 // 删除
 func (d *PlayerDao) DeletePlayer(id uint32) error {
-	return d.db.Delete(&model.Player{}, id).Error
+	if err := d.db.Delete(&model.Player{}, id).Error; err != nil {
+		return err
+	}
+	if d.indexer != nil {
+		if err := d.indexer.DeletePlayer(id); err != nil {
+			log.Printf("[PlayerDao] 删除搜索索引失败 id=%d err=%v", id, err)
+		}
+	}
+	return nil
+}
+
+// 查询全部 (重建搜索索引用)
+func (d *PlayerDao) ListAll() ([]*model.Player, error) {
+	var players []*model.Player
+	err := d.db.Order("id asc").Find(&players).Error
+	return players, err
+}
+
+// syncIndex 同步搜索索引; MySQL 为准, 索引失败只记录日志
+func (d *PlayerDao) syncIndex(player *model.Player) {
+	if d.indexer == nil {
+		return
+	}
+	if err := d.indexer.IndexPlayer(player); err != nil {
+		log.Printf("[PlayerDao] 同步搜索索引失败 id=%d err=%v", player.ID, err)
+	}
 }
 
 func (d *PlayerDao) ListPlayersByFilter(name string, teamID uint32, position pb.Position, status uint8, page, pageSize int) ([]*model.Player, int64, error) {
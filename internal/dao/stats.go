@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"gorm.io/gorm"
+	"nba-remake/internal/model"
+)
+
+type StatsDao struct {
+	db *gorm.DB
+}
+
+func NewStatsDao(db *gorm.DB) *StatsDao {
+	return &StatsDao{db: db}
+}
+
+// ListByMatch 查单场比赛所有球员的技术统计 (按得分排序)
+func (d *StatsDao) ListByMatch(matchID int64) ([]*model.PlayerGameStats, error) {
+	var stats []*model.PlayerGameStats
+	err := d.db.Where("match_id = ?", matchID).
+		Order("points desc").
+		Find(&stats).Error
+	return stats, err
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"nba-remake/internal/model"
 )
 
@@ -29,3 +30,156 @@ func (d *TeamDao) GetAll() ([]*model.Team, error) {
 	err := d.db.Order("name asc").Find(&teams).Error
 	return teams, err
 }
+
+// GetByIDs 批量查球队, 返回 id -> team
+func (d *TeamDao) GetByIDs(ids []uint32) (map[uint32]*model.Team, error) {
+	var teams []*model.Team
+	if err := d.db.Where("id IN ?", ids).Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[uint32]*model.Team, len(teams))
+	for _, t := range teams {
+		result[t.ID] = t
+	}
+	return result, nil
+}
+
+// WithTx 在事务中执行, fn 内使用传入的 txDao
+func (d *TeamDao) WithTx(fn func(txDao *TeamDao) error) error {
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&TeamDao{db: tx})
+	})
+}
+
+// League 共用同一连接 (事务) 的 LeagueDao
+func (d *TeamDao) League() *LeagueDao {
+	return NewLeagueDao(d.db)
+}
+
+// AbbreviationTaken 缩写是否已被其他球队使用
+func (d *TeamDao) AbbreviationTaken(abbr string, excludeID uint32) (bool, error) {
+	var count int64
+	err := d.db.Model(&model.Team{}).Where("abbreviation = ? AND id <> ?", abbr, excludeID).Count(&count).Error
+	return count > 0, err
+}
+
+// Create 创建球队
+func (d *TeamDao) Create(team *model.Team) error {
+	return d.db.Create(team).Error
+}
+
+// Update 更新球队当前信息
+func (d *TeamDao) Update(team *model.Team) error {
+	return d.db.Save(team).Error
+}
+
+// CountReferences 引用该球队的比赛数和球员数 (有引用时不能删除)
+func (d *TeamDao) CountReferences(id uint32) (matches int64, players int64, err error) {
+	if err = d.db.Model(&model.Match{}).Where("home_team_id = ? OR visitor_team_id = ?", id, id).Count(&matches).Error; err != nil {
+		return
+	}
+	err = d.db.Model(&model.Player{}).Where("team_id = ?", id).Count(&players).Error
+	return
+}
+
+// Delete 删除球队及其赛季信息、赛区归属
+func (d *TeamDao) Delete(id uint32) error {
+	if err := d.db.Where("team_id = ?", id).Delete(&model.TeamSeason{}).Error; err != nil {
+		return err
+	}
+	if err := d.db.Where("team_id = ?", id).Delete(&model.TeamDivision{}).Error; err != nil {
+		return err
+	}
+	return d.db.Delete(&model.Team{}, id).Error
+}
+
+// SaveSeason 写入赛季展示信息 (同赛季重复写入则覆盖)
+func (d *TeamDao) SaveSeason(ts *model.TeamSeason) error {
+	return d.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "team_id"}, {Name: "season"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "abbreviation", "city", "logo_url", "home_arena"}),
+	}).Create(ts).Error
+}
+
+// SeedSeasons 为没有赛季记录的球队 (赛季表之前已存在) 写入当前信息作为 season 起的基线
+// 之后更名只新增赛季记录, 早于更名的比赛仍取到基线的队名
+func (d *TeamDao) SeedSeasons(season string) error {
+	var teams []*model.Team
+	err := d.db.Where("id NOT IN (?)", d.db.Model(&model.TeamSeason{}).Select("team_id")).Find(&teams).Error
+	if err != nil {
+		return err
+	}
+	for _, t := range teams {
+		if err := d.SaveSeason(t.SeasonProfile(season)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LatestSeason 球队最近一条赛季信息的生效赛季, 没有记录返回空串
+func (d *TeamDao) LatestSeason(teamID uint32) (string, error) {
+	var season *string
+	err := d.db.Model(&model.TeamSeason{}).Select("MAX(season)").Where("team_id = ?", teamID).Scan(&season).Error
+	if err != nil || season == nil {
+		return "", err
+	}
+	return *season, nil
+}
+
+// History 球队全部赛季信息, 按生效赛季升序
+func (d *TeamDao) History(teamID uint32) ([]*model.TeamSeason, error) {
+	var rows []*model.TeamSeason
+	err := d.db.Where("team_id = ?", teamID).Order("season asc").Find(&rows).Error
+	return rows, err
+}
+
+// SeasonProfiles 某赛季各球队的展示信息, 返回 team_id -> 信息; teamIDs 为空时查全部球队
+func (d *TeamDao) SeasonProfiles(season string, teamIDs []uint32) (map[uint32]*model.TeamSeason, error) {
+	return seasonProfiles(d.db, season, teamIDs)
+}
+
+func seasonProfiles(db *gorm.DB, season string, teamIDs []uint32) (map[uint32]*model.TeamSeason, error) {
+	latest := db.Model(&model.TeamSeason{}).
+		Select("team_id, MAX(season) AS season").
+		Where("season <= ?", season).
+		Group("team_id")
+	if len(teamIDs) > 0 {
+		latest = latest.Where("team_id IN ?", teamIDs)
+	}
+	var rows []*model.TeamSeason
+	err := db.Joins("JOIN (?) AS latest ON latest.team_id = team_seasons.team_id AND latest.season = team_seasons.season", latest).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint32]*model.TeamSeason, len(rows))
+	for _, row := range rows {
+		result[row.TeamID] = row
+	}
+	return result, nil
+}
+
+// applyTeamSeasons 比赛中的主客队按比赛所属赛季显示当时的队名/城市/队徽
+func applyTeamSeasons(db *gorm.DB, matches ...*model.Match) error {
+	bySeason := make(map[string][]uint32)
+	for _, m := range matches {
+		bySeason[m.Season] = append(bySeason[m.Season], uint32(m.HomeTeamID), uint32(m.VisitorTeamID))
+	}
+	for season, ids := range bySeason {
+		profiles, err := seasonProfiles(db, season, ids)
+		if err != nil {
+			return err
+		}
+		if len(profiles) == 0 {
+			continue
+		}
+		for _, m := range matches {
+			if m.Season == season {
+				m.HomeTeam.ApplySeason(profiles[uint32(m.HomeTeamID)])
+				m.VisitorTeam.ApplySeason(profiles[uint32(m.VisitorTeamID)])
+			}
+		}
+	}
+	return nil
+}
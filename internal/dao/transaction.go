@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/model"
+)
+
+// TransactionDao 球员异动记录、交易和选秀权
+type TransactionDao struct {
+	db *gorm.DB
+}
+
+func NewTransactionDao(db *gorm.DB) *TransactionDao {
+	return &TransactionDao{db: db}
+}
+
+// Record 追加异动记录
+func (d *TransactionDao) Record(txs ...*model.PlayerTransaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	return d.db.Create(txs).Error
+}
+
+// History 球员的异动记录, 按生效日期升序 (同一天按写入顺序)
+func (d *TransactionDao) History(playerID uint32) ([]*model.PlayerTransaction, error) {
+	var txs []*model.PlayerTransaction
+	err := d.db.Where("player_id = ?", playerID).Order("effective_date asc, id asc").Find(&txs).Error
+	return txs, err
+}
+
+// LastSignings 球员最近一次签约的生效日期, 返回 player_id -> 日期 (没有签约记录的不在结果中)
+func (d *TransactionDao) LastSignings(playerIDs []uint32) (map[uint32]time.Time, error) {
+	signed := make(map[uint32]time.Time, len(playerIDs))
+	if len(playerIDs) == 0 {
+		return signed, nil
+	}
+	var rows []*model.PlayerTransaction
+	err := d.db.Where("player_id IN ? AND type = ?", playerIDs, pb.TransactionType_SIGNING).
+		Order("effective_date asc, id asc").Find(&rows).Error
+	for _, t := range rows {
+		signed[t.PlayerID] = t.EffectiveDate
+	}
+	return signed, err
+}
+
+// CreateTrade 写入交易 (连同 Picks)
+func (d *TransactionDao) CreateTrade(trade *model.Trade) error {
+	return d.db.Create(trade).Error
+}
+
+// GetPicksForUpdate 加锁查询选秀权, 返回 id -> pick
+func (d *TransactionDao) GetPicksForUpdate(ids []uint32) (map[uint32]*model.DraftPick, error) {
+	picks := make(map[uint32]*model.DraftPick, len(ids))
+	if len(ids) == 0 {
+		return picks, nil
+	}
+	var rows []*model.DraftPick
+	err := d.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", ids).Order("id asc").Find(&rows).Error
+	for _, p := range rows {
+		picks[p.ID] = p
+	}
+	return picks, err
+}
+
+// SetPickOwner 修改选秀权持有球队
+func (d *TransactionDao) SetPickOwner(id, ownerTeamID uint32) error {
+	return d.db.Model(&model.DraftPick{}).Where("id = ?", id).Update("owner_team_id", ownerTeamID).Error
+}
+
+// SetPickProtection 修改选秀权保护, protectedBy 为附加保护的球队
+func (d *TransactionDao) SetPickProtection(id uint32, protection int, protectedBy uint32) error {
+	return d.db.Model(&model.DraftPick{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"protection": protection, "protected_by": protectedBy}).Error
+}
@@ -0,0 +1,114 @@
+package draft
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// LotteryOdds 抽签前排名 1-N 的乐透球队各自的组合数 (共 1000 组), 即抽中状元签的千分比
+// 乐透球队少于 14 支时取前 N 项, 概率按实际组合总数折算
+var LotteryOdds = []int{140, 140, 140, 125, 105, 90, 75, 60, 45, 30, 20, 15, 10, 5}
+
+// LotteryDraws 通过抽签决定的顺位数, 其余乐透球队按抽签前排名依次排在之后
+const LotteryDraws = 4
+
+// Record 决定选秀顺位的常规赛战绩
+type Record struct {
+	TeamID uint32
+	Wins   int
+	Losses int
+}
+
+// Result 抽签结果
+type Result struct {
+	Standings  []uint32 // 抽签前排名: 战绩由差到好, 同胜率由种子决定; 也是第二轮起各轮的顺位
+	Lottery    int      // 实际参加抽签的球队数
+	FirstRound []uint32 // 第一轮顺位
+}
+
+// Odds 抽签前排名 rank (从 1 开始) 的球队抽中状元签的概率 (%), 非乐透球队为 0
+func Odds(rank, lotteryTeams int) float64 {
+	n := min(lotteryTeams, len(LotteryOdds))
+	if rank < 1 || rank > n {
+		return 0
+	}
+	total := 0
+	for _, c := range LotteryOdds[:n] {
+		total += c
+	}
+	return float64(LotteryOdds[rank-1]) * 100 / float64(total)
+}
+
+// Draw 按战绩排定抽签前排名并抽签; 相同的 records/lotteryTeams/seed 总是得到相同的结果
+// records 的顺序不影响结果
+func Draw(records []Record, lotteryTeams int, seed int64) *Result {
+	rng := rand.New(rand.NewSource(seed))
+
+	sorted := append([]Record(nil), records...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if c := compare(sorted[i], sorted[j]); c != 0 {
+			return c < 0
+		}
+		return sorted[i].TeamID < sorted[j].TeamID
+	})
+	// 同胜率的球队由种子打乱 (相当于抛硬币)
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && compare(sorted[i], sorted[j]) == 0 {
+			j++
+		}
+		group := sorted[i:j]
+		rng.Shuffle(len(group), func(a, b int) { group[a], group[b] = group[b], group[a] })
+		i = j
+	}
+
+	res := &Result{Lottery: min(lotteryTeams, len(LotteryOdds), len(sorted))}
+	for _, r := range sorted {
+		res.Standings = append(res.Standings, r.TeamID)
+	}
+
+	drawn := make(map[int]bool, LotteryDraws)
+	for k := 0; k < min(LotteryDraws, res.Lottery); k++ {
+		total := 0
+		for rank := 0; rank < res.Lottery; rank++ {
+			if !drawn[rank] {
+				total += LotteryOdds[rank]
+			}
+		}
+		n := rng.Intn(total)
+		for rank := 0; rank < res.Lottery; rank++ {
+			if drawn[rank] {
+				continue
+			}
+			if n -= LotteryOdds[rank]; n < 0 {
+				drawn[rank] = true
+				res.FirstRound = append(res.FirstRound, res.Standings[rank])
+				break
+			}
+		}
+	}
+	for rank, id := range res.Standings {
+		if !drawn[rank] {
+			res.FirstRound = append(res.FirstRound, id)
+		}
+	}
+	return res
+}
+
+// compare 按胜率比较, a 战绩更差时返回负数; 未比赛的球队胜率按 0 计
+func compare(a, b Record) int {
+	// a.W/(a.W+a.L) 与 b.W/(b.W+b.L) 交叉相乘比较, 避免浮点误差
+	x, y := a.Wins*(b.Wins+b.Losses), b.Wins*(a.Wins+a.Losses)
+	if a.Wins+a.Losses == 0 {
+		x, y = 0, min(b.Wins, 1)
+	} else if b.Wins+b.Losses == 0 {
+		x, y = min(a.Wins, 1), 0
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
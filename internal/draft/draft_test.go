@@ -0,0 +1,167 @@
+package draft
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// records n 支球队, 球队 i 的战绩为 i 胜 n-i 负 (球队 1 最差)
+func records(n int) []Record {
+	out := make([]Record, 0, n)
+	for i := 1; i <= n; i++ {
+		out = append(out, Record{TeamID: uint32(i), Wins: i, Losses: n - i})
+	}
+	return out
+}
+
+// checkFirstRound 前 LotteryDraws 个顺位是不重复的乐透球队, 其余按抽签前排名依次排列
+func checkFirstRound(t *testing.T, res *Result) {
+	t.Helper()
+	lottery := make(map[uint32]bool, res.Lottery)
+	for _, id := range res.Standings[:res.Lottery] {
+		lottery[id] = true
+	}
+	drawn := min(LotteryDraws, res.Lottery)
+	picked := make(map[uint32]bool, drawn)
+	for _, id := range res.FirstRound[:drawn] {
+		if !lottery[id] || picked[id] {
+			t.Fatalf("抽签顺位 %v 不是不重复的乐透球队", res.FirstRound[:drawn])
+		}
+		picked[id] = true
+	}
+	rest := res.FirstRound[drawn:]
+	for _, id := range res.Standings {
+		if picked[id] {
+			continue
+		}
+		if len(rest) == 0 || rest[0] != id {
+			t.Fatalf("未抽中的顺位 = %v, 抽签前排名 %v", res.FirstRound[drawn:], res.Standings)
+		}
+		rest = rest[1:]
+	}
+}
+
+func TestDraw(t *testing.T) {
+	for seed := int64(1); seed <= 200; seed++ {
+		res := Draw(records(30), 14, seed)
+		if res.Lottery != 14 || len(res.FirstRound) != 30 {
+			t.Fatalf("seed %d: Lottery = %d, 第一轮 %d 个顺位", seed, res.Lottery, len(res.FirstRound))
+		}
+		// 战绩互不相同时抽签前排名就是战绩由差到好
+		for i, id := range res.Standings {
+			if id != uint32(i+1) {
+				t.Fatalf("seed %d: Standings = %v", seed, res.Standings)
+			}
+		}
+		checkFirstRound(t, res)
+		// 抽签最多把球队往后推 LotteryDraws 位
+		for i, id := range res.FirstRound {
+			if i+1 > int(id)+LotteryDraws {
+				t.Fatalf("seed %d: 抽签前第 %d 的球队落到第 %d 顺位", seed, id, i+1)
+			}
+		}
+	}
+}
+
+func TestDrawLotterySize(t *testing.T) {
+	// 乐透名额超过赔率表按赔率表, 少于抽签顺位时全部抽签, 为 0 时不抽签
+	for _, tt := range []struct {
+		teams, lotteryTeams, want int
+	}{
+		{30, 20, len(LotteryOdds)},
+		{6, 14, 6},
+		{10, 2, 2},
+		{10, 0, 0},
+	} {
+		res := Draw(records(tt.teams), tt.lotteryTeams, 7)
+		if res.Lottery != tt.want {
+			t.Errorf("%d 队 %d 个乐透名额: Lottery = %d, want %d", tt.teams, tt.lotteryTeams, res.Lottery, tt.want)
+			continue
+		}
+		checkFirstRound(t, res)
+	}
+	if res := Draw(records(10), 0, 7); !reflect.DeepEqual(res.FirstRound, res.Standings) {
+		t.Errorf("不抽签时第一轮应按抽签前排名: %v", res.FirstRound)
+	}
+}
+
+// 大量种子下状元签的分布应接近赔率表
+func TestDrawFrequencies(t *testing.T) {
+	const runs = 20000
+	first := make(map[uint32]int)
+	for seed := int64(0); seed < runs; seed++ {
+		first[Draw(records(30), 14, seed).FirstRound[0]]++
+	}
+	for rank, want := range map[uint32]float64{1: 14, 4: 12.5, 8: 6, 14: 0.5, 15: 0} {
+		if got := float64(first[rank]) * 100 / runs; math.Abs(got-want) > 1 {
+			t.Errorf("抽签前第 %d 抽中状元签 %.2f%%, want 约 %.1f%%", rank, got, want)
+		}
+	}
+}
+
+func TestDrawSeed(t *testing.T) {
+	// 球队 4 和 5 同胜率
+	recs := records(30)
+	recs[3].Wins, recs[3].Losses = recs[4].Wins, recs[4].Losses
+	reversed := make([]Record, len(recs))
+	for i, r := range recs {
+		reversed[len(recs)-1-i] = r
+	}
+
+	for _, seed := range []int64{1, 42, -7, math.MaxInt64} {
+		want := Draw(recs, 14, seed)
+		if got := Draw(recs, 14, seed); !reflect.DeepEqual(got, want) {
+			t.Errorf("seed %d: 相同种子结果不同", seed)
+		}
+		if got := Draw(reversed, 14, seed); !reflect.DeepEqual(got, want) {
+			t.Errorf("seed %d: records 的顺序影响了结果", seed)
+		}
+	}
+
+	// 同胜率的两队由种子决定先后, 两种结果都会出现
+	ahead := make(map[uint32]bool)
+	for seed := int64(1); seed <= 100; seed++ {
+		ahead[Draw(recs, 14, seed).Standings[3]] = true
+	}
+	if !ahead[4] || !ahead[5] {
+		t.Errorf("同胜率的球队 4/5 排名没有由种子决定: %v", ahead)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b Record
+		want int
+	}{
+		{Record{Wins: 10, Losses: 30}, Record{Wins: 20, Losses: 20}, -1},
+		{Record{Wins: 1, Losses: 1}, Record{Wins: 20, Losses: 20}, 0},
+		{Record{}, Record{Losses: 3}, 0}, // 未比赛按胜率 0 计, 与全败同胜率
+		{Record{}, Record{Wins: 1, Losses: 9}, -1},
+		{Record{Wins: 2}, Record{}, 1},
+	}
+	for _, tt := range tests {
+		if got := compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("compare(%+v, %+v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestOdds(t *testing.T) {
+	for _, tt := range []struct {
+		rank, lotteryTeams int
+		want               float64
+	}{
+		{1, 14, 14},
+		{4, 14, 12.5},
+		{14, 14, 0.5},
+		{15, 14, 0},
+		{0, 14, 0},
+		{1, 4, 140 * 100 / 545.0}, // 只有 4 支乐透球队时按 545 组折算
+		{3, 20, 14},
+	} {
+		if got := Odds(tt.rank, tt.lotteryTeams); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Odds(%d, %d) = %v, want %v", tt.rank, tt.lotteryTeams, got, tt.want)
+		}
+	}
+}
@@ -1,7 +1,14 @@
 package es
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
 	"nba-remake/internal/config"
 )
 
@@ -18,3 +25,79 @@ func NewEsClient(conf *config.ElasticsearchConfig) *elasticsearch.Client {
 	}
 	return esClient
 }
+
+// IndexName 拼接索引名, 例如 prefix=nba_ name=players -> nba_players
+func IndexName(conf *config.ElasticsearchConfig, name string) string {
+	return conf.IndexPrefix + name
+}
+
+// readResponse 检查 ES 返回状态, 成功时把响应体解析到 out (out 可为 nil)
+func readResponse(res *esapi.Response, err error, out interface{}) error {
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("elasticsearch %s: %s", res.Status(), body)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// ensureIndex 索引不存在时按 mapping 创建, 返回是否新建
+// 创建失败 (如依赖的分词插件未安装) 时依次改用 fallbacks 中的 mapping
+func ensureIndex(client *elasticsearch.Client, index, mapping string, fallbacks ...string) (bool, error) {
+	res, err := client.Indices.Exists([]string{index})
+	if err != nil {
+		return false, err
+	}
+	res.Body.Close()
+	if res.StatusCode == 200 {
+		return false, nil
+	}
+
+	for _, m := range fallbacks {
+		res, err = client.Indices.Create(index, client.Indices.Create.WithBody(strings.NewReader(mapping)))
+		if err = readResponse(res, err, nil); err == nil {
+			return true, nil
+		}
+		log.Printf("[ES] 索引 %s 创建失败, 改用备用 mapping: %v", index, err)
+		mapping = m
+	}
+	res, err = client.Indices.Create(index, client.Indices.Create.WithBody(strings.NewReader(mapping)))
+	if err := readResponse(res, err, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// bucket terms 聚合的单个桶
+type bucket struct {
+	Key      interface{} `json:"key"`
+	DocCount int64       `json:"doc_count"`
+}
+
+// searchResult 通用的搜索响应结构
+type searchResult struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			ID     string          `json:"_id"`
+			Source json.RawMessage `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+	Aggregations map[string]aggregation `json:"aggregations"`
+}
+
+// aggregation terms 聚合结果
+// 分面统计需要排除其它条件时外层包一个 filter 聚合, terms 结果在名为 facet 的子聚合中
+type aggregation struct {
+	Buckets []bucket     `json:"buckets"`
+	Facet   *aggregation `json:"facet"`
+}
@@ -0,0 +1,267 @@
+package es
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"nba-remake/internal/config"
+	"nba-remake/internal/model"
+)
+
+// eventMapping 比赛事件 (play-by-play) 索引
+// seconds_remaining 由 time_remaining 换算而来, 用于按比赛时钟做范围过滤
+const eventMapping = `{
+  "mappings": {
+    "properties": {
+      "id":                {"type": "long"},
+      "event_id":          {"type": "keyword"},
+      "match_id":          {"type": "long"},
+      "season":            {"type": "keyword"},
+      "match_date":        {"type": "date", "format": "yyyy-MM-dd"},
+      "player_id":         {"type": "integer"},
+      "team_id":           {"type": "integer"},
+      "type":              {"type": "keyword"},
+      "shot_type":         {"type": "keyword"},
+      "sub_type": {
+        "type": "keyword",
+        "fields": {
+          "text": {"type": "text"}
+        }
+      },
+      "value":             {"type": "integer"},
+      "quarter":           {"type": "integer"},
+      "time_remaining":    {"type": "keyword"},
+      "seconds_remaining": {"type": "integer"},
+      "event_time":        {"type": "date"},
+      "voided":            {"type": "boolean"},
+      "amends_event_id":   {"type": "keyword"}
+    }
+  }
+}`
+
+// EventDoc 索引中的事件文档, 文档ID 为 match_events.id (历史数据没有 event_id)
+type EventDoc struct {
+	ID               uint64 `json:"id"`
+	EventID          string `json:"event_id,omitempty"`
+	MatchID          uint64 `json:"match_id"`
+	Season           string `json:"season,omitempty"`
+	MatchDate        string `json:"match_date,omitempty"`
+	PlayerID         uint32 `json:"player_id"`
+	TeamID           uint32 `json:"team_id"`
+	Type             string `json:"type"`
+	ShotType         string `json:"shot_type"`
+	SubType          string `json:"sub_type,omitempty"`
+	Value            int    `json:"value"`
+	Quarter          int8   `json:"quarter"`
+	TimeRemaining    string `json:"time_remaining,omitempty"`
+	SecondsRemaining *int   `json:"seconds_remaining,omitempty"`
+	EventTime        string `json:"event_time"`
+	Voided           bool   `json:"voided"`
+	AmendsEventID    string `json:"amends_event_id,omitempty"`
+}
+
+// EventQuery 事件搜索条件, 过滤条件为零值时不生效
+// MinSecondsRemaining/MaxSecondsRemaining 为单节剩余时间范围 (闭区间), nil 表示不限
+type EventQuery struct {
+	Keyword             string // 全文匹配 sub_type, e.g. "step back"
+	MatchID             uint64
+	PlayerID            uint32
+	TeamID              uint32
+	Season              string
+	Type                string
+	ShotType            string
+	SubType             string
+	Quarter             int8
+	MinSecondsRemaining *int
+	MaxSecondsRemaining *int
+	IncludeVoided       bool
+	From                int
+	Size                int
+}
+
+// EventSearchResult 搜索结果, Facets 的 key 为 sub_type/type/shot_type/quarter/player_id/team_id
+type EventSearchResult struct {
+	Total  int64
+	Events []*EventDoc
+	Facets map[string][]FacetBucket
+}
+
+// EventIndex nba_match_events 索引
+type EventIndex struct {
+	client *elasticsearch.Client
+	index  string
+}
+
+func NewEventIndex(client *elasticsearch.Client, conf *config.ElasticsearchConfig) *EventIndex {
+	return &EventIndex{client: client, index: IndexName(conf, "match_events")}
+}
+
+// EnsureIndex 索引不存在时创建, 返回是否新建 (新建后需要全量导入)
+func (e *EventIndex) EnsureIndex() (bool, error) {
+	return ensureIndex(e.client, e.index, eventMapping)
+}
+
+// BulkIndex 批量写入/覆盖事件, matches 提供赛季和比赛日期 (缺失时不填)
+func (e *EventIndex) BulkIndex(events []*model.MatchEvent, matches map[uint64]*model.Match) error {
+	if len(events) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, event := range events {
+		meta := fmt.Sprintf(`{"index":{"_id":"%d"}}`, event.ID)
+		doc, err := json.Marshal(newEventDoc(event, matches[event.MatchID]))
+		if err != nil {
+			return err
+		}
+		buf.WriteString(meta)
+		buf.WriteByte('\n')
+		buf.Write(doc)
+		buf.WriteByte('\n')
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+	}
+	res, err := e.client.Bulk(&buf, e.client.Bulk.WithIndex(e.index))
+	if err := readResponse(res, err, &result); err != nil {
+		return err
+	}
+	if result.Errors {
+		return fmt.Errorf("批量写入部分失败")
+	}
+	return nil
+}
+
+// Search 按条件过滤事件, 同时返回按 sub_type/type 等维度的计数
+// 排序: 比赛日期倒序, 同场比赛内按比赛进程 (节次升序, 剩余时间降序)
+func (e *EventIndex) Search(q EventQuery) (*EventSearchResult, error) {
+	filters := []map[string]interface{}{}
+	term := func(field string, value interface{}) {
+		filters = append(filters, map[string]interface{}{"term": map[string]interface{}{field: value}})
+	}
+	if q.MatchID > 0 {
+		term("match_id", q.MatchID)
+	}
+	if q.PlayerID > 0 {
+		term("player_id", q.PlayerID)
+	}
+	if q.TeamID > 0 {
+		term("team_id", q.TeamID)
+	}
+	if q.Season != "" {
+		term("season", q.Season)
+	}
+	if q.Type != "" {
+		term("type", q.Type)
+	}
+	if q.ShotType != "" {
+		term("shot_type", q.ShotType)
+	}
+	if q.SubType != "" {
+		term("sub_type", q.SubType)
+	}
+	if q.Quarter > 0 {
+		term("quarter", q.Quarter)
+	}
+	if !q.IncludeVoided {
+		term("voided", false)
+	}
+	if q.MinSecondsRemaining != nil || q.MaxSecondsRemaining != nil {
+		clock := map[string]interface{}{}
+		if q.MinSecondsRemaining != nil {
+			clock["gte"] = *q.MinSecondsRemaining
+		}
+		if q.MaxSecondsRemaining != nil {
+			clock["lte"] = *q.MaxSecondsRemaining
+		}
+		filters = append(filters, map[string]interface{}{"range": map[string]interface{}{"seconds_remaining": clock}})
+	}
+
+	must := []map[string]interface{}{{"match_all": map[string]interface{}{}}}
+	if kw := strings.TrimSpace(q.Keyword); kw != "" {
+		must = []map[string]interface{}{{
+			"match": map[string]interface{}{"sub_type.text": map[string]interface{}{"query": kw, "fuzziness": "AUTO"}},
+		}}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"from":  q.From,
+		"size":  q.Size,
+		"query": map[string]interface{}{"bool": map[string]interface{}{"must": must, "filter": filters}},
+		"sort": []map[string]interface{}{
+			{"match_date": map[string]interface{}{"order": "desc", "missing": "_last"}},
+			{"match_id": "desc"},
+			{"quarter": "asc"},
+			{"seconds_remaining": map[string]interface{}{"order": "desc", "missing": "_last"}},
+			{"id": "asc"},
+		},
+		"aggs": map[string]interface{}{
+			"sub_type":  map[string]interface{}{"terms": map[string]interface{}{"field": "sub_type", "size": 50}},
+			"type":      map[string]interface{}{"terms": map[string]interface{}{"field": "type", "size": 30}},
+			"shot_type": map[string]interface{}{"terms": map[string]interface{}{"field": "shot_type"}},
+			"quarter":   map[string]interface{}{"terms": map[string]interface{}{"field": "quarter", "size": 10, "order": map[string]interface{}{"_key": "asc"}}},
+			"player_id": map[string]interface{}{"terms": map[string]interface{}{"field": "player_id", "size": 20}},
+			"team_id":   map[string]interface{}{"terms": map[string]interface{}{"field": "team_id", "size": 50}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sr searchResult
+	res, err := e.client.Search(
+		e.client.Search.WithIndex(e.index),
+		e.client.Search.WithBody(bytes.NewReader(body)),
+		e.client.Search.WithTrackTotalHits(true),
+	)
+	if err := readResponse(res, err, &sr); err != nil {
+		return nil, err
+	}
+
+	result := &EventSearchResult{Total: sr.Hits.Total.Value, Facets: facetsOf(&sr)}
+	for _, hit := range sr.Hits.Hits {
+		var doc EventDoc
+		if err := json.Unmarshal(hit.Source, &doc); err != nil {
+			return nil, err
+		}
+		result.Events = append(result.Events, &doc)
+	}
+	return result, nil
+}
+
+func newEventDoc(e *model.MatchEvent, match *model.Match) *EventDoc {
+	doc := &EventDoc{
+		ID:            e.ID,
+		MatchID:       e.MatchID,
+		PlayerID:      e.PlayerID,
+		TeamID:        e.TeamID,
+		Type:          e.Type.String(),
+		ShotType:      e.ShotType.String(),
+		SubType:       e.SubType,
+		Value:         e.Value,
+		Quarter:       e.Quarter,
+		TimeRemaining: e.TimeRemaining,
+		EventTime:     e.EventTime.Format(time.RFC3339),
+		Voided:        e.Voided,
+	}
+	if e.EventID != nil {
+		doc.EventID = *e.EventID
+	}
+	if e.AmendsEventID != nil {
+		doc.AmendsEventID = *e.AmendsEventID
+	}
+	if secs, err := model.ParseClock(e.TimeRemaining); err == nil {
+		doc.SecondsRemaining = &secs
+	}
+	if match != nil {
+		doc.Season = match.Season
+		if !match.Date.IsZero() {
+			doc.MatchDate = match.Date.Format("2006-01-02")
+		}
+	}
+	return doc
+}
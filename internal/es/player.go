@@ -0,0 +1,308 @@
+package es
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"nba-remake/internal/config"
+	"nba-remake/internal/model"
+)
+
+// playerMapping 球员索引
+// name/aliases 同时建 pinyin (需要 analysis-pinyin 插件) 和 phonetic (需要 analysis-phonetic 插件) 子字段,
+// 支持 "zhanmusi" / "zms" 搜 "詹姆斯", "Jokic" 搜 "Jokić" 之类的模糊匹配
+const playerMapping = `{
+  "settings": {
+    "analysis": {
+      "tokenizer": {
+        "pinyin_tokenizer": {
+          "type": "pinyin",
+          "keep_full_pinyin": true,
+          "keep_joined_full_pinyin": true,
+          "keep_first_letter": true,
+          "keep_original": false,
+          "lowercase": true
+        }
+      },
+      "filter": {
+        "name_phonetic": {"type": "phonetic", "encoder": "double_metaphone", "replace": false}
+      },
+      "analyzer": {
+        "pinyin_analyzer": {"tokenizer": "pinyin_tokenizer"},
+        "phonetic_analyzer": {"tokenizer": "standard", "filter": ["lowercase", "asciifolding", "name_phonetic"]}
+      }
+    }
+  },
+  "mappings": {
+    "properties": {
+      "name": {
+        "type": "text",
+        "fields": {
+          "keyword":  {"type": "keyword"},
+          "pinyin":   {"type": "text", "analyzer": "pinyin_analyzer"},
+          "phonetic": {"type": "text", "analyzer": "phonetic_analyzer"}
+        }
+      },
+      "aliases": {
+        "type": "text",
+        "fields": {
+          "keyword": {"type": "keyword"},
+          "pinyin":  {"type": "text", "analyzer": "pinyin_analyzer"}
+        }
+      },` + playerProperties + `
+    }
+  }
+}`
+
+// playerBasicMapping ES 没有装拼音/音近插件时的退化 mapping: 只按姓名/别名原文搜索,
+// 搜索语句里的 name.pinyin 等子字段不存在时会被忽略, 装好插件后删除索引重建即可
+const playerBasicMapping = `{
+  "mappings": {
+    "properties": {
+      "name":    {"type": "text", "fields": {"keyword": {"type": "keyword"}}},
+      "aliases": {"type": "text", "fields": {"keyword": {"type": "keyword"}}},` + playerProperties + `
+    }
+  }
+}`
+
+// playerProperties 两种 mapping 共用的字段
+const playerProperties = `
+      "id":            {"type": "integer"},
+      "team_id":       {"type": "integer"},
+      "jersey_number": {"type": "integer"},
+      "position":      {"type": "keyword"},
+      "height":        {"type": "float"},
+      "weight":        {"type": "float"},
+      "birthday":      {"type": "date", "format": "yyyy-MM-dd"},
+      "status":        {"type": "integer"},
+      "created_at":    {"type": "date"},
+      "updated_at":    {"type": "date"}`
+
+// playerFacets 分面统计的 terms 聚合, key 与 PlayerSearchResult.Facets 一致
+var playerFacets = map[string]map[string]interface{}{
+	"team_id":  {"field": "team_id", "size": 50},
+	"position": {"field": "position"},
+	"status":   {"field": "status"},
+}
+
+// PlayerDoc 索引中的球员文档
+type PlayerDoc struct {
+	ID           uint32   `json:"id"`
+	TeamID       uint32   `json:"team_id"`
+	Name         string   `json:"name"`
+	Aliases      []string `json:"aliases,omitempty"`
+	JerseyNumber uint8    `json:"jersey_number"`
+	Position     string   `json:"position"`
+	Height       float64  `json:"height,omitempty"`
+	Weight       float64  `json:"weight,omitempty"`
+	Birthday     string   `json:"birthday,omitempty"`
+	Status       int32    `json:"status"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+}
+
+// PlayerQuery 搜索条件, 过滤条件为零值时不生效
+type PlayerQuery struct {
+	Keyword  string
+	TeamID   uint32
+	Position string
+	Status   int32
+	From     int
+	Size     int
+}
+
+// FacetBucket 聚合结果
+type FacetBucket struct {
+	Key   string
+	Count int64
+}
+
+// PlayerSearchResult 搜索结果, Facets 的 key 为 team_id/position/status
+type PlayerSearchResult struct {
+	Total   int64
+	Players []*PlayerDoc
+	Facets  map[string][]FacetBucket
+}
+
+// PlayerIndex nba_players 索引
+type PlayerIndex struct {
+	client *elasticsearch.Client
+	index  string
+}
+
+func NewPlayerIndex(client *elasticsearch.Client, conf *config.ElasticsearchConfig) *PlayerIndex {
+	return &PlayerIndex{client: client, index: IndexName(conf, "players")}
+}
+
+// EnsureIndex 索引不存在时创建, 返回是否新建 (新建后需要全量导入)
+// 拼音/音近插件未安装导致创建失败时退回 playerBasicMapping, 模糊搜索不可用但精确搜索仍可用
+func (p *PlayerIndex) EnsureIndex() (bool, error) {
+	return ensureIndex(p.client, p.index, playerMapping, playerBasicMapping)
+}
+
+// IndexPlayer 写入/覆盖单个球员
+func (p *PlayerIndex) IndexPlayer(player *model.Player) error {
+	body, err := json.Marshal(newPlayerDoc(player))
+	if err != nil {
+		return err
+	}
+	res, err := p.client.Index(p.index, bytes.NewReader(body),
+		p.client.Index.WithDocumentID(strconv.FormatUint(uint64(player.ID), 10)))
+	return readResponse(res, err, nil)
+}
+
+// DeletePlayer 删除单个球员, 文档不存在不算错误
+func (p *PlayerIndex) DeletePlayer(id uint32) error {
+	res, err := p.client.Delete(p.index, strconv.FormatUint(uint64(id), 10))
+	if err == nil && res.StatusCode == 404 {
+		res.Body.Close()
+		return nil
+	}
+	return readResponse(res, err, nil)
+}
+
+// BulkIndex 批量写入 (全量导入用)
+func (p *PlayerIndex) BulkIndex(players []*model.Player) error {
+	if len(players) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, player := range players {
+		meta := fmt.Sprintf(`{"index":{"_id":"%d"}}`, player.ID)
+		doc, err := json.Marshal(newPlayerDoc(player))
+		if err != nil {
+			return err
+		}
+		buf.WriteString(meta)
+		buf.WriteByte('\n')
+		buf.Write(doc)
+		buf.WriteByte('\n')
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+	}
+	res, err := p.client.Bulk(&buf, p.client.Bulk.WithIndex(p.index))
+	if err := readResponse(res, err, &result); err != nil {
+		return err
+	}
+	if result.Errors {
+		return fmt.Errorf("批量写入部分失败")
+	}
+	return nil
+}
+
+// Search 按姓名/拼音/别名模糊搜索, 同时返回球队/位置/状态的分面统计
+// 过滤条件放在 post_filter 里只筛选返回的球员; 每个分面统计时应用其它分面的条件、不应用自己的,
+// 选中某个位置后仍能看到其它位置的人数, 方便切换
+func (p *PlayerIndex) Search(q PlayerQuery) (*PlayerSearchResult, error) {
+	filters := map[string]interface{}{}
+	if q.TeamID > 0 {
+		filters["team_id"] = q.TeamID
+	}
+	if q.Position != "" {
+		filters["position"] = q.Position
+	}
+	if q.Status > 0 {
+		filters["status"] = q.Status
+	}
+	// termsExcept 除 facet 以外的过滤条件, facet 为空时返回全部
+	termsExcept := func(facet string) []map[string]interface{} {
+		terms := []map[string]interface{}{}
+		for field, value := range filters {
+			if field != facet {
+				terms = append(terms, map[string]interface{}{"term": map[string]interface{}{field: value}})
+			}
+		}
+		return terms
+	}
+
+	must := []map[string]interface{}{{"match_all": map[string]interface{}{}}}
+	if kw := strings.TrimSpace(q.Keyword); kw != "" {
+		must = []map[string]interface{}{{
+			"multi_match": map[string]interface{}{
+				"query":     kw,
+				"fields":    []string{"name^3", "name.pinyin^2", "name.phonetic", "aliases^2", "aliases.pinyin"},
+				"fuzziness": "AUTO",
+			},
+		}}
+	}
+
+	aggs := make(map[string]interface{}, len(playerFacets))
+	for facet, terms := range playerFacets {
+		aggs[facet] = map[string]interface{}{
+			"filter": map[string]interface{}{"bool": map[string]interface{}{"filter": termsExcept(facet)}},
+			"aggs":   map[string]interface{}{"facet": map[string]interface{}{"terms": terms}},
+		}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"from":        q.From,
+		"size":        q.Size,
+		"query":       map[string]interface{}{"bool": map[string]interface{}{"must": must}},
+		"post_filter": map[string]interface{}{"bool": map[string]interface{}{"filter": termsExcept("")}},
+		"aggs":        aggs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sr searchResult
+	res, err := p.client.Search(
+		p.client.Search.WithIndex(p.index),
+		p.client.Search.WithBody(bytes.NewReader(body)),
+		p.client.Search.WithTrackTotalHits(true),
+	)
+	if err := readResponse(res, err, &sr); err != nil {
+		return nil, err
+	}
+
+	result := &PlayerSearchResult{Total: sr.Hits.Total.Value, Facets: facetsOf(&sr)}
+	for _, hit := range sr.Hits.Hits {
+		var doc PlayerDoc
+		if err := json.Unmarshal(hit.Source, &doc); err != nil {
+			return nil, err
+		}
+		result.Players = append(result.Players, &doc)
+	}
+	return result, nil
+}
+
+// facetsOf 把 terms 聚合转成分面统计
+func facetsOf(sr *searchResult) map[string][]FacetBucket {
+	facets := make(map[string][]FacetBucket, len(sr.Aggregations))
+	for name, agg := range sr.Aggregations {
+		if agg.Facet != nil {
+			agg = *agg.Facet
+		}
+		for _, b := range agg.Buckets {
+			facets[name] = append(facets[name], FacetBucket{Key: fmt.Sprint(b.Key), Count: b.DocCount})
+		}
+	}
+	return facets
+}
+
+func newPlayerDoc(p *model.Player) *PlayerDoc {
+	doc := &PlayerDoc{
+		ID:           p.ID,
+		TeamID:       p.TeamID,
+		Name:         p.Name,
+		Aliases:      p.AliasList(),
+		JerseyNumber: p.JerseyNumber,
+		Position:     p.Position.String(),
+		Height:       p.Height,
+		Weight:       p.Weight,
+		Status:       int32(p.Status),
+		CreatedAt:    p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    p.UpdatedAt.Format(time.RFC3339),
+	}
+	if p.Birthday != nil {
+		doc.Birthday = p.Birthday.Format("2006-01-02")
+	}
+	return doc
+}
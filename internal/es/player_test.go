@@ -0,0 +1,125 @@
+package es
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8"
+)
+
+// fakeES 用 handler 模拟 ES 接口, 返回指向它的客户端
+func fakeES(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, body []byte)) *elasticsearch.Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r, body)
+	}))
+	t.Cleanup(srv.Close)
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{srv.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestMappingsAreValidJSON(t *testing.T) {
+	for name, mapping := range map[string]string{"playerMapping": playerMapping, "playerBasicMapping": playerBasicMapping} {
+		if !json.Valid([]byte(mapping)) {
+			t.Errorf("%s 不是合法的 JSON", name)
+		}
+	}
+}
+
+func TestPlayerEnsureIndexFallback(t *testing.T) {
+	var created []string
+	client := fakeES(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		created = append(created, string(body))
+		if strings.Contains(string(body), `"pinyin"`) {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"error":{"type":"illegal_argument_exception","reason":"Unknown tokenizer type [pinyin]"}}`)
+			return
+		}
+		io.WriteString(w, `{"acknowledged":true}`)
+	})
+
+	ok, err := (&PlayerIndex{client: client, index: "nba_players"}).EnsureIndex()
+	if err != nil || !ok {
+		t.Fatalf("EnsureIndex() = %v, %v, want 退回基础 mapping 创建成功", ok, err)
+	}
+	if len(created) != 2 || created[1] != playerBasicMapping {
+		t.Errorf("应先按插件 mapping 创建, 失败后改用基础 mapping, 实际请求 %d 次", len(created))
+	}
+}
+
+func TestPlayerSearchFacets(t *testing.T) {
+	var req map[string]interface{}
+	client := fakeES(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Errorf("搜索请求不是合法的 JSON: %v", err)
+		}
+		io.WriteString(w, `{
+  "hits": {"total": {"value": 1}, "hits": [{"_id": "23", "_source": {"id": 23, "team_id": 14, "name": "勒布朗·詹姆斯", "position": "SF"}}]},
+  "aggregations": {
+    "position": {"doc_count": 3, "facet": {"buckets": [{"key": "SF", "doc_count": 1}, {"key": "PG", "doc_count": 2}]}},
+    "team_id":  {"doc_count": 5, "facet": {"buckets": [{"key": 14, "doc_count": 1}, {"key": 2, "doc_count": 4}]}},
+    "status":   {"doc_count": 1, "facet": {"buckets": [{"key": 1, "doc_count": 1}]}}
+  }
+}`)
+	})
+
+	res, err := (&PlayerIndex{client: client, index: "nba_players"}).Search(PlayerQuery{Keyword: "詹姆斯", TeamID: 14, Position: "SF", Size: 10})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	// 过滤条件只在 post_filter 里, 不影响聚合
+	if _, ok := req["query"].(map[string]interface{})["bool"].(map[string]interface{})["filter"]; ok {
+		t.Errorf("过滤条件不应放在 query 里: %v", req["query"])
+	}
+	if got := termFields(req["post_filter"]); !reflect.DeepEqual(got, []string{"position", "team_id"}) {
+		t.Errorf("post_filter 过滤字段 = %v, want [position team_id]", got)
+	}
+	// 每个分面只应用其它分面的条件
+	aggs := req["aggs"].(map[string]interface{})
+	for facet, want := range map[string][]string{"position": {"team_id"}, "team_id": {"position"}, "status": {"position", "team_id"}} {
+		if got := termFields(aggs[facet].(map[string]interface{})["filter"]); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s 分面的过滤字段 = %v, want %v", facet, got, want)
+		}
+	}
+
+	if res.Total != 1 || len(res.Players) != 1 || res.Players[0].ID != 23 {
+		t.Errorf("搜索结果 = %d %+v", res.Total, res.Players)
+	}
+	want := map[string][]FacetBucket{
+		"position": {{Key: "SF", Count: 1}, {Key: "PG", Count: 2}},
+		"team_id":  {{Key: "14", Count: 1}, {Key: "2", Count: 4}},
+		"status":   {{Key: "1", Count: 1}},
+	}
+	if !reflect.DeepEqual(res.Facets, want) {
+		t.Errorf("Facets = %v, want %v", res.Facets, want)
+	}
+}
+
+// termFields 取出 {"bool":{"filter":[{"term":{field:value}}...]}} 中的字段名, 按字母排序
+func termFields(clause interface{}) []string {
+	fields := []string{}
+	for _, f := range clause.(map[string]interface{})["bool"].(map[string]interface{})["filter"].([]interface{}) {
+		for field := range f.(map[string]interface{})["term"].(map[string]interface{}) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
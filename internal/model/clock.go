@@ -0,0 +1,117 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	myErrors "nba-remake/errors"
+)
+
+// 单节时长
+const (
+	PeriodLength   = 12 * time.Minute // 常规时间每节
+	OvertimeLength = 5 * time.Minute  // 每个加时
+)
+
+// PeriodDuration 某一节的时长, 5 及以上为加时
+func PeriodDuration(period int8) time.Duration {
+	if period > RegulationPeriods {
+		return OvertimeLength
+	}
+	return PeriodLength
+}
+
+// PeriodStartOffset 某一节开始时比赛已进行的时长
+func PeriodStartOffset(period int8) time.Duration {
+	if period <= RegulationPeriods {
+		return time.Duration(period-1) * PeriodLength
+	}
+	return RegulationPeriods*PeriodLength + time.Duration(period-RegulationPeriods-1)*OvertimeLength
+}
+
+// FormatClock 剩余时间格式化为 "mm:ss", 不足一秒按一秒显示 (只有真正走完才显示 00:00)
+func FormatClock(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	secs := int((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%02d:%02d", secs/60, secs%60)
+}
+
+// 比赛时钟
+// 持久化 "最近一次启动/暂停时的剩余时间" 和 "启动时刻", 当前剩余时间由两者推算,
+// 服务重启后无需恢复任何内存状态
+
+// ClockRemaining 当前节剩余时间, 最小为 0
+func (m *Match) ClockRemaining(now time.Time) time.Duration {
+	remaining := time.Duration(m.ClockRemainingMs) * time.Millisecond
+	if m.ClockRunning && m.ClockStartedAt != nil {
+		remaining -= now.Sub(*m.ClockStartedAt)
+	}
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// StartClock 启动时钟, 只能在比赛进行中且本节未结束时
+func (m *Match) StartClock(now time.Time) error {
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if m.PeriodEnded {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "本节已结束, 时钟不能启动", "")
+	}
+	if m.ClockRunning {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "时钟已在运行", "")
+	}
+	if m.ClockRemainingMs <= 0 {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "本节时间已走完", "")
+	}
+	m.ClockRunning = true
+	m.ClockStartedAt = &now
+	return nil
+}
+
+// StopClock 暂停时钟, 冻结当前剩余时间
+func (m *Match) StopClock(now time.Time) error {
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if !m.ClockRunning {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "时钟未在运行", "")
+	}
+	m.freezeClock(now)
+	return nil
+}
+
+// ResetClock 校正时钟 (暂停状态下), remaining 为 nil 时重置为整节时长
+func (m *Match) ResetClock(remaining *time.Duration) error {
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if m.ClockRunning {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "请先暂停时钟", "")
+	}
+	length := PeriodDuration(m.Period)
+	if remaining == nil {
+		remaining = &length
+	}
+	if *remaining < 0 || *remaining > length {
+		return myErrors.NewError(myErrors.CodeInvalidMatchTime, "剩余时间超出单节时长", "")
+	}
+	m.setClock(*remaining)
+	return nil
+}
+
+// freezeClock 停表并把当前剩余时间写回
+func (m *Match) freezeClock(now time.Time) {
+	m.setClock(m.ClockRemaining(now))
+}
+
+// setClock 停表并设置剩余时间
+func (m *Match) setClock(remaining time.Duration) {
+	m.ClockRemainingMs = remaining.Milliseconds()
+	m.ClockRunning = false
+	m.ClockStartedAt = nil
+}
@@ -0,0 +1,144 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	myErrors "nba-remake/errors"
+)
+
+func TestPeriodOffsets(t *testing.T) {
+	tests := []struct {
+		period     int8
+		wantLength time.Duration
+		wantStart  time.Duration
+	}{
+		{1, 12 * time.Minute, 0},
+		{4, 12 * time.Minute, 36 * time.Minute},
+		{5, 5 * time.Minute, 48 * time.Minute},
+		{7, 5 * time.Minute, 58 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := PeriodDuration(tt.period); got != tt.wantLength {
+			t.Errorf("PeriodDuration(%d) = %v, want %v", tt.period, got, tt.wantLength)
+		}
+		if got := PeriodStartOffset(tt.period); got != tt.wantStart {
+			t.Errorf("PeriodStartOffset(%d) = %v, want %v", tt.period, got, tt.wantStart)
+		}
+	}
+}
+
+func TestFormatClock(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{12 * time.Minute, "12:00"},
+		{83 * time.Second, "01:23"},
+		{100 * time.Millisecond, "00:01"}, // 不足一秒按一秒显示
+		{0, "00:00"},
+		{-time.Second, "00:00"},
+	}
+	for _, tt := range tests {
+		if got := FormatClock(tt.d); got != tt.want {
+			t.Errorf("FormatClock(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    int
+		wantErr bool
+	}{
+		{"12:00", 720, false},
+		{"0:05", 5, false},
+		{"01:23", 83, false},
+		{"1023", 0, true},
+		{"10:60", 0, true},
+		{"-1:00", 0, true},
+		{"1:-5", 0, true},
+		{"a:00", 0, true},
+		{"1:00:00", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseClock(tt.s)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseClock(%q) = %d, %v, want %d, wantErr %v", tt.s, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+// 时钟只持久化剩余时间和启动时刻, 当前剩余时间由两者推算
+func TestMatchClock(t *testing.T) {
+	start := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	m := Match{Status: MatchStatusInProgress, Period: 1}
+	if err := m.ResetClock(nil); err != nil {
+		t.Fatalf("ResetClock: %v", err)
+	}
+	if got := m.ClockRemaining(start); got != 12*time.Minute {
+		t.Fatalf("重置后剩余 %v, want 12m", got)
+	}
+
+	if err := m.StartClock(start); err != nil {
+		t.Fatalf("StartClock: %v", err)
+	}
+	if code := errCode(t, m.StartClock(start)); code != myErrors.CodeInvalidMatchData {
+		t.Errorf("重复启动错误码 = %d", code)
+	}
+	if code := errCode(t, m.ResetClock(nil)); code != myErrors.CodeInvalidMatchData {
+		t.Errorf("运行中校正错误码 = %d", code)
+	}
+	if got := m.ClockRemaining(start.Add(90 * time.Second)); got != 630*time.Second {
+		t.Errorf("走表 90 秒后剩余 %v, want 10m30s", got)
+	}
+	if got := m.ClockRemaining(start.Add(time.Hour)); got != 0 {
+		t.Errorf("剩余时间最小为 0, 实际 %v", got)
+	}
+
+	if err := m.StopClock(start.Add(90 * time.Second)); err != nil {
+		t.Fatalf("StopClock: %v", err)
+	}
+	if m.ClockRunning || m.ClockStartedAt != nil || m.ClockRemainingMs != 630000 {
+		t.Errorf("暂停后 = running %v startedAt %v remaining %dms", m.ClockRunning, m.ClockStartedAt, m.ClockRemainingMs)
+	}
+	if got := m.ClockRemaining(start.Add(time.Hour)); got != 630*time.Second {
+		t.Errorf("暂停后时钟不应再走, 实际 %v", got)
+	}
+	if code := errCode(t, m.StopClock(start)); code != myErrors.CodeInvalidMatchData {
+		t.Errorf("重复暂停错误码 = %d", code)
+	}
+
+	over := 13 * time.Minute
+	if code := errCode(t, m.ResetClock(&over)); code != myErrors.CodeInvalidMatchTime {
+		t.Errorf("超出单节时长错误码 = %d", code)
+	}
+	zero := time.Duration(0)
+	if err := m.ResetClock(&zero); err != nil {
+		t.Fatalf("ResetClock(0): %v", err)
+	}
+	if code := errCode(t, m.StartClock(start)); code != myErrors.CodeInvalidMatchData {
+		t.Errorf("时间走完后启动错误码 = %d", code)
+	}
+}
+
+func TestStartClockRequiresLivePeriod(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    Match
+		wantCode myErrors.ErrorCode
+	}{
+		{"未开始", Match{ClockRemainingMs: 720000}, myErrors.CodeMatchNotStarted},
+		{"本节已结束", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true}, myErrors.CodeInvalidMatchData},
+		{"已结束", Match{Status: MatchStatusFinished, Period: 4, PeriodEnded: true}, myErrors.CodeMatchFinished},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := errCode(t, tt.match.StartClock(time.Now())); code != tt.wantCode {
+				t.Errorf("StartClock() 错误码 = %d, want %d", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	nba_v "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+)
+
+// Contract 球员合同, TeamID 为承担工资的球队 (交易后随球员转移)
+// 被裁后 WaivedDate 不为空, 之后各赛季只有保障部分计入原球队工资
+type Contract struct {
+	ID         uint64           `gorm:"primaryKey;autoIncrement"`
+	PlayerID   uint32           `gorm:"column:player_id;not null;index"`
+	TeamID     uint32           `gorm:"column:team_id;not null;index"`
+	SignedDate time.Time        `gorm:"column:signed_date;type:date;not null"`
+	WaivedDate *time.Time       `gorm:"column:waived_date;type:date"`
+	Seasons    []ContractSeason `gorm:"foreignKey:ContractID"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ContractSeason 合同中一个赛季的工资 (美元)
+type ContractSeason struct {
+	ID         uint64               `gorm:"primaryKey;autoIncrement"`
+	ContractID uint64               `gorm:"column:contract_id;not null;uniqueIndex:uk_contract_season"`
+	Season     string               `gorm:"column:season;type:varchar(10);not null;uniqueIndex:uk_contract_season;index"`
+	Salary     int64                `gorm:"column:salary;not null"`
+	Guaranteed int64                `gorm:"column:guaranteed;not null"` // 保障金额, 等于 Salary 为全额保障
+	Option     nba_v.ContractOption `gorm:"column:contract_option;type:tinyint;not null;default:0"`
+}
+
+// CapThresholds 某赛季的工资帽/奢侈税线/土豪线
+type CapThresholds struct {
+	SalaryCap   int64
+	LuxuryTax   int64
+	FirstApron  int64
+	SecondApron int64
+}
+
+// Level 工资总额所处区间
+func (t CapThresholds) Level(total int64) nba_v.PayrollLevel {
+	switch {
+	case total > t.SecondApron:
+		return nba_v.PayrollLevel_OVER_SECOND_APRON
+	case total > t.FirstApron:
+		return nba_v.PayrollLevel_OVER_FIRST_APRON
+	case total > t.LuxuryTax:
+		return nba_v.PayrollLevel_OVER_TAX
+	case total > t.SalaryCap:
+		return nba_v.PayrollLevel_OVER_CAP
+	default:
+		return nba_v.PayrollLevel_UNDER_CAP
+	}
+}
+
+// Validate 赛季格式正确且不重复, 金额非负, 保障金额不超过工资
+func (c *Contract) Validate() error {
+	if len(c.Seasons) == 0 {
+		return myErrors.NewError(myErrors.CodeInvalidPlayerData, "合同至少包含一个赛季", "")
+	}
+	seen := make(map[string]bool, len(c.Seasons))
+	for _, s := range c.Seasons {
+		if _, err := ParseSeason(s.Season); err != nil {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, err.Error(), "")
+		}
+		if seen[s.Season] {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "合同赛季重复: "+s.Season, "")
+		}
+		seen[s.Season] = true
+		if s.Salary < 0 || s.Guaranteed < 0 || s.Guaranteed > s.Salary {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, fmt.Sprintf("%s 赛季金额无效: 工资 %d, 保障 %d", s.Season, s.Salary, s.Guaranteed), "")
+		}
+	}
+	sort.Slice(c.Seasons, func(i, j int) bool { return c.Seasons[i].Season < c.Seasons[j].Season })
+	return nil
+}
+
+// Season 某赛季的条款, 不在合同期内时为 nil
+func (c *Contract) Season(season string) *ContractSeason {
+	for i := range c.Seasons {
+		if c.Seasons[i].Season == season {
+			return &c.Seasons[i]
+		}
+	}
+	return nil
+}
+
+// CapHit 某赛季计入工资帽的金额: 被裁后 (被裁当季及之后) 只计保障部分
+func (c *Contract) CapHit(season string) int64 {
+	s := c.Season(season)
+	if s == nil {
+		return 0
+	}
+	if c.Waived(season) {
+		return s.Guaranteed
+	}
+	return s.Salary
+}
+
+// Waived 在 season 时是否已被裁
+func (c *Contract) Waived(season string) bool {
+	return c.WaivedDate != nil && SeasonOf(*c.WaivedDate) <= season
+}
+
+// TotalValue 合同总额
+func (c *Contract) TotalValue() int64 {
+	var total int64
+	for _, s := range c.Seasons {
+		total += s.Salary
+	}
+	return total
+}
+
+// Overlaps 与另一份合同是否有相同赛季
+func (c *Contract) Overlaps(other *Contract) bool {
+	for _, s := range c.Seasons {
+		if other.Season(s.Season) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// BirdRightsOf 按异动记录 (生效日期升序) 求 teamID 在 asOf 时对球员的鸟权
+// 交易不中断效力年限, 在原球队续约不中断, 被裁 (WAIVER) 后重新计算; 效力年限按跨越的赛季数
+func BirdRightsOf(history []*PlayerTransaction, teamID uint32, asOf time.Time) nba_v.BirdRights {
+	var team uint32
+	var start time.Time
+	waived := false
+	for _, t := range history {
+		if t.EffectiveDate.After(asOf) {
+			break
+		}
+		switch t.Type {
+		case nba_v.TransactionType_TRADE:
+			if start.IsZero() {
+				start = t.EffectiveDate
+			}
+			team, waived = t.ToTeamID, false
+		case nba_v.TransactionType_SIGNING:
+			if team != t.ToTeamID || waived {
+				start = t.EffectiveDate
+			}
+			team, waived = t.ToTeamID, false
+		case nba_v.TransactionType_WAIVER:
+			waived = true
+		}
+	}
+	if team == 0 || team != teamID || waived {
+		return nba_v.BirdRights_BIRD_NONE
+	}
+	from, _ := ParseSeason(SeasonOf(start))
+	to, _ := ParseSeason(SeasonOf(asOf))
+	switch seasons := to - from + 1; {
+	case seasons >= 3:
+		return nba_v.BirdRights_FULL_BIRD
+	case seasons == 2:
+		return nba_v.BirdRights_EARLY_BIRD
+	default:
+		return nba_v.BirdRights_NON_BIRD
+	}
+}
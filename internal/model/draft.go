@@ -0,0 +1,94 @@
+package model
+
+import (
+	"time"
+
+	nba_v "nba-remake/api/proto/v1"
+)
+
+// MaxDraftRounds 选秀轮数上限
+const MaxDraftRounds = 10
+
+// Draft 选秀年份, 顺位按 Season 赛季的常规赛战绩排定; LotteryDate 为空表示尚未抽签
+type Draft struct {
+	Year         int        `gorm:"primaryKey;autoIncrement:false"`
+	Season       string     `gorm:"column:season;type:varchar(10);not null"`
+	Rounds       int8       `gorm:"column:rounds;type:tinyint;not null;default:2"`
+	LotteryTeams int8       `gorm:"column:lottery_teams;type:tinyint;not null;default:14"`
+	Seed         int64      `gorm:"column:seed;not null;default:0"` // 抽签种子, 可复现抽签结果
+	LotteryDate  *time.Time `gorm:"column:lottery_date"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Drawn 是否已抽签 (顺位已确定)
+func (d *Draft) Drawn() bool {
+	return d.LotteryDate != nil
+}
+
+// DraftPick 选秀权, OwnerTeamID 为当前持有球队 (交易后变化), OriginalTeamID 为原属球队
+type DraftPick struct {
+	ID             uint32 `gorm:"primaryKey;autoIncrement"`
+	Year           int    `gorm:"column:year;type:smallint;not null;uniqueIndex:uk_pick"`
+	Round          int8   `gorm:"column:round;type:tinyint;not null;uniqueIndex:uk_pick"`
+	OriginalTeamID uint32 `gorm:"column:original_team_id;not null;uniqueIndex:uk_pick"`
+	OwnerTeamID    uint32 `gorm:"column:owner_team_id;not null;index"`
+	Number         int    `gorm:"column:number;type:smallint;not null;default:0"`      // 总顺位, 抽签前为 0
+	LotteryRank    int8   `gorm:"column:lottery_rank;type:tinyint;not null;default:0"` // 抽签前排名, 非乐透为 0
+	Protection     int    `gorm:"column:protection;type:smallint;not null;default:0"`  // 前 N 顺位保护
+	Protected      bool   `gorm:"column:protected;not null;default:false"`             // 保护生效, 已回到附加保护的球队
+	ProtectedBy    uint32 `gorm:"column:protected_by;not null;default:0"`              // 附加保护的球队 (该笔交易的送出方), 0 表示原属球队
+	PlayerID       uint32 `gorm:"column:player_id;not null;default:0"`                 // 选中的球员
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Used 是否已选人
+func (p *DraftPick) Used() bool {
+	return p.PlayerID != 0
+}
+
+// Resolve 顺位确定后写入顺位, 落在保护范围内的选秀权回到附加保护的球队
+func (p *DraftPick) Resolve(number int, lotteryRank int) {
+	p.Number, p.LotteryRank = number, int8(lotteryRank)
+	if p.Protection > 0 && number <= p.Protection && p.OwnerTeamID != p.ProtectionHolder() {
+		p.OwnerTeamID, p.Protected = p.ProtectionHolder(), true
+	}
+}
+
+// ProtectionHolder 保护生效时选秀权回到的球队
+func (p *DraftPick) ProtectionHolder() uint32 {
+	if p.ProtectedBy == 0 {
+		return p.OriginalTeamID
+	}
+	return p.ProtectedBy
+}
+
+// Prospect 参加选秀的新秀, 被选中后生成球员 (PlayerID)
+type Prospect struct {
+	ID        uint32         `gorm:"primaryKey;autoIncrement"`
+	Year      int            `gorm:"column:year;type:smallint;not null;index"`
+	Name      string         `gorm:"column:name;type:varchar(100);not null"`
+	Position  nba_v.Position `gorm:"column:position;type:varchar(10);not null"`
+	Height    float64        `gorm:"column:height;type:decimal(3,2)"`
+	Weight    float64        `gorm:"column:weight;type:decimal(5,2)"`
+	Birthday  *time.Time     `gorm:"column:birthday;type:date"`
+	School    string         `gorm:"column:school;type:varchar(100)"`
+	BoardRank int            `gorm:"column:board_rank;not null;default:0"` // 选秀大板排名, 0 表示未排名
+	PickID    uint32         `gorm:"column:pick_id;not null;default:0"`
+	PlayerID  uint32         `gorm:"column:player_id;not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Rookie 被选中的新秀生成的球员 (尚未加入阵容)
+func (p *Prospect) Rookie() *Player {
+	return &Player{
+		Name:     p.Name,
+		Position: p.Position,
+		Height:   p.Height,
+		Weight:   p.Weight,
+		Birthday: p.Birthday,
+		Status:   nba_v.PlayerStatus_ACTIVE,
+	}
+}
@@ -0,0 +1,33 @@
+package model
+
+import "testing"
+
+func TestDraftPickResolve(t *testing.T) {
+	tests := []struct {
+		name          string
+		pick          DraftPick
+		number        int
+		wantOwner     uint32
+		wantProtected bool
+	}{
+		{"没有保护", DraftPick{OriginalTeamID: 1, OwnerTeamID: 2}, 3, 2, false},
+		{"落在保护范围内回到原属球队", DraftPick{OriginalTeamID: 1, OwnerTeamID: 2, Protection: 10}, 10, 1, true},
+		{"保护范围外归持有球队", DraftPick{OriginalTeamID: 1, OwnerTeamID: 2, Protection: 10}, 11, 2, false},
+		{"原属球队自己持有时保护不生效", DraftPick{OriginalTeamID: 1, OwnerTeamID: 1, Protection: 10}, 1, 1, false},
+		// 球队 2 得到球队 1 的签后附加保护送给球队 3, 保护生效时回到球队 2 而不是原属球队
+		{"回到附加保护的球队", DraftPick{OriginalTeamID: 1, OwnerTeamID: 3, Protection: 10, ProtectedBy: 2}, 5, 2, true},
+		{"附加保护的球队换回后保护不生效", DraftPick{OriginalTeamID: 1, OwnerTeamID: 2, Protection: 10, ProtectedBy: 2}, 5, 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pick := tt.pick
+			pick.Resolve(tt.number, 4)
+			if pick.Number != tt.number || pick.LotteryRank != 4 {
+				t.Errorf("顺位 = %d (抽签前第 %d), want %d (第 4)", pick.Number, pick.LotteryRank, tt.number)
+			}
+			if pick.OwnerTeamID != tt.wantOwner || pick.Protected != tt.wantProtected {
+				t.Errorf("持有球队 = %d protected = %v, want %d %v", pick.OwnerTeamID, pick.Protected, tt.wantOwner, tt.wantProtected)
+			}
+		})
+	}
+}
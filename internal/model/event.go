@@ -0,0 +1,146 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	nba_v "nba-remake/api/proto/v1"
+)
+
+// 事件消息的动作 (EventDTO.Action)
+const (
+	EventActionRecord = ""      // 普通事件
+	EventActionVoid   = "void"  // 作废原事件
+	EventActionAmend  = "amend" // 更正原事件 (作废 + 写入新事件)
+)
+
+// IsScoringEvent 是否为得分事件 (会改变比分)
+func IsScoringEvent(t nba_v.EventType) bool {
+	return t == nba_v.EventType_SHOT_MADE || t == nba_v.EventType_FREE_THROW_MADE
+}
+
+// IsFieldGoal 是否为投篮 (非罚球) 出手方式
+func IsFieldGoal(shot nba_v.ShotType) bool {
+	return shot != nba_v.ShotType_SHOT_TYPE_UNKNOWN && shot != nba_v.ShotType_FREE_THROW
+}
+
+// ShotPoints 出手方式对应的分值
+func ShotPoints(shot nba_v.ShotType) int {
+	switch shot {
+	case nba_v.ShotType_THREE_POINTER:
+		return 3
+	case nba_v.ShotType_FREE_THROW:
+		return 1
+	case nba_v.ShotType_SHOT_TYPE_UNKNOWN:
+		return 0
+	default:
+		return 2
+	}
+}
+
+// IsReversible 事件是否支持作废/更正
+// 换人和单节起止会影响上场时间的结算, 不支持冲正
+func IsReversible(t nba_v.EventType) bool {
+	switch t {
+	case nba_v.EventType_SUBSTITUTION_IN, nba_v.EventType_SUBSTITUTION_OUT,
+		nba_v.EventType_PERIOD_START, nba_v.EventType_PERIOD_END:
+		return false
+	}
+	return true
+}
+
+// RequiresPlayer 事件是否必须指定球员
+func RequiresPlayer(t nba_v.EventType) bool {
+	switch t {
+	case nba_v.EventType_TIMEOUT, nba_v.EventType_PERIOD_START, nba_v.EventType_PERIOD_END:
+		return false
+	}
+	return true
+}
+
+// RequiresTeam 事件是否必须指定球队
+func RequiresTeam(t nba_v.EventType) bool {
+	return t != nba_v.EventType_PERIOD_START && t != nba_v.EventType_PERIOD_END
+}
+
+// LineupSize 每队场上人数
+const LineupSize = 5
+
+// ValidateLineups 校验单节首发: 单节开始事件必须带双方各 5 名不重复的球员, 其它事件不能带首发
+// 上场时间从单节开始时的首发算起, 节中上场的球员仍靠换人事件
+func ValidateLineups(t nba_v.EventType, home, visitor []uint32) error {
+	if t != nba_v.EventType_PERIOD_START {
+		if len(home) > 0 || len(visitor) > 0 {
+			return fmt.Errorf("%s 事件不能指定首发", t)
+		}
+		return nil
+	}
+	if len(home) != LineupSize || len(visitor) != LineupSize {
+		return fmt.Errorf("单节开始必须指定双方各 %d 名首发, 当前: 主队 %d 名, 客队 %d 名", LineupSize, len(home), len(visitor))
+	}
+	seen := make(map[uint32]bool, 2*LineupSize)
+	for _, id := range append(append([]uint32(nil), home...), visitor...) {
+		if id == 0 {
+			return fmt.Errorf("首发球员ID无效")
+		}
+		if seen[id] {
+			return fmt.Errorf("首发球员 %d 重复", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// ValidateEvent 校验事件类型、出手方式与分值是否一致
+// 例如: 三分命中 value 必须为 3, 篮板不能带出手方式
+func ValidateEvent(t nba_v.EventType, shot nba_v.ShotType, value int) error {
+	if _, ok := nba_v.EventType_name[int32(t)]; !ok || t == nba_v.EventType_EVENT_TYPE_UNKNOWN {
+		return fmt.Errorf("未知的事件类型: %d", t)
+	}
+	if _, ok := nba_v.ShotType_name[int32(shot)]; !ok {
+		return fmt.Errorf("未知的出手方式: %d", shot)
+	}
+
+	switch t {
+	case nba_v.EventType_SHOT_MADE, nba_v.EventType_SHOT_MISSED:
+		if !IsFieldGoal(shot) {
+			return fmt.Errorf("投篮事件必须指定投篮出手方式, 当前: %s", shot)
+		}
+	case nba_v.EventType_FREE_THROW_MADE, nba_v.EventType_FREE_THROW_MISSED:
+		if shot != nba_v.ShotType_FREE_THROW && shot != nba_v.ShotType_SHOT_TYPE_UNKNOWN {
+			return fmt.Errorf("罚球事件的出手方式只能是 FREE_THROW, 当前: %s", shot)
+		}
+		shot = nba_v.ShotType_FREE_THROW
+	default:
+		if shot != nba_v.ShotType_SHOT_TYPE_UNKNOWN {
+			return fmt.Errorf("%s 事件不能指定出手方式", t)
+		}
+	}
+
+	expected := 0
+	if IsScoringEvent(t) {
+		expected = ShotPoints(shot)
+	}
+	if value != expected {
+		return fmt.Errorf("%s/%s 的分值应为 %d, 当前: %d", t, shot, expected, value)
+	}
+	return nil
+}
+
+// ParseClock 解析 "mm:ss" 格式的比赛时钟, 返回秒数
+func ParseClock(s string) (int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("无效的比赛时间: %q", s)
+	}
+	m, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("无效的比赛时间: %q", s)
+	}
+	sec, err := strconv.Atoi(parts[1])
+	if err != nil || sec < 0 || sec >= 60 || m < 0 {
+		return 0, fmt.Errorf("无效的比赛时间: %q", s)
+	}
+	return m*60 + sec, nil
+}
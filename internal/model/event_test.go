@@ -0,0 +1,82 @@
+package model
+
+import (
+	"testing"
+
+	nba_v "nba-remake/api/proto/v1"
+)
+
+func TestValidateEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     nba_v.EventType
+		shot    nba_v.ShotType
+		value   int
+		wantErr bool
+	}{
+		{"三分命中", nba_v.EventType_SHOT_MADE, nba_v.ShotType_THREE_POINTER, 3, false},
+		{"上篮命中", nba_v.EventType_SHOT_MADE, nba_v.ShotType_LAYUP, 2, false},
+		{"三分命中分值不符", nba_v.EventType_SHOT_MADE, nba_v.ShotType_THREE_POINTER, 2, true},
+		{"投篮命中缺出手方式", nba_v.EventType_SHOT_MADE, nba_v.ShotType_SHOT_TYPE_UNKNOWN, 2, true},
+		{"投篮不能是罚球", nba_v.EventType_SHOT_MADE, nba_v.ShotType_FREE_THROW, 1, true},
+		{"投篮不中不计分", nba_v.EventType_SHOT_MISSED, nba_v.ShotType_DUNK, 0, false},
+		{"投篮不中带分值", nba_v.EventType_SHOT_MISSED, nba_v.ShotType_DUNK, 2, true},
+		{"罚球命中", nba_v.EventType_FREE_THROW_MADE, nba_v.ShotType_FREE_THROW, 1, false},
+		{"罚球命中可省略出手方式", nba_v.EventType_FREE_THROW_MADE, nba_v.ShotType_SHOT_TYPE_UNKNOWN, 1, false},
+		{"罚球不能是三分", nba_v.EventType_FREE_THROW_MADE, nba_v.ShotType_THREE_POINTER, 1, true},
+		{"篮板", nba_v.EventType_REBOUND_DEFENSIVE, nba_v.ShotType_SHOT_TYPE_UNKNOWN, 0, false},
+		{"篮板不能带出手方式", nba_v.EventType_REBOUND_DEFENSIVE, nba_v.ShotType_JUMP_SHOT, 0, true},
+		{"未知事件类型", nba_v.EventType_EVENT_TYPE_UNKNOWN, nba_v.ShotType_SHOT_TYPE_UNKNOWN, 0, true},
+		{"越界事件类型", nba_v.EventType(99), nba_v.ShotType_SHOT_TYPE_UNKNOWN, 0, true},
+		{"越界出手方式", nba_v.EventType_SHOT_MISSED, nba_v.ShotType(99), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEvent(tt.typ, tt.shot, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateEvent(%s, %s, %d) = %v, wantErr %v", tt.typ, tt.shot, tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateLineups(t *testing.T) {
+	five := func(first uint32) []uint32 { return []uint32{first, first + 1, first + 2, first + 3, first + 4} }
+	tests := []struct {
+		name    string
+		typ     nba_v.EventType
+		home    []uint32
+		visitor []uint32
+		wantErr bool
+	}{
+		{"单节开始带双方首发", nba_v.EventType_PERIOD_START, five(1), five(11), false},
+		{"单节开始缺首发", nba_v.EventType_PERIOD_START, nil, nil, true},
+		{"首发不足 5 人", nba_v.EventType_PERIOD_START, five(1)[:4], five(11), true},
+		{"首发重复", nba_v.EventType_PERIOD_START, five(1), []uint32{5, 12, 13, 14, 15}, true},
+		{"首发球员ID为 0", nba_v.EventType_PERIOD_START, []uint32{0, 2, 3, 4, 5}, five(11), true},
+		{"其它事件不带首发", nba_v.EventType_ASSIST, nil, nil, false},
+		{"其它事件不能带首发", nba_v.EventType_SUBSTITUTION_IN, five(1), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLineups(tt.typ, tt.home, tt.visitor)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLineups() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestShotPoints(t *testing.T) {
+	for shot, want := range map[nba_v.ShotType]int{
+		nba_v.ShotType_SHOT_TYPE_UNKNOWN: 0,
+		nba_v.ShotType_FREE_THROW:        1,
+		nba_v.ShotType_JUMP_SHOT:         2,
+		nba_v.ShotType_TIP_IN:            2,
+		nba_v.ShotType_THREE_POINTER:     3,
+	} {
+		if got := ShotPoints(shot); got != want {
+			t.Errorf("ShotPoints(%s) = %d, want %d", shot, got, want)
+		}
+	}
+}
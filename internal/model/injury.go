@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	nba_v "nba-remake/api/proto/v1"
+)
+
+// Injury 伤病记录, ReturnedDate 为空表示尚未复出
+type Injury struct {
+	ID             uint64                  `gorm:"primaryKey;autoIncrement"`
+	PlayerID       uint32                  `gorm:"column:player_id;not null;index"`
+	TeamID         uint32                  `gorm:"column:team_id;not null;index"` // 受伤时所在球队, 缺席场次按该队比赛计算
+	BodyPart       string                  `gorm:"column:body_part;type:varchar(50);not null"`
+	Description    string                  `gorm:"column:description;type:varchar(255)"`
+	InjuryDate     time.Time               `gorm:"column:injury_date;type:date;not null;index"`
+	ExpectedReturn *time.Time              `gorm:"column:expected_return;type:date"`
+	ReturnedDate   *time.Time              `gorm:"column:returned_date;type:date;index"`
+	Designation    nba_v.InjuryDesignation `gorm:"column:designation;type:tinyint;not null;default:1"`
+	Player         Player                  `gorm:"foreignKey:PlayerID"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ActiveOn date 当天是否仍在伤病中 (受伤当天算, 复出当天不算)
+func (i *Injury) ActiveOn(date time.Time) bool {
+	day := date.Format("2006-01-02")
+	return i.InjuryDate.Format("2006-01-02") <= day && (i.ReturnedDate == nil || i.ReturnedDate.Format("2006-01-02") > day)
+}
+
+// Sidelines 该出战状态是否视为伤停 (缺阵/出战成疑); 存疑和大概率出战的球员保持现役
+func Sidelines(d nba_v.InjuryDesignation) bool {
+	return d == nba_v.InjuryDesignation_OUT || d == nba_v.InjuryDesignation_DOUBTFUL
+}
+
+// InjuryStatus 按尚未复出的伤病求球员状态: 有伤停的伤病时为 INJURED, 没有时由 INJURED 恢复为 ACTIVE
+// 退役/下放的球员状态不变
+func InjuryStatus(current nba_v.PlayerStatus, active []*Injury) nba_v.PlayerStatus {
+	if current == nba_v.PlayerStatus_RETIRED || current == nba_v.PlayerStatus_ASSIGNED {
+		return current
+	}
+	for _, i := range active {
+		if i.ReturnedDate == nil && Sidelines(i.Designation) {
+			return nba_v.PlayerStatus_INJURED
+		}
+	}
+	return nba_v.PlayerStatus_ACTIVE
+}
@@ -0,0 +1,55 @@
+package model
+
+// Conference 联盟 (东部/西部)
+type Conference struct {
+	ID       uint32 `gorm:"primaryKey"`
+	Name     string `gorm:"type:varchar(20);not null;uniqueIndex"` // East, West; 与 Team.Conference 取值一致
+	FullName string `gorm:"column:full_name;type:varchar(50)"`     // Eastern Conference
+}
+
+// Division 赛区, 隶属于一个联盟
+type Division struct {
+	ID           uint32     `gorm:"primaryKey"`
+	Name         string     `gorm:"type:varchar(20);not null;uniqueIndex"` // Atlantic, Pacific
+	ConferenceID uint32     `gorm:"column:conference_id;not null;index"`
+	Conference   Conference `gorm:"foreignKey:ConferenceID"`
+}
+
+// TeamDivision 球队所属赛区, 按赛季记录 (联盟重新分区或球队搬迁时新增一行)
+// 某赛季的归属取 season 不晚于该赛季的最近一条; 没有记录时只有 Team.Conference
+type TeamDivision struct {
+	ID         uint64   `gorm:"primaryKey;autoIncrement"`
+	TeamID     uint32   `gorm:"column:team_id;not null;uniqueIndex:uk_team_season"`
+	Season     string   `gorm:"column:season;type:varchar(10);not null;uniqueIndex:uk_team_season"` // 生效赛季
+	DivisionID uint32   `gorm:"column:division_id;not null;index"`
+	Division   Division `gorm:"foreignKey:DivisionID"`
+}
+
+// DefaultAlignmentSeason 现行 6 赛区划分的起始赛季
+const DefaultAlignmentSeason = "2004-05"
+
+// AlignmentConference / AlignmentDivision 赛区划分的初始数据
+type AlignmentConference struct {
+	Name      string
+	FullName  string
+	Divisions []AlignmentDivision
+}
+
+type AlignmentDivision struct {
+	Name  string
+	Teams []string // 球队缩写
+}
+
+// DefaultAlignment 现行赛区划分, 首次建表时写入 (球队按缩写匹配, 匹配不到的跳过)
+var DefaultAlignment = []AlignmentConference{
+	{"East", "Eastern Conference", []AlignmentDivision{
+		{"Atlantic", []string{"BOS", "BKN", "NYK", "PHI", "TOR"}},
+		{"Central", []string{"CHI", "CLE", "DET", "IND", "MIL"}},
+		{"Southeast", []string{"ATL", "CHA", "MIA", "ORL", "WAS"}},
+	}},
+	{"West", "Western Conference", []AlignmentDivision{
+		{"Northwest", []string{"DEN", "MIN", "OKC", "POR", "UTA"}},
+		{"Pacific", []string{"GSW", "LAC", "LAL", "PHX", "SAC"}},
+		{"Southwest", []string{"DAL", "HOU", "MEM", "NOP", "SAS"}},
+	}},
+}
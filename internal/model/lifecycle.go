@@ -0,0 +1,183 @@
+package model
+
+import (
+	"fmt"
+
+	myErrors "nba-remake/errors"
+)
+
+// 比赛状态机
+//
+//	未开始 --StartMatch--> 进行中 (第1节)
+//	未开始 --PostponeMatch--> 延期 --UpdateMatch (改期)--> 未开始
+//	未开始/延期 --CancelMatch--> 取消
+//	进行中 --EndPeriod--> 第1~3节: 进入下一节; 第4节/加时: 本节结束
+//	本节结束 (平局) --StartOvertime--> 进行中 (加时)
+//	本节结束 (非平局) --FinalizeMatch--> 已结束
+//
+// 各方法只校验并修改内存中的状态, 由调用方在行锁内执行并落库
+
+// Start 开赛, 进入第1节
+func (m *Match) Start() error {
+	switch m.Status {
+	case MatchStatusInProgress:
+		return myErrors.NewError(myErrors.CodeMatchInProgress, "比赛已在进行中", "")
+	case MatchStatusFinished:
+		return myErrors.NewError(myErrors.CodeMatchFinished, "比赛已结束", "")
+	case MatchStatusPostponed:
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "比赛已延期, 需要重新排期后才能开赛", "")
+	case MatchStatusCancelled:
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "比赛已取消", "")
+	}
+	m.Status = MatchStatusInProgress
+	m.Period = 1
+	m.PeriodEnded = false
+	m.setClock(PeriodDuration(m.Period))
+	return nil
+}
+
+// EndPeriod 结束当前节并停表; period 不为 0 时必须与当前节次一致 (防止重复提交)
+func (m *Match) EndPeriod(period int8) error {
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if period != 0 && period != m.Period {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "节次不一致", "")
+	}
+	if m.PeriodEnded {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "本节已结束, 请开始加时或结束比赛", "")
+	}
+	if m.Period < RegulationPeriods {
+		m.Period++
+		m.setClock(PeriodDuration(m.Period))
+		return nil
+	}
+	m.PeriodEnded = true
+	m.setClock(0)
+	return nil
+}
+
+// StartOvertime 第4节/加时结束且比分相同时进入加时
+func (m *Match) StartOvertime() error {
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if !m.PeriodEnded || m.Period < RegulationPeriods {
+		return myErrors.NewError(myErrors.CodeMatchInProgress, "常规时间或当前加时尚未结束", "")
+	}
+	if m.HomeScore != m.VisitorScore {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "比分不是平局, 不能进入加时", "")
+	}
+	m.Period++
+	m.PeriodEnded = false
+	m.setClock(PeriodDuration(m.Period))
+	return nil
+}
+
+// Finalize 终场: 第4节/加时已结束且分出胜负
+func (m *Match) Finalize() error {
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if !m.PeriodEnded || m.Period < RegulationPeriods {
+		return myErrors.NewError(myErrors.CodeMatchInProgress, "比赛尚未打完", "")
+	}
+	if m.HomeScore == m.VisitorScore {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "比分为平局, 需要进入加时", "")
+	}
+	m.Status = MatchStatusFinished
+	return nil
+}
+
+// Postpone 延期, 只能在开赛前; 重复延期只更新原因
+func (m *Match) Postpone(reason string) error {
+	switch m.Status {
+	case MatchStatusInProgress:
+		return myErrors.NewError(myErrors.CodeMatchInProgress, "比赛进行中, 不能延期", "")
+	case MatchStatusFinished:
+		return myErrors.NewError(myErrors.CodeMatchFinished, "比赛已结束, 不能延期", "")
+	case MatchStatusCancelled:
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "比赛已取消, 不能延期", "")
+	}
+	m.Status = MatchStatusPostponed
+	m.PostponeReason = reason
+	return nil
+}
+
+// Cancel 取消比赛, 只能在开赛前 (含延期); 重复取消只更新原因
+func (m *Match) Cancel(reason string) error {
+	if err := m.CheckEditable(); err != nil && m.Status != MatchStatusCancelled {
+		return err
+	}
+	m.Status = MatchStatusCancelled
+	m.CancelReason = reason
+	return nil
+}
+
+// CheckEditable 只有未开始或延期的比赛可以修改赛程
+func (m *Match) CheckEditable() error {
+	switch m.Status {
+	case MatchStatusScheduled, MatchStatusPostponed:
+		return nil
+	case MatchStatusInProgress:
+		return myErrors.NewError(myErrors.CodeMatchInProgress, "比赛进行中, 不能修改赛程", "")
+	case MatchStatusFinished:
+		return myErrors.NewError(myErrors.CodeMatchFinished, "比赛已结束, 不能修改赛程", "")
+	default:
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "比赛已取消, 不能修改赛程", "")
+	}
+}
+
+// Reschedule 改期: 延期的比赛改期后恢复为未开始
+func (m *Match) Reschedule() {
+	if m.Status == MatchStatusPostponed {
+		m.Status = MatchStatusScheduled
+		m.PostponeReason = ""
+	}
+}
+
+// OccupiesDate 是否占用球队当天的赛程 (延期/取消的不占用)
+func (m *Match) OccupiesDate() bool {
+	return m.Status != MatchStatusPostponed && m.Status != MatchStatusCancelled
+}
+
+// CheckScorable 计分事件只允许在比赛进行中 (且当前节未结束) 时写入
+func (m *Match) CheckScorable() error {
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if m.PeriodEnded {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "本节已结束, 不能计分", "")
+	}
+	return nil
+}
+
+// CheckScorableAt 消费端按事件自身的节次判断: 入队时已按比赛时钟盖章 (节次为当时的当前节),
+// 本节结束后才被消费的事件仍计入所属的已打完的节, 不因消费延迟被拒绝;
+// 终场后胜负已确定, 迟到的计分事件可能打平或改变胜负, 一律拒绝
+// 没有节次的事件按当前状态判断
+func (m *Match) CheckScorableAt(quarter int8) error {
+	if quarter == 0 {
+		return m.CheckScorable()
+	}
+	if err := m.requireInProgress(); err != nil {
+		return err
+	}
+	if quarter < 1 || quarter > m.Period {
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, fmt.Sprintf("事件节次 %d 晚于比赛进程 (第 %d 节)", quarter, m.Period), "")
+	}
+	return nil
+}
+
+func (m *Match) requireInProgress() error {
+	switch m.Status {
+	case MatchStatusInProgress:
+		return nil
+	case MatchStatusFinished:
+		return myErrors.NewError(myErrors.CodeMatchFinished, "比赛已结束", "")
+	case MatchStatusCancelled:
+		return myErrors.NewError(myErrors.CodeInvalidMatchData, "比赛已取消", "")
+	default:
+		return myErrors.NewError(myErrors.CodeMatchNotStarted, "比赛未开始", "")
+	}
+}
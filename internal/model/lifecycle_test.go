@@ -0,0 +1,137 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	myErrors "nba-remake/errors"
+)
+
+// errCode 业务错误码, 成功为 CodeSuccess
+func errCode(t *testing.T, err error) myErrors.ErrorCode {
+	t.Helper()
+	if err == nil {
+		return myErrors.CodeSuccess
+	}
+	appErr, ok := myErrors.FromError(err)
+	if !ok {
+		t.Fatalf("不是业务错误: %v", err)
+	}
+	return appErr.Code
+}
+
+func TestMatchLifecycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    Match
+		op       func(m *Match) error
+		wantCode myErrors.ErrorCode
+		want     Match // 成功时流转后的状态
+	}{
+		{"开赛", Match{}, (*Match).Start, myErrors.CodeSuccess,
+			Match{Status: MatchStatusInProgress, Period: 1, ClockRemainingMs: 720000}},
+		{"重复开赛", Match{Status: MatchStatusInProgress, Period: 2}, (*Match).Start, myErrors.CodeMatchInProgress, Match{}},
+		{"已结束不能开赛", Match{Status: MatchStatusFinished}, (*Match).Start, myErrors.CodeMatchFinished, Match{}},
+		{"延期后不能直接开赛", Match{Status: MatchStatusPostponed}, (*Match).Start, myErrors.CodeInvalidMatchData, Match{}},
+
+		{"第1节结束进入第2节", Match{Status: MatchStatusInProgress, Period: 1},
+			func(m *Match) error { return m.EndPeriod(1) }, myErrors.CodeSuccess,
+			Match{Status: MatchStatusInProgress, Period: 2, ClockRemainingMs: 720000}},
+		{"第4节结束停表等待终场", Match{Status: MatchStatusInProgress, Period: 4, ClockRemainingMs: 1500},
+			func(m *Match) error { return m.EndPeriod(0) }, myErrors.CodeSuccess,
+			Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true}},
+		{"重复提交的节次", Match{Status: MatchStatusInProgress, Period: 2},
+			func(m *Match) error { return m.EndPeriod(1) }, myErrors.CodeInvalidMatchData, Match{}},
+		{"本节已结束", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true},
+			func(m *Match) error { return m.EndPeriod(4) }, myErrors.CodeInvalidMatchData, Match{}},
+		{"未开赛不能结束本节", Match{}, func(m *Match) error { return m.EndPeriod(0) }, myErrors.CodeMatchNotStarted, Match{}},
+
+		{"平局进入加时", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true, HomeScore: 100, VisitorScore: 100},
+			(*Match).StartOvertime, myErrors.CodeSuccess,
+			Match{Status: MatchStatusInProgress, Period: 5, HomeScore: 100, VisitorScore: 100, ClockRemainingMs: 300000}},
+		{"非平局不能加时", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true, HomeScore: 101, VisitorScore: 100},
+			(*Match).StartOvertime, myErrors.CodeInvalidMatchData, Match{}},
+		{"常规时间未打完不能加时", Match{Status: MatchStatusInProgress, Period: 3, HomeScore: 80, VisitorScore: 80},
+			(*Match).StartOvertime, myErrors.CodeMatchInProgress, Match{}},
+
+		{"分出胜负后终场", Match{Status: MatchStatusInProgress, Period: 5, PeriodEnded: true, HomeScore: 110, VisitorScore: 108},
+			(*Match).Finalize, myErrors.CodeSuccess,
+			Match{Status: MatchStatusFinished, Period: 5, PeriodEnded: true, HomeScore: 110, VisitorScore: 108}},
+		{"平局不能终场", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true, HomeScore: 100, VisitorScore: 100},
+			(*Match).Finalize, myErrors.CodeInvalidMatchData, Match{}},
+		{"本节未结束不能终场", Match{Status: MatchStatusInProgress, Period: 4, HomeScore: 101, VisitorScore: 100},
+			(*Match).Finalize, myErrors.CodeMatchInProgress, Match{}},
+		{"重复终场", Match{Status: MatchStatusFinished, Period: 4, PeriodEnded: true, HomeScore: 101, VisitorScore: 100},
+			(*Match).Finalize, myErrors.CodeMatchFinished, Match{}},
+
+		{"开赛前延期", Match{}, func(m *Match) error { return m.Postpone("场馆维修") }, myErrors.CodeSuccess,
+			Match{Status: MatchStatusPostponed, PostponeReason: "场馆维修"}},
+		{"进行中不能延期", Match{Status: MatchStatusInProgress, Period: 1},
+			func(m *Match) error { return m.Postpone("下雨") }, myErrors.CodeMatchInProgress, Match{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.match
+			code := errCode(t, tt.op(&m))
+			if code != tt.wantCode {
+				t.Fatalf("错误码 = %d, want %d", code, tt.wantCode)
+			}
+			if code != myErrors.CodeSuccess {
+				if !reflect.DeepEqual(m, tt.match) {
+					t.Errorf("失败时不应修改状态: %+v", m)
+				}
+				return
+			}
+			if !reflect.DeepEqual(m, tt.want) {
+				t.Errorf("流转后 = %+v, want %+v", m, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckScorable(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    Match
+		wantCode myErrors.ErrorCode
+	}{
+		{"进行中", Match{Status: MatchStatusInProgress, Period: 2}, myErrors.CodeSuccess},
+		{"加时进行中", Match{Status: MatchStatusInProgress, Period: 5}, myErrors.CodeSuccess},
+		{"本节已结束", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true}, myErrors.CodeInvalidMatchData},
+		{"未开始", Match{}, myErrors.CodeMatchNotStarted},
+		{"已延期", Match{Status: MatchStatusPostponed}, myErrors.CodeMatchNotStarted},
+		{"已结束", Match{Status: MatchStatusFinished, Period: 4, PeriodEnded: true}, myErrors.CodeMatchFinished},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := errCode(t, tt.match.CheckScorable()); code != tt.wantCode {
+				t.Errorf("CheckScorable() 错误码 = %d, want %d", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCheckScorableAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    Match
+		quarter  int8
+		wantCode myErrors.ErrorCode
+	}{
+		{"当前节", Match{Status: MatchStatusInProgress, Period: 2}, 2, myErrors.CodeSuccess},
+		{"之前的节", Match{Status: MatchStatusInProgress, Period: 3}, 1, myErrors.CodeSuccess},
+		{"本节结束前入队", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true}, 4, myErrors.CodeSuccess},
+		{"终场后不再计分", Match{Status: MatchStatusFinished, Period: 4, PeriodEnded: true}, 4, myErrors.CodeMatchFinished},
+		{"晚于比赛进程", Match{Status: MatchStatusInProgress, Period: 2}, 3, myErrors.CodeInvalidMatchData},
+		{"无效节次", Match{Status: MatchStatusInProgress, Period: 2}, -1, myErrors.CodeInvalidMatchData},
+		{"未开始", Match{}, 1, myErrors.CodeMatchNotStarted},
+		{"没有节次按当前状态", Match{Status: MatchStatusInProgress, Period: 4, PeriodEnded: true}, 0, myErrors.CodeInvalidMatchData},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := errCode(t, tt.match.CheckScorableAt(tt.quarter)); code != tt.wantCode {
+				t.Errorf("CheckScorableAt(%d) 错误码 = %d, want %d", tt.quarter, code, tt.wantCode)
+			}
+		})
+	}
+}
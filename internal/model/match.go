@@ -1,6 +1,20 @@
 package model
 
-import "time"
+import (
+	nba_v "nba-remake/api/proto/v1"
+	"time"
+)
+
+// 比赛状态 (Match.Status)
+const (
+	MatchStatusScheduled  = 0 // 未开始
+	MatchStatusInProgress = 1 // 进行中
+	MatchStatusFinished   = 2 // 已结束
+	MatchStatusPostponed  = 3 // 延期
+	MatchStatusCancelled  = 4 // 取消
+)
+
+const RegulationPeriods = 4 // 常规时间节数, 之后为加时
 
 // Match 比赛主表
 type Match struct {
@@ -14,21 +28,52 @@ type Match struct {
 	Status        int       `gorm:"default:0"`
 	StartTime     time.Time
 
-	HomeTeam    Team `gorm:"foreignKey:HomeTeamID"`
-	VisitorTeam Team `gorm:"foreignKey:VisitorTeamID"`
+	// 比赛进程: Period 为当前节次 (0 未开始, 5 及以上为加时)
+	// 前三节结束后直接进入下一节; 第四节及加时结束后 PeriodEnded=true, 等待加时或终场
+	Period         int8   `gorm:"column:period;type:tinyint;not null;default:0"`
+	PeriodEnded    bool   `gorm:"column:period_ended;not null;default:false"`
+	PostponeReason string `gorm:"column:postpone_reason;type:varchar(255)"`
+	CancelReason   string `gorm:"column:cancel_reason;type:varchar(255)"`
+
+	// 比赛时钟: 见 clock.go
+	ClockRemainingMs int64      `gorm:"column:clock_remaining_ms;not null;default:0"` // 最近一次启动/暂停时本节剩余毫秒数
+	ClockRunning     bool       `gorm:"column:clock_running;not null;default:false"`
+	ClockStartedAt   *time.Time `gorm:"column:clock_started_at;type:datetime(3)"` // 最近一次启动时刻, 暂停时为 NULL
+
+	HomeTeam     Team               `gorm:"foreignKey:HomeTeamID"`
+	VisitorTeam  Team               `gorm:"foreignKey:VisitorTeamID"`
+	PeriodScores []MatchPeriodScore `gorm:"foreignKey:MatchID"`
+}
+
+// MatchPeriodScore 单节比分 (line score), 由 Kafka 消费者按事件的 quarter 累加
+type MatchPeriodScore struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement"`
+	MatchID      uint64    `gorm:"column:match_id;not null;uniqueIndex:uk_match_period"`
+	Period       int8      `gorm:"column:period;not null;uniqueIndex:uk_match_period"` // 节次, 5 及以上为加时
+	HomeScore    int       `gorm:"column:home_score;not null;default:0"`
+	VisitorScore int       `gorm:"column:visitor_score;not null;default:0"`
+	UpdatedAt    time.Time `gorm:"column:updated_at"`
 }
 
 // MatchEvent 比赛事件流水表
 // 对应数据库: match_events
 type MatchEvent struct {
-	ID            uint64    `gorm:"primaryKey;autoIncrement"`
-	MatchID       uint64    `gorm:"column:match_id;not null;index"`
-	PlayerID      uint32    `gorm:"column:player_id;not null;index"`
-	TeamID        uint32    `gorm:"column:team_id;not null"`                // 发生时属于哪个队(冗余)
-	Type          int8      `gorm:"column:type;not null"`                   // 事件类型 (TINYINT)
-	SubType       string    `gorm:"column:sub_type;type:varchar(20)"`       // 子类型: 3pt, dunk, layup
-	Value         int       `gorm:"column:value;not null;default:0"`        // 分值
-	Quarter       int8      `gorm:"column:quarter;default:1"`               // 第几节
-	TimeRemaining string    `gorm:"column:time_remaining;type:varchar(10)"` // 剩余时间 e.g. "10:23"
-	EventTime     time.Time `gorm:"column:event_time;autoCreateTime"`       // 物理写入时间
+	ID            uint64          `gorm:"primaryKey;autoIncrement"`
+	EventID       *string         `gorm:"column:event_id;type:char(36);uniqueIndex:uk_event_id"` // 事件ID (UUID), 用于幂等去重; 历史数据为 NULL
+	MatchID       uint64          `gorm:"column:match_id;not null;index"`
+	PlayerID      uint32          `gorm:"column:player_id;not null;index"`
+	TeamID        uint32          `gorm:"column:team_id;not null"`                 // 发生时属于哪个队(冗余)
+	Type          nba_v.EventType `gorm:"column:type;type:tinyint;not null"`       // 事件类型 (TINYINT)
+	ShotType      nba_v.ShotType  `gorm:"column:shot_type;type:tinyint;default:0"` // 出手方式
+	SubType       string          `gorm:"column:sub_type;type:varchar(20)"`        // 补充描述: step-back, alley-oop
+	Value         int             `gorm:"column:value;not null;default:0"`         // 分值
+	Quarter       int8            `gorm:"column:quarter;default:1"`                // 第几节
+	TimeRemaining string          `gorm:"column:time_remaining;type:varchar(10)"`  // 剩余时间 e.g. "10:23"
+	EventTime     time.Time       `gorm:"column:event_time;autoCreateTime"`        // 物理写入时间
+
+	// 作废/更正: 原记录保留用于审计
+	Voided        bool    `gorm:"column:voided;not null;default:false"` // 是否已作废
+	VoidedBy      *string `gorm:"column:voided_by;type:char(36)"`       // 执行作废/更正的事件ID
+	VoidReason    string  `gorm:"column:void_reason;type:varchar(255)"` // 作废原因
+	AmendsEventID *string `gorm:"column:amends_event_id;type:char(36)"` // 本事件更正的原事件ID
 }
@@ -2,6 +2,7 @@ package model
 
 import (
 	nba_v "nba-remake/api/proto/v1"
+	"strings"
 	"time"
 )
 
@@ -15,6 +16,25 @@ type Player struct {
 	Weight       float64            `gorm:"type:decimal(5,2);column:weight" json:"weight,omitempty"`
 	Birthday     *time.Time         `gorm:"type:date;column:birthday" json:"birthday,omitempty"`
 	Status       nba_v.PlayerStatus `gorm:"type:tinyint;default:1;column:status" json:"status"`
+	Aliases      string             `gorm:"type:varchar(255);column:aliases" json:"aliases,omitempty"`    // 别名/绰号, 逗号分隔 e.g. "KD,死神"
+	RosterSlot   nba_v.RosterSlot   `gorm:"type:tinyint;default:0;column:roster_slot" json:"roster_slot"` // 阵容名额类型, 自由球员为 0
+	NoTrade      bool               `gorm:"not null;default:false;column:no_trade" json:"no_trade"`       // 不可交易条款
 	CreatedAt    time.Time          `gorm:"autoCreateTime;column:created_at" json:"created_at"`
 	UpdatedAt    time.Time          `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
 }
+
+// AliasList 别名拆分成列表
+func (p *Player) AliasList() []string {
+	var aliases []string
+	for _, a := range strings.Split(p.Aliases, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			aliases = append(aliases, a)
+		}
+	}
+	return aliases
+}
+
+// SetAliases 列表合并为逗号分隔
+func (p *Player) SetAliases(aliases []string) {
+	p.Aliases = strings.Join(aliases, ",")
+}
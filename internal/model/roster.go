@@ -0,0 +1,111 @@
+package model
+
+import (
+	"fmt"
+
+	nba_v "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+)
+
+// 阵容名额上限: 标准合同 15 人, 双向合同 3 人; 下放发展联盟 (ASSIGNED) 的球员仍占用原名额
+const (
+	MaxStandardRoster = 15
+	MaxTwoWayRoster   = 3
+	MaxJerseyNumber   = 99
+)
+
+// Roster 球队当前阵容 (不含退役球员)
+type Roster struct {
+	TeamID  uint32
+	Players []*Player
+}
+
+// Count 某类名额已用人数
+func (r *Roster) Count(slot nba_v.RosterSlot) int {
+	n := 0
+	for _, p := range r.Players {
+		if p.Slot() == slot {
+			n++
+		}
+	}
+	return n
+}
+
+// Assigned 下放发展联盟的人数
+func (r *Roster) Assigned() int {
+	n := 0
+	for _, p := range r.Players {
+		if p.Status == nba_v.PlayerStatus_ASSIGNED {
+			n++
+		}
+	}
+	return n
+}
+
+// CheckJersey 球衣号码范围及队内唯一, excludeID 为号码持有人自己 (改号时)
+func (r *Roster) CheckJersey(number int32, excludeID uint32) error {
+	if number < 0 || number > MaxJerseyNumber {
+		return myErrors.NewError(myErrors.CodeInvalidPlayerData, fmt.Sprintf("球衣号码应在 0-%d 之间", MaxJerseyNumber), "")
+	}
+	for _, p := range r.Players {
+		if p.ID != excludeID && int32(p.JerseyNumber) == number {
+			return myErrors.NewError(myErrors.CodeJerseyConflict, fmt.Sprintf("%d 号已被 %s 使用", number, p.Name), "")
+		}
+	}
+	return nil
+}
+
+// CheckSlot 是否还有 slot 类型的空余名额
+func (r *Roster) CheckSlot(slot nba_v.RosterSlot) error {
+	switch slot {
+	case nba_v.RosterSlot_STANDARD:
+		if r.Count(slot) >= MaxStandardRoster {
+			return myErrors.NewError(myErrors.CodeTeamFull, fmt.Sprintf("标准合同名额已满 (%d 人)", MaxStandardRoster), "")
+		}
+	case nba_v.RosterSlot_TWO_WAY:
+		if r.Count(slot) >= MaxTwoWayRoster {
+			return myErrors.NewError(myErrors.CodeTeamFull, fmt.Sprintf("双向合同名额已满 (%d 人)", MaxTwoWayRoster), "")
+		}
+	default:
+		return myErrors.NewError(myErrors.CodeInvalidPlayerData, "名额类型无效", slot.String())
+	}
+	return nil
+}
+
+// Slot 阵容名额类型; 早于名额类型的数据 (在队但 roster_slot 为 0) 按标准合同
+func (p *Player) Slot() nba_v.RosterSlot {
+	if p.TeamID != 0 && p.RosterSlot == nba_v.RosterSlot_ROSTER_SLOT_UNKNOWN {
+		return nba_v.RosterSlot_STANDARD
+	}
+	return p.RosterSlot
+}
+
+// Join 球员加入球队阵容
+func (p *Player) Join(teamID uint32, jersey int32, slot nba_v.RosterSlot) {
+	p.TeamID = teamID
+	p.JerseyNumber = uint8(jersey)
+	p.RosterSlot = slot
+	if p.Status == nba_v.PlayerStatus_STATUS_UNKNOWN || p.Status == nba_v.PlayerStatus_ASSIGNED {
+		p.Status = nba_v.PlayerStatus_ACTIVE
+	}
+}
+
+// Release 球员离开阵容成为自由球员, 下放状态随之结束
+func (p *Player) Release() {
+	p.TeamID = 0
+	p.RosterSlot = nba_v.RosterSlot_ROSTER_SLOT_UNKNOWN
+	if p.Status == nba_v.PlayerStatus_ASSIGNED {
+		p.Status = nba_v.PlayerStatus_ACTIVE
+	}
+}
+
+// CheckStatus 只有在阵容中的球员才能下放; 退役球员不能在阵容中
+func (p *Player) CheckStatus() error {
+	if p.Status == nba_v.PlayerStatus_ASSIGNED && p.TeamID == 0 {
+		return myErrors.NewError(myErrors.CodeInvalidPlayerData, "自由球员不能下放", "")
+	}
+	if p.Status == nba_v.PlayerStatus_RETIRED && p.TeamID != 0 {
+		return myErrors.NewError(myErrors.CodeInvalidPlayerData, "退役球员不能在球队阵容中", "")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// seasonPattern 赛季格式 "2023-24"
+var seasonPattern = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+
+// SeasonOf 比赛日期所属赛季: 7 月 1 日起算新赛季 (常规赛 10 月开始, 季后赛 6 月结束)
+func SeasonOf(date time.Time) string {
+	year := date.Year()
+	if date.Month() < time.July {
+		year--
+	}
+	return fmt.Sprintf("%d-%02d", year, (year+1)%100)
+}
+
+// ParseSeason 解析赛季, 返回起始年份; 要求形如 "2023-24" 且后两位是下一年
+func ParseSeason(season string) (int, error) {
+	m := seasonPattern.FindStringSubmatch(season)
+	if m == nil {
+		return 0, fmt.Errorf("赛季格式错误, 应为 YYYY-YY, 当前: %q", season)
+	}
+	start, _ := strconv.Atoi(m[1])
+	end, _ := strconv.Atoi(m[2])
+	if (start+1)%100 != end {
+		return 0, fmt.Errorf("赛季年份不连续: %q", season)
+	}
+	return start, nil
+}
+
+// ValidateSeason 校验赛季格式, 且比赛日期落在该赛季内
+func ValidateSeason(season string, date time.Time) error {
+	if _, err := ParseSeason(season); err != nil {
+		return err
+	}
+	if SeasonOf(date) != season {
+		return fmt.Errorf("比赛日期 %s 不在 %s 赛季内", date.Format("2006-01-02"), season)
+	}
+	return nil
+}
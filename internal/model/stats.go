@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// PlayerGameStats 球员单场技术统计 (box score)
+// 对应数据库: player_game_stats, 由 Kafka 消费者按事件累加
+type PlayerGameStats struct {
+	ID       uint64 `gorm:"primaryKey;autoIncrement"`
+	MatchID  uint64 `gorm:"column:match_id;not null;uniqueIndex:uk_match_player"`
+	PlayerID uint32 `gorm:"column:player_id;not null;uniqueIndex:uk_match_player"`
+	TeamID   uint32 `gorm:"column:team_id;not null"`
+
+	Points int `gorm:"column:points;not null;default:0"` // 得分
+	FGM    int `gorm:"column:fgm;not null;default:0"`    // 投篮命中
+	FGA    int `gorm:"column:fga;not null;default:0"`    // 投篮出手
+	FG3M   int `gorm:"column:fg3m;not null;default:0"`   // 三分命中
+	FG3A   int `gorm:"column:fg3a;not null;default:0"`   // 三分出手
+	FTM    int `gorm:"column:ftm;not null;default:0"`    // 罚球命中
+	FTA    int `gorm:"column:fta;not null;default:0"`    // 罚球出手
+	OREB   int `gorm:"column:oreb;not null;default:0"`   // 前场篮板
+	DREB   int `gorm:"column:dreb;not null;default:0"`   // 后场篮板
+	AST    int `gorm:"column:ast;not null;default:0"`    // 助攻
+	STL    int `gorm:"column:stl;not null;default:0"`    // 抢断
+	BLK    int `gorm:"column:blk;not null;default:0"`    // 盖帽
+	TOV    int `gorm:"column:tov;not null;default:0"`    // 失误
+	PF     int `gorm:"column:pf;not null;default:0"`     // 犯规
+
+	Seconds  int  `gorm:"column:seconds;not null;default:0"`      // 上场时间(秒)
+	OnCourt  bool `gorm:"column:on_court;not null;default:false"` // 当前是否在场上
+	LastInAt int  `gorm:"column:last_in_at;not null;default:0"`   // 最近一次上场时比赛已进行的秒数
+
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (PlayerGameStats) TableName() string {
+	return "player_game_stats"
+}
@@ -1,8 +1,12 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
 
-// Team 对应数据库中的 teams 表
+// Team 对应数据库中的 teams 表 (当前的名称/城市/场馆/队徽, 历史见 TeamSeason)
 type Team struct {
 	ID           uint32 `gorm:"primaryKey"`
 	Name         string `gorm:"type:varchar(50);not null"`
@@ -14,3 +18,56 @@ type Team struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// TeamSeason 球队按赛季的展示信息 (搬迁/更名时新增一行), 某赛季取 season 不晚于该赛季的最近一条
+// 历史比赛按比赛所属赛季显示当时的队名, 不受之后更名影响
+type TeamSeason struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement"`
+	TeamID       uint32 `gorm:"column:team_id;not null;uniqueIndex:uk_team_season"`
+	Season       string `gorm:"column:season;type:varchar(10);not null;uniqueIndex:uk_team_season"` // 生效赛季
+	Name         string `gorm:"type:varchar(50);not null"`
+	Abbreviation string `gorm:"type:char(3);not null"`
+	City         string `gorm:"type:varchar(50);not null"`
+	LogoURL      string `gorm:"column:logo_url;type:varchar(255)"`
+	HomeArena    string `gorm:"column:home_arena;type:varchar(100)"`
+	CreatedAt    time.Time
+}
+
+// BaselineSeason 赛季记录之前就存在的球队, 其当时的信息作为该赛季起生效的基线记录, 早于所有比赛
+const BaselineSeason = "1946-47"
+
+// abbreviationPattern 球队缩写: 3 个大写字母
+var abbreviationPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ValidateAbbreviation 校验球队缩写
+func ValidateAbbreviation(abbr string) error {
+	if !abbreviationPattern.MatchString(abbr) {
+		return fmt.Errorf("球队缩写必须是 3 个大写字母, 当前: %q", abbr)
+	}
+	return nil
+}
+
+// ApplySeason 用赛季展示信息覆盖当前信息, ts 为 nil 时不变
+func (t *Team) ApplySeason(ts *TeamSeason) {
+	if ts == nil {
+		return
+	}
+	t.Name = ts.Name
+	t.Abbreviation = ts.Abbreviation
+	t.City = ts.City
+	t.LogoURL = ts.LogoURL
+	t.HomeArena = ts.HomeArena
+}
+
+// SeasonProfile 当前信息生成一条赛季展示记录
+func (t *Team) SeasonProfile(season string) *TeamSeason {
+	return &TeamSeason{
+		TeamID:       t.ID,
+		Season:       season,
+		Name:         t.Name,
+		Abbreviation: t.Abbreviation,
+		City:         t.City,
+		LogoURL:      t.LogoURL,
+		HomeArena:    t.HomeArena,
+	}
+}
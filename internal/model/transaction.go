@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	nba_v "nba-remake/api/proto/v1"
+)
+
+// PlayerTransaction 球员异动流水 (签约/交易/裁掉/下放等), 只追加不修改
+// 某天所在球队取生效日期不晚于该天的最后一条记录的 ToTeamID
+type PlayerTransaction struct {
+	ID            uint64                `gorm:"primaryKey;autoIncrement"`
+	PlayerID      uint32                `gorm:"column:player_id;not null;index:idx_player_date"`
+	Type          nba_v.TransactionType `gorm:"column:type;type:tinyint;not null"`
+	FromTeamID    uint32                `gorm:"column:from_team_id;not null;default:0"` // 0 表示自由球员
+	ToTeamID      uint32                `gorm:"column:to_team_id;not null;default:0"`
+	EffectiveDate time.Time             `gorm:"column:effective_date;type:date;not null;index:idx_player_date"`
+	TradeID       uint64                `gorm:"column:trade_id;not null;default:0;index"` // 交易产生的记录才有
+	Note          string                `gorm:"column:note;type:varchar(255)"`
+	CreatedAt     time.Time
+}
+
+// Trade 一笔交易, 球员部分见 PlayerTransaction.TradeID, 选秀权部分见 TradePick
+type Trade struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	Date      time.Time `gorm:"column:date;type:date;not null;index"`
+	Note      string    `gorm:"column:note;type:varchar(255)"`
+	CreatedAt time.Time
+	Picks     []TradePick `gorm:"foreignKey:TradeID"`
+}
+
+// TradePick 交易中转移的选秀权
+type TradePick struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"`
+	TradeID    uint64 `gorm:"column:trade_id;not null;index"`
+	PickID     uint32 `gorm:"column:pick_id;not null;index"`
+	FromTeamID uint32 `gorm:"column:from_team_id;not null"`
+	ToTeamID   uint32 `gorm:"column:to_team_id;not null"`
+	Protection int    `gorm:"column:protection;type:smallint;not null;default:0"` // 交易时附加的保护 (由 FromTeamID 持有), 0 表示沿用原有保护
+}
+
+// TeamStint 效力某队的区间, To 为零值表示至今
+type TeamStint struct {
+	TeamID uint32
+	From   time.Time
+	To     time.Time
+}
+
+// TeamOn 按异动记录 (生效日期升序) 求 date 当天所在球队; 没有不晚于 date 的记录时 known 为 false
+func TeamOn(history []*PlayerTransaction, date time.Time) (teamID uint32, known bool) {
+	day := date.Format("2006-01-02")
+	for _, t := range history {
+		if t.EffectiveDate.Format("2006-01-02") > day {
+			break
+		}
+		teamID, known = t.ToTeamID, true
+	}
+	return teamID, known
+}
+
+// Stints 按异动记录 (生效日期升序) 求效力区间, 不含自由球员期间; 下放/名额转换不改变球队
+func Stints(history []*PlayerTransaction) []TeamStint {
+	var stints []TeamStint
+	for _, t := range history {
+		if n := len(stints); n > 0 && stints[n-1].To.IsZero() {
+			if stints[n-1].TeamID == t.ToTeamID {
+				continue
+			}
+			stints[n-1].To = t.EffectiveDate
+		}
+		if t.ToTeamID != 0 {
+			stints = append(stints, TeamStint{TeamID: t.ToTeamID, From: t.EffectiveDate})
+		}
+	}
+	return stints
+}
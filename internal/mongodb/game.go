@@ -0,0 +1,119 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"nba-remake/internal/config"
+)
+
+// GameDoc games 集合中的整场比赛归档 (反范式, 读取时不再依赖 MySQL)
+type GameDoc struct {
+	MatchID      int64         `bson:"_id"`
+	Season       string        `bson:"season"`
+	Date         string        `bson:"date"` // YYYY-MM-DD
+	StartTime    time.Time     `bson:"start_time"`
+	Status       int           `bson:"status"`
+	Home         GameTeam      `bson:"home"`
+	Visitor      GameTeam      `bson:"visitor"`
+	PeriodScores []PeriodScore `bson:"period_scores"`
+	Plays        []Play        `bson:"plays"` // 按比赛进程排序
+	ArchivedAt   time.Time     `bson:"archived_at"`
+}
+
+// GameTeam 单队信息 + 最终得分 + 技术统计
+type GameTeam struct {
+	TeamID       uint32     `bson:"team_id"`
+	Name         string     `bson:"name"`
+	City         string     `bson:"city"`
+	Abbreviation string     `bson:"abbreviation"`
+	Conference   string     `bson:"conference"`
+	LogoURL      string     `bson:"logo_url"`
+	Score        int        `bson:"score"`
+	Players      []StatLine `bson:"players"`
+	Totals       StatLine   `bson:"totals"`
+}
+
+// StatLine 球员 (或全队合计, player_id 为 0) 的技术统计
+type StatLine struct {
+	PlayerID   uint32 `bson:"player_id"`
+	PlayerName string `bson:"player_name"`
+	Points     int    `bson:"points"`
+	FGM        int    `bson:"fgm"`
+	FGA        int    `bson:"fga"`
+	FG3M       int    `bson:"fg3m"`
+	FG3A       int    `bson:"fg3a"`
+	FTM        int    `bson:"ftm"`
+	FTA        int    `bson:"fta"`
+	OREB       int    `bson:"oreb"`
+	DREB       int    `bson:"dreb"`
+	AST        int    `bson:"ast"`
+	STL        int    `bson:"stl"`
+	BLK        int    `bson:"blk"`
+	TOV        int    `bson:"tov"`
+	PF         int    `bson:"pf"`
+	Seconds    int    `bson:"seconds"`
+}
+
+// PeriodScore 单节比分, 5 及以上为加时
+type PeriodScore struct {
+	Period  int `bson:"period"`
+	Home    int `bson:"home"`
+	Visitor int `bson:"visitor"`
+}
+
+// Play 单条比赛事件, HomeScore/VisitorScore 为该事件之后的比分 (作废事件不计入)
+type Play struct {
+	Seq           int       `bson:"seq"`
+	EventID       string    `bson:"event_id,omitempty"`
+	PlayerID      uint32    `bson:"player_id"`
+	TeamID        uint32    `bson:"team_id"`
+	Type          string    `bson:"type"`
+	ShotType      string    `bson:"shot_type"`
+	SubType       string    `bson:"sub_type,omitempty"`
+	Value         int       `bson:"value"`
+	Quarter       int       `bson:"quarter"`
+	TimeRemaining string    `bson:"time_remaining"`
+	EventTime     time.Time `bson:"event_time"`
+	Voided        bool      `bson:"voided"`
+	AmendsEventID string    `bson:"amends_event_id,omitempty"`
+	HomeScore     int       `bson:"home_score"`
+	VisitorScore  int       `bson:"visitor_score"`
+}
+
+// GameStore games 集合
+type GameStore struct {
+	coll *mongo.Collection
+}
+
+func NewGameStore(client *mongo.Client, conf *config.MongoDBConfig) *GameStore {
+	return &GameStore{coll: client.Database(conf.Database).Collection("games")}
+}
+
+// EnsureIndexes 按赛季/日期查询历史比赛用
+func (s *GameStore) EnsureIndexes(ctx context.Context) error {
+	_, err := s.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "season", Value: 1}, {Key: "date", Value: 1}}},
+		{Keys: bson.D{{Key: "home.team_id", Value: 1}, {Key: "date", Value: 1}}},
+		{Keys: bson.D{{Key: "visitor.team_id", Value: 1}, {Key: "date", Value: 1}}},
+	})
+	return err
+}
+
+// Save 写入归档, 已存在则整体覆盖 (重复归档幂等)
+func (s *GameStore) Save(ctx context.Context, doc *GameDoc) error {
+	_, err := s.coll.ReplaceOne(ctx, bson.M{"_id": doc.MatchID}, doc, options.Replace().SetUpsert(true))
+	return err
+}
+
+// Get 查询归档, 不存在时返回 mongo.ErrNoDocuments
+func (s *GameStore) Get(ctx context.Context, matchID int64) (*GameDoc, error) {
+	var doc GameDoc
+	if err := s.coll.FindOne(ctx, bson.M{"_id": matchID}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
@@ -0,0 +1,188 @@
+package mq
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"nba-remake/internal/config"
+)
+
+// 死信消息头, 记录失败原因和原始位置, 方便排查
+const (
+	HeaderError             = "x-error"
+	HeaderAttempts          = "x-attempts"
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+)
+
+// replayIdleTimeout 重放时等待下一条死信的最长时间
+// 事务控制记录或已被清理的消息会占用 offset 但不会投递, 不能只靠 offset 判断是否读完
+const replayIdleTimeout = 5 * time.Second
+
+// SendDeadLetter 把处理失败的消息原样转发到死信队列
+func (p *Producer) SendDeadLetter(msg *sarama.ConsumerMessage, reason error, attempts int) error {
+	if p.dlqTopic == "" {
+		return fmt.Errorf("未配置死信队列 topic")
+	}
+	dlqMsg := &sarama.ProducerMessage{
+		Topic: p.dlqTopic,
+		Key:   sarama.ByteEncoder(msg.Key),
+		Value: sarama.ByteEncoder(msg.Value),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(HeaderError), Value: []byte(reason.Error())},
+			{Key: []byte(HeaderAttempts), Value: []byte(strconv.Itoa(attempts))},
+			{Key: []byte(HeaderOriginalTopic), Value: []byte(msg.Topic)},
+			{Key: []byte(HeaderOriginalPartition), Value: []byte(strconv.Itoa(int(msg.Partition)))},
+			{Key: []byte(HeaderOriginalOffset), Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+		},
+	}
+
+	_, _, err := p.client.SendMessage(dlqMsg)
+	if err != nil {
+		log.Printf("Kafka DLQ Send Error: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeadLetterReplayer 把死信队列里的消息重新投递回主 Topic
+// 使用独立的 consumer group 记录重放进度, 已重放的消息不会重复投递
+// 同一时间只允许一次重放, 并发请求排队执行, 避免同一条死信被重复投递
+type DeadLetterReplayer struct {
+	conf     config.KafkaConfig
+	producer *Producer
+	mu       sync.Mutex
+}
+
+func NewDeadLetterReplayer(conf config.KafkaConfig, producer *Producer) *DeadLetterReplayer {
+	return &DeadLetterReplayer{conf: conf, producer: producer}
+}
+
+// Replay 重放最多 limit 条死信 (limit<=0 表示全部), 返回重放条数和剩余条数
+// 只重放开始时已存在的死信; ctx 取消时停止, 已重放的进度会保留
+func (r *DeadLetterReplayer) Replay(ctx context.Context, limit int) (replayed int, remaining int64, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	client, err := sarama.NewClient(r.conf.Brokers, saramaConfig)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer client.Close()
+
+	offsetManager, err := sarama.NewOffsetManagerFromClient(r.conf.GroupID+"_dlq_replay", client)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer offsetManager.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer consumer.Close()
+
+	partitions, err := client.Partitions(r.conf.DeadLetterTopic)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, partition := range partitions {
+		quota := 0
+		if limit > 0 {
+			quota = limit - replayed
+			if quota <= 0 {
+				quota = -1 // 额度用完, 后续分区只统计剩余数量
+			}
+		}
+		n, left, err := r.replayPartition(ctx, client, consumer, offsetManager, partition, quota)
+		replayed += n
+		remaining += left
+		if err != nil {
+			return replayed, remaining, err
+		}
+	}
+	return replayed, remaining, nil
+}
+
+// replayPartition 重放单个分区; quota=0 不限条数, quota<0 只统计剩余数量
+func (r *DeadLetterReplayer) replayPartition(ctx context.Context, client sarama.Client, consumer sarama.Consumer, om sarama.OffsetManager, partition int32, quota int) (int, int64, error) {
+	topic := r.conf.DeadLetterTopic
+	pom, err := om.ManagePartition(topic, partition)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer pom.Close()
+
+	oldest, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, 0, err
+	}
+	newest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, 0, err
+	}
+	next, _ := pom.NextOffset()
+	if next < oldest {
+		next = oldest
+	}
+	if next >= newest || quota < 0 {
+		return 0, newest - next, nil
+	}
+
+	pc, err := consumer.ConsumePartition(topic, partition, next)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer pc.Close()
+
+	replayed, next, err := replayMessages(ctx, pc.Messages(), next, newest, quota, replayIdleTimeout, func(msg *sarama.ConsumerMessage) error {
+		if err := r.producer.Send(string(msg.Key), msg.Value); err != nil {
+			return err
+		}
+		pom.MarkOffset(msg.Offset+1, "")
+		return nil
+	})
+	return replayed, max(newest-next, 0), err
+}
+
+// replayMessages 依次重放 offset 小于 newest 的消息, 返回重放条数和下一个待重放的 offset
+// 达到 quota、读到 newest、ctx 取消或 idle 时间内没有新消息时停止
+func replayMessages(ctx context.Context, messages <-chan *sarama.ConsumerMessage, next, newest int64, quota int, idle time.Duration, replay func(*sarama.ConsumerMessage) error) (int, int64, error) {
+	timer := time.NewTimer(idle)
+	defer timer.Stop()
+
+	replayed := 0
+	for next < newest && (quota == 0 || replayed < quota) {
+		select {
+		case <-ctx.Done():
+			return replayed, next, ctx.Err()
+		case <-timer.C:
+			log.Printf("[DLQ] %v 内没有读到 offset %d 之后的死信, 停止重放", idle, next)
+			return replayed, next, nil
+		case msg, ok := <-messages:
+			if !ok {
+				return replayed, next, nil
+			}
+			if msg.Offset >= newest {
+				return replayed, next, nil
+			}
+			if err := replay(msg); err != nil {
+				return replayed, next, err
+			}
+			replayed++
+			next = msg.Offset + 1
+			timer.Reset(idle)
+		}
+	}
+	return replayed, next, nil
+}
@@ -0,0 +1,124 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+)
+
+func TestSendDeadLetter(t *testing.T) {
+	msg := &sarama.ConsumerMessage{Topic: "match_events", Partition: 2, Offset: 41, Key: []byte("7"), Value: []byte(`{"match_id":7}`)}
+
+	sp := mocks.NewSyncProducer(t, nil)
+	sp.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(m *sarama.ProducerMessage) error {
+		if m.Topic != "match_events_dlq" {
+			return errors.New("死信应发往 DLQ topic, 实际: " + m.Topic)
+		}
+		if key, _ := m.Key.Encode(); string(key) != "7" {
+			return errors.New("死信应保留原消息 key")
+		}
+		want := map[string]string{
+			HeaderError:             "比赛不存在: 7",
+			HeaderAttempts:          "1",
+			HeaderOriginalTopic:     "match_events",
+			HeaderOriginalPartition: "2",
+			HeaderOriginalOffset:    "41",
+		}
+		for _, h := range m.Headers {
+			if v, ok := want[string(h.Key)]; ok && v != string(h.Value) {
+				return errors.New("消息头 " + string(h.Key) + " = " + string(h.Value) + ", want " + v)
+			}
+			delete(want, string(h.Key))
+		}
+		if len(want) > 0 {
+			return errors.New("缺少消息头")
+		}
+		return nil
+	})
+
+	p := &Producer{client: sp, topic: "match_events", dlqTopic: "match_events_dlq"}
+	if err := p.SendDeadLetter(msg, errors.New("比赛不存在: 7"), 1); err != nil {
+		t.Fatalf("SendDeadLetter: %v", err)
+	}
+	if err := sp.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendDeadLetterNotConfigured(t *testing.T) {
+	p := &Producer{topic: "match_events"}
+	if err := p.SendDeadLetter(&sarama.ConsumerMessage{}, errors.New("x"), 1); err == nil {
+		t.Error("未配置死信 topic 时应返回错误, 不能提交 offset")
+	}
+}
+
+// deadLetters 依次返回给定 offset 的死信, 通道不关闭, 模拟仍在运行的分区消费者
+func deadLetters(offsets ...int64) <-chan *sarama.ConsumerMessage {
+	ch := make(chan *sarama.ConsumerMessage, len(offsets))
+	for _, off := range offsets {
+		ch <- &sarama.ConsumerMessage{Offset: off}
+	}
+	return ch
+}
+
+func TestReplayMessages(t *testing.T) {
+	tests := []struct {
+		name         string
+		offsets      []int64
+		newest       int64
+		quota        int
+		wantReplayed int
+		wantNext     int64
+	}{
+		{"读到 newest 为止", []int64{3, 4, 5}, 6, 0, 3, 6},
+		{"达到 quota 停止", []int64{3, 4, 5}, 6, 2, 2, 5},
+		{"不重放开始后新写入的死信", []int64{3, 4, 6}, 5, 0, 2, 5},
+		// 最后的 offset 被事务控制记录占用, 读不到 newest-1 时空闲超时退出
+		{"offset 有空洞时空闲超时", []int64{3, 4}, 6, 0, 2, 5},
+		{"没有消息时空闲超时", nil, 6, 0, 0, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int64
+			replayed, next, err := replayMessages(context.Background(), deadLetters(tt.offsets...), 3, tt.newest, tt.quota, 10*time.Millisecond,
+				func(msg *sarama.ConsumerMessage) error {
+					got = append(got, msg.Offset)
+					return nil
+				})
+			if err != nil {
+				t.Fatalf("replayMessages: %v", err)
+			}
+			if replayed != tt.wantReplayed || next != tt.wantNext || len(got) != replayed {
+				t.Errorf("replayMessages() = %d, %d (重放 %v), want %d, %d", replayed, next, got, tt.wantReplayed, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestReplayMessagesStops(t *testing.T) {
+	t.Run("ctx 取消", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, next, err := replayMessages(ctx, deadLetters(), 3, 6, 0, time.Hour, func(*sarama.ConsumerMessage) error { return nil })
+		if !errors.Is(err, context.Canceled) || next != 3 {
+			t.Errorf("replayMessages() next = %d err = %v, want 3 context.Canceled", next, err)
+		}
+	})
+
+	t.Run("投递失败不推进 offset", func(t *testing.T) {
+		sendErr := errors.New("broker 不可用")
+		replayed, next, err := replayMessages(context.Background(), deadLetters(3, 4), 3, 6, 0, time.Hour, func(msg *sarama.ConsumerMessage) error {
+			if msg.Offset == 4 {
+				return sendErr
+			}
+			return nil
+		})
+		if !errors.Is(err, sendErr) || replayed != 1 || next != 4 {
+			t.Errorf("replayMessages() = %d, %d, %v, want 1, 4, %v", replayed, next, err, sendErr)
+		}
+	})
+}
@@ -8,8 +8,9 @@ import (
 )
 
 type Producer struct {
-	client sarama.SyncProducer
-	topic  string // 存一下 topic，发送时就不用每次传了
+	client   sarama.SyncProducer
+	topic    string // 存一下 topic，发送时就不用每次传了
+	dlqTopic string // 死信队列 topic
 }
 
 // NewProducer 现在接收 config.KafkaConfig
@@ -26,8 +27,9 @@ func NewProducer(conf config.KafkaConfig) (*Producer, error) {
 	}
 
 	return &Producer{
-		client: client,
-		topic:  conf.Topic, // 从配置里拿 topic
+		client:   client,
+		topic:    conf.Topic, // 从配置里拿 topic
+		dlqTopic: conf.DeadLetterTopic,
 	}, nil
 }
 
@@ -0,0 +1,34 @@
+package processor
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"nba-remake/internal/cache"
+	"nba-remake/internal/model"
+)
+
+// MatchArchiver 重新生成已结束比赛的归档 (MongoDB games 集合)
+type MatchArchiver interface {
+	RearchiveMatch(ctx context.Context, matchID int64) error
+}
+
+// refreshFinished 已结束比赛的事件/更正提交后, 清除该赛季排名缓存并重新归档, 避免 GetGameArchive 返回旧的技术统计
+// 归档失败不影响消费, 可以通过 ArchiveMatch 补录
+func (h *StatsHandler) refreshFinished(match *model.Match) {
+	if match.Status != model.MatchStatusFinished {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if h.redisClient != nil {
+		h.cache.Invalidate(ctx, cache.StandingsKey(match.Season))
+	}
+	if h.archiver == nil {
+		return
+	}
+	if err := h.archiver.RearchiveMatch(ctx, int64(match.ID)); err != nil {
+		log.Printf("[Consumer] 重新归档失败 match_id=%d err=%v", match.ID, err)
+	}
+}
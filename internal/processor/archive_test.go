@@ -0,0 +1,31 @@
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"nba-remake/internal/model"
+)
+
+// fakeArchiver 记录重新归档的比赛
+type fakeArchiver struct {
+	matches []int64
+}
+
+func (a *fakeArchiver) RearchiveMatch(_ context.Context, matchID int64) error {
+	a.matches = append(a.matches, matchID)
+	return nil
+}
+
+// 终场后的更正提交后需要重新归档, 进行中的比赛不归档
+func TestRefreshFinished(t *testing.T) {
+	h, _ := newTestHandler(t)
+	archiver := &fakeArchiver{}
+	h.archiver = archiver
+
+	h.refreshFinished(&model.Match{ID: 1, Status: model.MatchStatusInProgress})
+	h.refreshFinished(&model.Match{ID: 2, Status: model.MatchStatusFinished})
+	if len(archiver.matches) != 1 || archiver.matches[0] != 2 {
+		t.Errorf("重新归档的比赛 = %v, want [2]", archiver.matches)
+	}
+}
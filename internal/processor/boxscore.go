@@ -0,0 +1,185 @@
+package processor
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/model"
+)
+
+// 需要累加的计数列
+var counterColumns = []string{
+	"points", "fgm", "fga", "fg3m", "fg3a", "ftm", "fta",
+	"oreb", "dreb", "ast", "stl", "blk", "tov", "pf",
+}
+
+// statDelta 把单条事件换算成技术统计的增量, sign 为 -1 时得到冲正用的负增量
+func statDelta(event *EventDTO, sign int) model.PlayerGameStats {
+	d := model.PlayerGameStats{
+		MatchID:  event.MatchID,
+		PlayerID: event.PlayerID,
+		TeamID:   event.TeamID,
+	}
+
+	switch event.Type {
+	case pb.EventType_SHOT_MADE:
+		d.Points = event.Value
+		d.FGM, d.FGA = 1, 1
+		if event.ShotType == pb.ShotType_THREE_POINTER {
+			d.FG3M, d.FG3A = 1, 1
+		}
+	case pb.EventType_SHOT_MISSED:
+		d.FGA = 1
+		if event.ShotType == pb.ShotType_THREE_POINTER {
+			d.FG3A = 1
+		}
+	case pb.EventType_FREE_THROW_MADE:
+		d.Points = event.Value
+		d.FTM, d.FTA = 1, 1
+	case pb.EventType_FREE_THROW_MISSED:
+		d.FTA = 1
+	case pb.EventType_REBOUND_OFFENSIVE:
+		d.OREB = 1
+	case pb.EventType_REBOUND_DEFENSIVE:
+		d.DREB = 1
+	case pb.EventType_ASSIST:
+		d.AST = 1
+	case pb.EventType_STEAL:
+		d.STL = 1
+	case pb.EventType_BLOCK:
+		d.BLK = 1
+	case pb.EventType_TURNOVER:
+		d.TOV = 1
+	case pb.EventType_FOUL_PERSONAL, pb.EventType_FOUL_SHOOTING, pb.EventType_FOUL_OFFENSIVE, pb.EventType_FOUL_FLAGRANT:
+		// 技术犯规不计入个人犯规次数
+		d.PF = 1
+	}
+
+	if sign < 0 {
+		d.Points, d.FGM, d.FGA, d.FG3M, d.FG3A = -d.Points, -d.FGM, -d.FGA, -d.FG3M, -d.FG3A
+		d.FTM, d.FTA, d.OREB, d.DREB = -d.FTM, -d.FTA, -d.OREB, -d.DREB
+		d.AST, d.STL, d.BLK, d.TOV, d.PF = -d.AST, -d.STL, -d.BLK, -d.TOV, -d.PF
+	}
+	return d
+}
+
+// applyPlayerStats 在同一事务内累加球员单场数据, sign 为 -1 时用于冲正
+func applyPlayerStats(tx *gorm.DB, event *EventDTO, sign int) error {
+	// 1. 计数类字段: INSERT ... ON DUPLICATE KEY UPDATE col = col + VALUES(col)
+	// 暂停/单节结束等无球员事件不产生个人数据
+	if event.PlayerID != 0 {
+		delta := statDelta(event, sign)
+		updates := make(map[string]interface{}, len(counterColumns))
+		for _, col := range counterColumns {
+			updates[col] = gorm.Expr(fmt.Sprintf("%s + VALUES(%s)", col, col))
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "match_id"}, {Name: "player_id"}},
+			DoUpdates: clause.Assignments(updates),
+		}).Create(&delta).Error; err != nil {
+			return err
+		}
+	}
+
+	// 2. 换人/单节结束: 维护上场时间 (这类事件不允许冲正)
+	if sign < 0 {
+		return nil
+	}
+	return applyPlayingTime(tx, event)
+}
+
+// applyPlayingTime 根据换人/单节结束事件维护上场时间
+// 每节的首发由单节开始事件带入 (见 applyLineups), 节中上场的球员靠换人事件
+func applyPlayingTime(tx *gorm.DB, event *EventDTO) error {
+	switch event.Type {
+	case pb.EventType_SUBSTITUTION_IN, pb.EventType_SUBSTITUTION_OUT, pb.EventType_PERIOD_END:
+	default:
+		return nil
+	}
+
+	elapsed, err := gameElapsedSeconds(event.Quarter, event.TimeRemaining)
+	if err != nil {
+		return permanent(err)
+	}
+	query := tx.Model(&model.PlayerGameStats{}).Where("match_id = ?", event.MatchID)
+
+	switch event.Type {
+	case pb.EventType_SUBSTITUTION_IN:
+		return query.Where("player_id = ? AND on_court = ?", event.PlayerID, false).
+			Updates(map[string]interface{}{"on_court": true, "last_in_at": elapsed}).Error
+	case pb.EventType_SUBSTITUTION_OUT:
+		return query.Where("player_id = ? AND on_court = ?", event.PlayerID, true).
+			Updates(map[string]interface{}{
+				"on_court": false,
+				"seconds":  gorm.Expr("seconds + (? - last_in_at)", elapsed),
+			}).Error
+	default:
+		// 单节结束: 结算场上球员的时间, 仍留在场上
+		return query.Where("on_court = ?", true).
+			Updates(map[string]interface{}{
+				"seconds":    gorm.Expr("seconds + (? - last_in_at)", elapsed),
+				"last_in_at": elapsed,
+			}).Error
+	}
+}
+
+// applyLineups 单节开始: 结算仍在场上的球员 (上一节结束未上报时), 再让双方首发上场
+// 首发没有技术统计行时新建, 队伍取比赛的主客队
+func applyLineups(tx *gorm.DB, match *model.Match, event *EventDTO) error {
+	if event.Type != pb.EventType_PERIOD_START {
+		return nil
+	}
+	elapsed, err := gameElapsedSeconds(event.Quarter, event.TimeRemaining)
+	if err != nil {
+		return permanent(err)
+	}
+
+	starters := make([]model.PlayerGameStats, 0, 2*model.LineupSize)
+	for teamID, lineup := range map[uint32][]uint32{
+		uint32(match.HomeTeamID):    event.HomeLineup,
+		uint32(match.VisitorTeamID): event.VisitorLineup,
+	} {
+		for _, id := range lineup {
+			if err := checkPlayerTeam(tx, match, id, teamID); err != nil {
+				return err
+			}
+			starters = append(starters, model.PlayerGameStats{
+				MatchID: event.MatchID, PlayerID: id, TeamID: teamID, OnCourt: true, LastInAt: elapsed,
+			})
+		}
+	}
+
+	if err := tx.Model(&model.PlayerGameStats{}).
+		Where("match_id = ? AND on_court = ?", event.MatchID, true).
+		Updates(map[string]interface{}{
+			"on_court": false,
+			"seconds":  gorm.Expr("seconds + (? - last_in_at)", elapsed),
+		}).Error; err != nil {
+		return err
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "match_id"}, {Name: "player_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"on_court", "last_in_at"}),
+	}).Create(&starters).Error
+}
+
+// gameElapsedSeconds 根据节次和剩余时间("10:23")计算比赛已进行的秒数
+func gameElapsedSeconds(quarter int8, timeRemaining string) (int, error) {
+	if quarter < 1 {
+		return 0, fmt.Errorf("无效的节次: %d", quarter)
+	}
+	remaining, err := model.ParseClock(timeRemaining)
+	if err != nil {
+		return 0, err
+	}
+
+	length := int(model.PeriodDuration(quarter).Seconds())
+	elapsed := int(model.PeriodStartOffset(quarter).Seconds())
+	if remaining > length {
+		return 0, fmt.Errorf("剩余时间超出单节时长: %s", timeRemaining)
+	}
+	return elapsed + length - remaining, nil
+}
@@ -0,0 +1,172 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/model"
+)
+
+func TestStatDelta(t *testing.T) {
+	tests := []struct {
+		name  string
+		event EventDTO
+		want  model.PlayerGameStats
+	}{
+		{"三分命中", EventDTO{Type: pb.EventType_SHOT_MADE, ShotType: pb.ShotType_THREE_POINTER, Value: 3},
+			model.PlayerGameStats{Points: 3, FGM: 1, FGA: 1, FG3M: 1, FG3A: 1}},
+		{"扣篮命中", EventDTO{Type: pb.EventType_SHOT_MADE, ShotType: pb.ShotType_DUNK, Value: 2}, model.PlayerGameStats{Points: 2, FGM: 1, FGA: 1}},
+		{"三分不中", EventDTO{Type: pb.EventType_SHOT_MISSED, ShotType: pb.ShotType_THREE_POINTER}, model.PlayerGameStats{FGA: 1, FG3A: 1}},
+		{"跳投不中", EventDTO{Type: pb.EventType_SHOT_MISSED, ShotType: pb.ShotType_JUMP_SHOT}, model.PlayerGameStats{FGA: 1}},
+		{"罚球命中", EventDTO{Type: pb.EventType_FREE_THROW_MADE, ShotType: pb.ShotType_FREE_THROW, Value: 1}, model.PlayerGameStats{Points: 1, FTM: 1, FTA: 1}},
+		{"罚球不中", EventDTO{Type: pb.EventType_FREE_THROW_MISSED}, model.PlayerGameStats{FTA: 1}},
+		{"前场篮板", EventDTO{Type: pb.EventType_REBOUND_OFFENSIVE}, model.PlayerGameStats{OREB: 1}},
+		{"后场篮板", EventDTO{Type: pb.EventType_REBOUND_DEFENSIVE}, model.PlayerGameStats{DREB: 1}},
+		{"助攻", EventDTO{Type: pb.EventType_ASSIST}, model.PlayerGameStats{AST: 1}},
+		{"抢断", EventDTO{Type: pb.EventType_STEAL}, model.PlayerGameStats{STL: 1}},
+		{"盖帽", EventDTO{Type: pb.EventType_BLOCK}, model.PlayerGameStats{BLK: 1}},
+		{"失误", EventDTO{Type: pb.EventType_TURNOVER}, model.PlayerGameStats{TOV: 1}},
+		{"投篮犯规", EventDTO{Type: pb.EventType_FOUL_SHOOTING}, model.PlayerGameStats{PF: 1}},
+		{"技术犯规不计个人犯规", EventDTO{Type: pb.EventType_FOUL_TECHNICAL}, model.PlayerGameStats{}},
+		{"换人不计数", EventDTO{Type: pb.EventType_SUBSTITUTION_IN}, model.PlayerGameStats{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.event.MatchID, tt.event.PlayerID, tt.event.TeamID = 1, 2, 3
+			tt.want.MatchID, tt.want.PlayerID, tt.want.TeamID = 1, 2, 3
+			if got := statDelta(&tt.event, 1); got != tt.want {
+				t.Errorf("statDelta() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// 冲正得到的增量与原增量相反, 累加后抵消
+func TestStatDeltaReversal(t *testing.T) {
+	event := &EventDTO{MatchID: 1, PlayerID: 2, TeamID: 3, Type: pb.EventType_SHOT_MADE, ShotType: pb.ShotType_THREE_POINTER, Value: 3}
+	got := statDelta(event, -1)
+	want := model.PlayerGameStats{MatchID: 1, PlayerID: 2, TeamID: 3, Points: -3, FGM: -1, FGA: -1, FG3M: -1, FG3A: -1}
+	if got != want {
+		t.Errorf("statDelta(-1) = %+v, want %+v", got, want)
+	}
+}
+
+func TestGameElapsedSeconds(t *testing.T) {
+	tests := []struct {
+		quarter   int8
+		remaining string
+		want      int
+		wantErr   bool
+	}{
+		{1, "12:00", 0, false},
+		{1, "10:23", 97, false},
+		{2, "0:00", 1440, false},
+		{4, "0:00", 2880, false},
+		{5, "5:00", 2880, false}, // 第一个加时开始
+		{6, "2:30", 3330, false},
+		{5, "6:00", 0, true}, // 超出加时时长
+		{0, "12:00", 0, true},
+		{1, "1023", 0, true},
+		{1, "10:60", 0, true},
+		{1, "-1:00", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := gameElapsedSeconds(tt.quarter, tt.remaining)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("gameElapsedSeconds(%d, %q) err = %v, wantErr %v", tt.quarter, tt.remaining, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("gameElapsedSeconds(%d, %q) = %d, want %d", tt.quarter, tt.remaining, got, tt.want)
+		}
+	}
+}
+
+// 上场时间: 换上时记下比赛已进行的秒数, 换下时按差值累加
+func TestApplyPlayerStatsPlayingTime(t *testing.T) {
+	db, conn := newFakeDB(t)
+	in := &EventDTO{MatchID: 1, PlayerID: 2, TeamID: 3, Type: pb.EventType_SUBSTITUTION_IN, Quarter: 1, TimeRemaining: "10:00"}
+	if err := applyPlayerStats(db, in, 1); err != nil {
+		t.Fatalf("换上: %v", err)
+	}
+	if exec := conn.find("UPDATE `player_game_stats`"); exec == nil || !exec.hasArg(int64(120)) || !exec.hasArg(true) {
+		t.Errorf("换上应把 on_court 置为 true 并记录 last_in_at=120, 实际: %+v", exec)
+	}
+
+	db, conn = newFakeDB(t)
+	out := &EventDTO{MatchID: 1, PlayerID: 2, TeamID: 3, Type: pb.EventType_SUBSTITUTION_OUT, Quarter: 2, TimeRemaining: "6:00"}
+	if err := applyPlayerStats(db, out, 1); err != nil {
+		t.Fatalf("换下: %v", err)
+	}
+	if exec := conn.find("UPDATE `player_game_stats`"); exec == nil || !exec.hasArg(int64(1080)) || !exec.hasArg(false) {
+		t.Errorf("换下应按比赛已进行 1080 秒结算上场时间, 实际: %+v", exec)
+	}
+
+	// 单节结束: 结算场上球员, 从节末重新计时
+	db, conn = newFakeDB(t)
+	end := &EventDTO{MatchID: 1, Type: pb.EventType_PERIOD_END, Quarter: 1, TimeRemaining: "0:00"}
+	if err := applyPlayerStats(db, end, 1); err != nil {
+		t.Fatalf("单节结束: %v", err)
+	}
+	if len(conn.execs) != 1 || !conn.execs[0].hasArg(int64(720)) {
+		t.Errorf("单节结束应只结算场上球员的时间到 720 秒, 实际: %+v", conn.execs)
+	}
+
+	// 非换人事件只累加计数, 不维护上场时间
+	db, conn = newFakeDB(t)
+	if err := applyPlayerStats(db, &EventDTO{MatchID: 1, PlayerID: 2, TeamID: 3, Type: pb.EventType_ASSIST}, 1); err != nil {
+		t.Fatal(err)
+	}
+	if len(conn.execs) != 1 || conn.find("ON DUPLICATE KEY UPDATE") == nil {
+		t.Errorf("助攻应只有一条累加语句, 实际: %+v", conn.execs)
+	}
+
+	// 无效的比赛时间不能结算
+	db, _ = newFakeDB(t)
+	bad := &EventDTO{MatchID: 1, PlayerID: 2, TeamID: 3, Type: pb.EventType_SUBSTITUTION_IN, Quarter: 1, TimeRemaining: "bad"}
+	if err := applyPlayerStats(db, bad, 1); err == nil {
+		t.Error("无效的比赛时间应返回错误")
+	}
+}
+
+// 单节开始: 先结算仍在场上的球员, 再让双方首发从节首开始计时
+func TestApplyLineups(t *testing.T) {
+	match := &model.Match{ID: 1, HomeTeamID: 3, VisitorTeamID: 4}
+	start := &EventDTO{MatchID: 1, Type: pb.EventType_PERIOD_START, Quarter: 2, TimeRemaining: "12:00",
+		HomeLineup: []uint32{1, 2, 3, 4, 5}, VisitorLineup: []uint32{11, 12, 13, 14, 15}}
+
+	db, conn := newFakeDB(t)
+	if err := applyLineups(db, match, start); err != nil {
+		t.Fatalf("applyLineups: %v", err)
+	}
+	if len(conn.execs) != 2 {
+		t.Fatalf("应有结算和首发上场两条语句, 实际: %+v", conn.execs)
+	}
+	if settle := conn.execs[0]; !strings.HasPrefix(settle.query, "UPDATE `player_game_stats`") || !settle.hasArg(int64(720)) {
+		t.Errorf("应按节首 720 秒结算场上球员, 实际: %+v", settle)
+	}
+	insert := conn.execs[1]
+	if !strings.Contains(insert.query, "INSERT INTO `player_game_stats`") || !strings.Contains(insert.query, "ON DUPLICATE KEY UPDATE") {
+		t.Errorf("首发应以 upsert 上场, 实际: %s", insert.query)
+	}
+	for _, id := range append(start.HomeLineup, start.VisitorLineup...) {
+		if !insert.hasArg(int64(id)) {
+			t.Errorf("首发 %d 未上场", id)
+		}
+	}
+
+	// 其它事件不处理首发
+	db, conn = newFakeDB(t)
+	if err := applyLineups(db, match, &EventDTO{MatchID: 1, Type: pb.EventType_PERIOD_END, Quarter: 2, TimeRemaining: "0:00"}); err != nil || len(conn.execs) != 0 {
+		t.Errorf("单节结束不应处理首发: err=%v execs=%+v", err, conn.execs)
+	}
+
+	// 无效的比赛时间是永久错误
+	db, _ = newFakeDB(t)
+	bad := *start
+	bad.TimeRemaining = "bad"
+	if err := applyLineups(db, match, &bad); !isPermanent(err) {
+		t.Errorf("无效的比赛时间应为永久错误, 实际: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"nba-remake/internal/model"
+)
+
+// voidEvent 作废原事件: 冲正其对比分和技术统计的影响, 原记录保留并打上作废标记
+// 返回 false 表示该补偿事件已处理过 (重复投递), 调用方应跳过后续步骤
+func voidEvent(tx *gorm.DB, match *model.Match, event *EventDTO) (bool, error) {
+	if event.TargetEventID == "" || event.EventID == "" {
+		return false, permanent(fmt.Errorf("作废/更正事件缺少 event_id 或 target_event_id"))
+	}
+
+	// 1. 锁定原事件, 防止并发作废
+	var orig model.MatchEvent
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("event_id = ?", event.TargetEventID).First(&orig).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, permanent(fmt.Errorf("原事件不存在: %s", event.TargetEventID))
+		}
+		return false, err
+	}
+	if orig.MatchID != event.MatchID {
+		return false, permanent(fmt.Errorf("原事件 %s 不属于比赛 %d", event.TargetEventID, event.MatchID))
+	}
+
+	// 2. 已作废: 同一个补偿事件重复投递则忽略, 否则说明被其它操作作废过
+	if orig.Voided {
+		if orig.VoidedBy != nil && *orig.VoidedBy == event.EventID {
+			log.Printf("[Consumer] 重复的作废/更正已忽略 event_id=%s", event.EventID)
+			return false, nil
+		}
+		return false, permanent(fmt.Errorf("原事件 %s 已被作废", event.TargetEventID))
+	}
+	if !model.IsReversible(orig.Type) {
+		return false, permanent(fmt.Errorf("%s 事件不支持作废/更正", orig.Type))
+	}
+	// 与写入时同一规则: 按原事件所属的节判断, 本节结束后仍可冲正, 终场后不能再改比分
+	if model.IsScoringEvent(orig.Type) {
+		if err := match.CheckScorableAt(orig.Quarter); err != nil {
+			return false, permanent(fmt.Errorf("比赛 %d 不能修改比分: %w", event.MatchID, err))
+		}
+	}
+
+	// 3. 冲正比分和技术统计
+	reversal := dtoFromRecord(&orig)
+	if err := applyPlayerStats(tx, reversal, -1); err != nil {
+		return false, err
+	}
+	if err := applyScore(tx, match, reversal, -1); err != nil {
+		return false, err
+	}
+
+	// 4. 标记作废
+	err = tx.Model(&model.MatchEvent{}).Where("id = ?", orig.ID).Updates(map[string]interface{}{
+		"voided":      true,
+		"voided_by":   event.EventID,
+		"void_reason": event.Reason,
+	}).Error
+	return err == nil, err
+}
+
+// dtoFromRecord 由流水记录还原出事件, 用于计算冲正增量
+func dtoFromRecord(e *model.MatchEvent) *EventDTO {
+	return &EventDTO{
+		MatchID:       e.MatchID,
+		PlayerID:      e.PlayerID,
+		TeamID:        e.TeamID,
+		Type:          e.Type,
+		ShotType:      e.ShotType,
+		SubType:       e.SubType,
+		Value:         e.Value,
+		Quarter:       e.Quarter,
+		TimeRemaining: e.TimeRemaining,
+	}
+}
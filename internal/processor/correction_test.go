@@ -0,0 +1,124 @@
+package processor
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/model"
+)
+
+const (
+	origEventID = "6f1c2a7e-3b7d-4c1e-9a51-0d3f6b2c8e11"
+	voidEventID = "0b9d4f3e-8a2c-4e6f-b1d7-5c3a9e2f4d60"
+)
+
+var eventColumns = []string{"id", "event_id", "match_id", "player_id", "team_id", "type", "shot_type", "value", "quarter", "time_remaining", "voided", "voided_by"}
+
+// origThree 主队 (3) 球员 2 第一节的三分命中
+func origThree(voided bool, voidedBy driver.Value) []driver.Value {
+	return []driver.Value{int64(10), origEventID, int64(1), int64(2), int64(3),
+		int64(pb.EventType_SHOT_MADE), int64(pb.ShotType_THREE_POINTER), int64(3), int64(1), "10:00", voided, voidedBy}
+}
+
+func TestVoidEvent(t *testing.T) {
+	match := &model.Match{ID: 1, HomeTeamID: 3, VisitorTeamID: 4, Status: model.MatchStatusInProgress, Period: 2}
+	tests := []struct {
+		name          string
+		rows          [][]driver.Value
+		target        string
+		matchID       uint64
+		wantApplied   bool
+		wantPermanent bool
+	}{
+		{"冲正三分命中", [][]driver.Value{origThree(false, nil)}, origEventID, 1, true, false},
+		{"缺少原事件ID", nil, "", 1, false, true},
+		{"原事件不存在", nil, origEventID, 1, false, true},
+		{"原事件属于其它比赛", [][]driver.Value{origThree(false, nil)}, origEventID, 2, false, true},
+		{"同一补偿事件重复投递", [][]driver.Value{origThree(true, voidEventID)}, origEventID, 1, false, false},
+		{"已被其它操作作废", [][]driver.Value{origThree(true, "other")}, origEventID, 1, false, true},
+		{"换人不能作废", [][]driver.Value{{int64(10), origEventID, int64(1), int64(2), int64(3),
+			int64(pb.EventType_SUBSTITUTION_IN), int64(0), int64(0), int64(1), "10:00", false, nil}}, origEventID, 1, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, conn := newFakeDB(t)
+			conn.tables = map[string]fakeTable{"match_events": {columns: eventColumns, rows: tt.rows}}
+			event := &EventDTO{EventID: voidEventID, TargetEventID: tt.target, MatchID: tt.matchID, Action: model.EventActionVoid}
+
+			applied, err := voidEvent(db, match, event)
+			if applied != tt.wantApplied {
+				t.Errorf("voidEvent() applied = %v, want %v", applied, tt.wantApplied)
+			}
+			if isPermanent(err) != tt.wantPermanent || (err != nil && !tt.wantPermanent) {
+				t.Fatalf("voidEvent() err = %v, wantPermanent %v", err, tt.wantPermanent)
+			}
+			if !tt.wantApplied {
+				if len(conn.execs) != 0 {
+					t.Errorf("未作废时不应写库, 实际: %+v", conn.execs)
+				}
+				return
+			}
+
+			if exec := conn.find("INSERT INTO `player_game_stats`"); exec == nil || !exec.hasArg(int64(-3)) {
+				t.Errorf("应冲减球员 3 分, 实际: %+v", exec)
+			}
+			if exec := conn.find("home_score"); exec == nil || !exec.hasArg(int64(-3)) {
+				t.Errorf("应冲减主队比分 3 分, 实际: %+v", exec)
+			}
+			if exec := conn.find("`voided`"); exec == nil || !exec.hasArg(true) || !exec.hasArg(voidEventID) {
+				t.Errorf("原事件应标记为被 %s 作废, 实际: %+v", voidEventID, exec)
+			}
+		})
+	}
+}
+
+// 计分事件的冲正与写入同一规则: 按原事件所属的节判断
+func TestVoidEventMatchState(t *testing.T) {
+	tests := []struct {
+		name          string
+		match         model.Match
+		wantPermanent bool
+	}{
+		// 第二节已结束、第三节未开始时仍可冲正第一节的计分
+		{"节间冲正已打完的节", model.Match{Status: model.MatchStatusInProgress, Period: 2, PeriodEnded: true}, false},
+		// 终场后不能再改比分, 否则会推翻已确定的胜负
+		{"终场后不能冲正", model.Match{Status: model.MatchStatusFinished, Period: 4, PeriodEnded: true}, true},
+		{"比赛未开始", model.Match{Status: model.MatchStatusScheduled}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, conn := newFakeDB(t)
+			conn.tables = map[string]fakeTable{"match_events": {columns: eventColumns, rows: [][]driver.Value{origThree(false, nil)}}}
+			match := tt.match
+			match.ID, match.HomeTeamID, match.VisitorTeamID = 1, 3, 4
+			event := &EventDTO{EventID: voidEventID, TargetEventID: origEventID, MatchID: 1, Action: model.EventActionVoid}
+
+			applied, err := voidEvent(db, &match, event)
+			if tt.wantPermanent {
+				if applied || !isPermanent(err) {
+					t.Fatalf("voidEvent() = %v, %v, want 永久错误", applied, err)
+				}
+				if len(conn.execs) != 0 {
+					t.Errorf("不应写库, 实际: %+v", conn.execs)
+				}
+				return
+			}
+			if !applied || err != nil {
+				t.Fatalf("voidEvent() = %v, %v, want 冲正成功", applied, err)
+			}
+		})
+	}
+}
+
+func TestDtoFromRecord(t *testing.T) {
+	rec := &model.MatchEvent{MatchID: 1, PlayerID: 2, TeamID: 3, Type: pb.EventType_SHOT_MADE,
+		ShotType: pb.ShotType_DUNK, Value: 2, Quarter: 3, TimeRemaining: "4:05"}
+	got := dtoFromRecord(rec)
+	want := EventDTO{MatchID: 1, PlayerID: 2, TeamID: 3, Type: pb.EventType_SHOT_MADE,
+		ShotType: pb.ShotType_DUNK, Value: 2, Quarter: 3, TimeRemaining: "4:05"}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("dtoFromRecord() = %+v, want %+v", *got, want)
+	}
+}
@@ -0,0 +1,132 @@
+package processor
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeConn 只记录语句的数据库连接, 用于在没有 MySQL 时检查消费者写了哪些 SQL
+// 查询按 tables 返回预置的行, 没有预置时为空结果; 写语句的影响行数依次取 affected, 用完后为 1
+type fakeConn struct {
+	execs    []fakeExec
+	affected []int64
+	execErr  error                // 不为 nil 时所有写语句都返回该错误
+	tables   map[string]fakeTable // 表名 -> 查询结果
+}
+
+// fakeTable 查询该表时返回的行
+type fakeTable struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+// newFakeDB 基于 fakeConn 的 gorm 连接 (MySQL 方言)
+func newFakeDB(t *testing.T, affected ...int64) (*gorm.DB, *fakeConn) {
+	t.Helper()
+	conn := &fakeConn{affected: affected}
+	sqlDB := sql.OpenDB(conn)
+	sqlDB.SetMaxOpenConns(1)
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("打开假数据库失败: %v", err)
+	}
+	return db, conn
+}
+
+// find 第一条包含 keyword 的写语句
+func (c *fakeConn) find(keyword string) *fakeExec {
+	for i := range c.execs {
+		if strings.Contains(c.execs[i].query, keyword) {
+			return &c.execs[i]
+		}
+	}
+	return nil
+}
+
+// hasArg 语句参数中是否有 v (整数参数统一按 int64 比较)
+func (e *fakeExec) hasArg(v driver.Value) bool {
+	for _, arg := range e.args {
+		if arg == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("fakeConn: 不支持预编译")
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	exec := fakeExec{query: query}
+	for _, arg := range args {
+		exec.args = append(exec.args, arg.Value)
+	}
+	c.execs = append(c.execs, exec)
+	if c.execErr != nil {
+		return nil, c.execErr
+	}
+
+	n := int64(1)
+	if len(c.affected) > 0 {
+		n, c.affected = c.affected[0], c.affected[1:]
+	}
+	return fakeResult(n), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	for table, result := range c.tables {
+		if strings.Contains(query, "FROM `"+table+"`") {
+			return &fakeRows{fakeTable: result}, nil
+		}
+	}
+	return &fakeRows{}, nil
+}
+
+// fakeResult 影响行数; 自增ID 固定为 1
+type fakeResult int64
+
+func (r fakeResult) LastInsertId() (int64, error) { return 1, nil }
+func (r fakeResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeRows struct {
+	fakeTable
+	next int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
@@ -2,35 +2,78 @@ package processor
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/config"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/es"
 	"nba-remake/internal/model"
+	"nba-remake/internal/mq"
 )
 
 // EventDTO 用于接收 Kafka 消息的数据结构
 // 保持与 Producer 发送的 JSON 字段一致
 type EventDTO struct {
-	MatchID       uint64 `json:"match_id"`
-	PlayerID      uint32 `json:"player_id"`
-	TeamID        uint32 `json:"team_id"`
-	Type          int8   `json:"type"`
-	SubType       string `json:"sub_type"`
-	Value         int    `json:"value"`
-	Quarter       int8   `json:"quarter"`
-	TimeRemaining string `json:"time_remaining"`
-	EventTime     string `json:"event_time"`
+	EventID       string       `json:"event_id"`
+	MatchID       uint64       `json:"match_id"`
+	PlayerID      uint32       `json:"player_id"`
+	TeamID        uint32       `json:"team_id"`
+	Type          pb.EventType `json:"type"`
+	ShotType      pb.ShotType  `json:"shot_type"`
+	SubType       string       `json:"sub_type"`
+	Value         int          `json:"value"`
+	Quarter       int8         `json:"quarter"`
+	TimeRemaining string       `json:"time_remaining"`
+	EventTime     string       `json:"event_time"`
+
+	// 单节开始时双方的首发 (仅 PERIOD_START)
+	HomeLineup    []uint32 `json:"home_lineup,omitempty"`
+	VisitorLineup []uint32 `json:"visitor_lineup,omitempty"`
+
+	// 更正/作废 (补偿事件)
+	Action        string `json:"action,omitempty"`          // 为空表示普通事件, void: 作废, amend: 更正
+	TargetEventID string `json:"target_event_id,omitempty"` // 被作废/更正的原事件ID
+	Reason        string `json:"reason,omitempty"`          // 作废/更正原因
 }
 
 type StatsHandler struct {
-	db *gorm.DB
+	db              *gorm.DB
+	producer        *mq.Producer   // 用于投递死信
+	redisClient     *redis.Client  // 用于推送实时比分
+	cache           *cache.Store   // 提交后删除比赛缓存
+	eventIndex      *es.EventIndex // 事件搜索索引, 可为 nil
+	archiver        MatchArchiver  // 已结束比赛的重新归档, 可为 nil
+	maxRetries      int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
 }
 
-func NewStatsHandler(db *gorm.DB) *StatsHandler {
-	return &StatsHandler{db: db}
+func NewStatsHandler(db *gorm.DB, producer *mq.Producer, redisClient *redis.Client, eventIndex *es.EventIndex, archiver MatchArchiver, conf config.KafkaConfig) *StatsHandler {
+	maxRetries := conf.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+	return &StatsHandler{
+		db:              db,
+		producer:        producer,
+		redisClient:     redisClient,
+		cache:           cache.NewStore(redisClient),
+		eventIndex:      eventIndex,
+		archiver:        archiver,
+		maxRetries:      maxRetries,
+		retryBackoff:    parseDuration(conf.RetryBackoff, defaultRetryBackoff),
+		maxRetryBackoff: parseDuration(conf.MaxRetryBackoff, defaultMaxRetryBackoff),
+	}
 }
 
 // Setup 在消费者组会话开始前执行
@@ -46,10 +89,20 @@ func (h *StatsHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
 // ConsumeClaim 消费循环核心逻辑
 func (h *StatsHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for msg := range claim.Messages() {
-		// 业务处理
-		if err := h.processEvent(msg.Value); err != nil {
-			// 生产环境建议接入报警系统或写入死信队列(DLQ)
-			log.Printf("[Consumer Error] partition=%d offset=%d err=%v", msg.Partition, msg.Offset, err)
+		// 业务处理 (临时性错误会退避重试)
+		attempts, err := h.processWithRetry(session.Context(), msg.Value)
+		if err != nil {
+			if session.Context().Err() != nil {
+				// 会话结束(重平衡/停机), 不提交 offset, 由下一个消费者重新处理
+				return nil
+			}
+			log.Printf("[Consumer Error] partition=%d offset=%d attempts=%d err=%v", msg.Partition, msg.Offset, attempts, err)
+
+			// 毒消息或重试耗尽: 转入死信队列, 修复后可通过 ReplayDeadLetters 重放
+			if dlqErr := h.producer.SendDeadLetter(msg, err, attempts); dlqErr != nil {
+				// 死信也发不出去时不能提交 offset, 否则消息丢失
+				return dlqErr
+			}
 		}
 
 		// 标记消息为已处理
@@ -62,53 +115,184 @@ func (h *StatsHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 func (h *StatsHandler) processEvent(data []byte) error {
 	var event EventDTO
 	if err := json.Unmarshal(data, &event); err != nil {
-		return err
+		return permanent(err)
 	}
-
-	// 开启事务
-	return h.db.Transaction(func(tx *gorm.DB) error {
-		// 1. 写入流水表
-		newRecord := model.MatchEvent{
-			MatchID:       event.MatchID,
-			PlayerID:      event.PlayerID,
-			TeamID:        event.TeamID,
-			Type:          event.Type,
-			SubType:       event.SubType,
-			Value:         event.Value,
-			Quarter:       event.Quarter,
-			TimeRemaining: event.TimeRemaining,
-			// EventTime 还是取当前写入时间较为准确，也可解析 event.EventTime
-			EventTime: time.Now(),
+	// 作废消息只带目标事件ID, 不需要校验事件内容
+	if event.Action != model.EventActionVoid {
+		if err := model.ValidateEvent(event.Type, event.ShotType, event.Value); err != nil {
+			return permanent(err)
 		}
+		if err := model.ValidateLineups(event.Type, event.HomeLineup, event.VisitorLineup); err != nil {
+			return permanent(err)
+		}
+	}
 
-		if err := tx.Create(&newRecord).Error; err != nil {
+	// 开启事务
+	applied := false
+	var match model.Match
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		// 0. 确认比赛存在, 顺便取出主客队用于更新比分 (赛季/日期用于写搜索索引)
+		// 加行锁, 与开赛/终场等状态流转互斥
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "date", "season", "status", "period", "period_ended", "home_team_id", "visitor_team_id").
+			First(&match, event.MatchID).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return permanent(fmt.Errorf("比赛不存在: %d", event.MatchID))
+			}
 			return err
 		}
 
-		// 2. 如果是得分事件(Type=1)，更新比赛主表比分
-		// 使用 gorm.Expr 进行原子递增，防止并发覆盖
-		if event.Type == 1 && event.Value > 0 {
-			var match model.Match
-			// 仅查询 TeamID 字段用于判断主客队，减少开销
-			if err := tx.Select("id", "home_team_id", "visitor_team_id").First(&match, event.MatchID).Error; err != nil {
-				return err
+		switch event.Action {
+		case model.EventActionVoid:
+			applied, err = voidEvent(tx, &match, &event)
+		case model.EventActionAmend:
+			// 更正 = 作废原事件 + 写入更正后的事件, 在同一事务内完成
+			applied, err = voidEvent(tx, &match, &event)
+			if err == nil && applied {
+				applied, err = recordEvent(tx, &match, &event)
 			}
+		default:
+			applied, err = recordEvent(tx, &match, &event)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
 
-			if uint32(match.HomeTeamID) == event.TeamID {
-				// 更新主队得分
-				if err := tx.Model(&model.Match{}).Where("id = ?", event.MatchID).
-					UpdateColumn("home_score", gorm.Expr("home_score + ?", event.Value)).Error; err != nil {
-					return err
-				}
-			} else if uint32(match.VisitorTeamID) == event.TeamID {
-				// 更新客队得分
-				if err := tx.Model(&model.Match{}).Where("id = ?", event.MatchID).
-					UpdateColumn("visitor_score", gorm.Expr("visitor_score + ?", event.Value)).Error; err != nil {
-					return err
-				}
-			}
+	// 事务提交后再删缓存和推送, 保证读到/收到的比分已落库; 重复消息不处理
+	if applied {
+		h.invalidateMatch(event.MatchID)
+		h.publishLive(&event)
+		h.indexEvents(&match, event.EventID, event.TargetEventID)
+		h.refreshFinished(&match)
+	}
+	return nil
+}
+
+// recordEvent 写入流水并累加比分和技术统计, 返回 false 表示重复投递已忽略
+func recordEvent(tx *gorm.DB, match *model.Match, event *EventDTO) (bool, error) {
+	// 1. 写入流水表
+	newRecord := model.MatchEvent{
+		EventID:       eventIDPtr(event.EventID),
+		AmendsEventID: eventIDPtr(event.TargetEventID),
+		MatchID:       event.MatchID,
+		PlayerID:      event.PlayerID,
+		TeamID:        event.TeamID,
+		Type:          event.Type,
+		ShotType:      event.ShotType,
+		SubType:       event.SubType,
+		Value:         event.Value,
+		Quarter:       event.Quarter,
+		TimeRemaining: event.TimeRemaining,
+		// EventTime 还是取当前写入时间较为准确，也可解析 event.EventTime
+		EventTime: time.Now(),
+	}
+
+	// event_id 唯一索引冲突时不插入 (ON DUPLICATE KEY UPDATE id=id)
+	// RowsAffected 为 0 说明是重复投递, 直接跳过后续累加, 保证幂等
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&newRecord)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		log.Printf("[Consumer] 重复事件已忽略 event_id=%s", event.EventID)
+		return false, nil
+	}
+	// 计分事件按事件盖章的节次校验 (放在去重之后, 终场后重复投递的旧事件仍按重复忽略)
+	// 节结束前入队、之后才消费的事件照常计分; 终场后不再计分, 避免改变已确定的胜负
+	if model.IsScoringEvent(event.Type) {
+		if err := match.CheckScorableAt(event.Quarter); err != nil {
+			return false, permanent(fmt.Errorf("比赛 %d 不能计分: %w", event.MatchID, err))
 		}
+	}
+
+	// 球员所属球队按比赛当天的阵容校验 (赛后才补发的事件不受之后交易影响)
+	if err := checkEventTeam(tx, match, event); err != nil {
+		return false, err
+	}
 
+	// 2. 累加球员单场技术统计; 单节开始时按首发重置场上球员
+	if err := applyPlayerStats(tx, event, 1); err != nil {
+		return false, err
+	}
+	if err := applyLineups(tx, match, event); err != nil {
+		return false, err
+	}
+
+	// 3. 如果是得分事件(投篮/罚球命中)，更新比赛主表比分
+	if err := applyScore(tx, match, event, 1); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// checkEventTeam 球员事件的球队必须是参赛球队, 且球员在比赛当天属于该队 (按异动记录)
+// 没有异动记录的球员 (异动记录上线前的数据) 不校验所属球队
+func checkEventTeam(tx *gorm.DB, match *model.Match, event *EventDTO) error {
+	if event.PlayerID == 0 {
 		return nil
-	})
+	}
+	if event.TeamID != uint32(match.HomeTeamID) && event.TeamID != uint32(match.VisitorTeamID) {
+		return permanent(fmt.Errorf("球队 %d 不是比赛 %d 的参赛球队", event.TeamID, event.MatchID))
+	}
+	return checkPlayerTeam(tx, match, event.PlayerID, event.TeamID)
+}
+
+// checkPlayerTeam 球员在比赛当天属于 teamID (按异动记录)
+func checkPlayerTeam(tx *gorm.DB, match *model.Match, playerID, teamID uint32) error {
+	history, err := dao.NewTransactionDao(tx).History(playerID)
+	if err != nil {
+		return err
+	}
+	if actual, known := model.TeamOn(history, match.Date); known && actual != teamID {
+		return permanent(fmt.Errorf("球员 %d 在 %s 属于球队 %d, 与事件球队 %d 不符",
+			playerID, match.Date.Format("2006-01-02"), actual, teamID))
+	}
+	return nil
+}
+
+// applyScore 更新比赛主表比分和单节比分, sign 为 -1 时用于冲正
+// 使用 gorm.Expr 进行原子递增，防止并发覆盖
+func applyScore(tx *gorm.DB, match *model.Match, event *EventDTO, sign int) error {
+	if !model.IsScoringEvent(event.Type) || event.Value <= 0 {
+		return nil
+	}
+
+	column := ""
+	delta := model.MatchPeriodScore{MatchID: event.MatchID, Period: event.Quarter, UpdatedAt: time.Now()}
+	if uint32(match.HomeTeamID) == event.TeamID {
+		column = "home_score" // 主队得分
+		delta.HomeScore = sign * event.Value
+	} else if uint32(match.VisitorTeamID) == event.TeamID {
+		column = "visitor_score" // 客队得分
+		delta.VisitorScore = sign * event.Value
+	} else {
+		return nil
+	}
+	if err := tx.Model(&model.Match{}).Where("id = ?", event.MatchID).
+		UpdateColumn(column, gorm.Expr(column+" + ?", sign*event.Value)).Error; err != nil {
+		return err
+	}
+
+	// 单节比分: INSERT ... ON DUPLICATE KEY UPDATE col = col + VALUES(col)
+	if event.Quarter < 1 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "match_id"}, {Name: "period"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			column:       gorm.Expr(fmt.Sprintf("%s + VALUES(%s)", column, column)),
+			"updated_at": gorm.Expr("VALUES(updated_at)"),
+		}),
+	}).Create(&delta).Error
+}
+
+// eventIDPtr 空字符串存为 NULL, 兼容未携带 event_id 的旧消息
+func eventIDPtr(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
 }
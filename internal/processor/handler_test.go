@@ -0,0 +1,93 @@
+package processor
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+
+	"nba-remake/internal/config"
+	"nba-remake/internal/model"
+)
+
+const assistEvent = `{"event_id":"6f1c2a7e-3b7d-4c1e-9a51-0d3f6b2c8e11","match_id":1,"player_id":2,"team_id":3,"type":7,"quarter":1,"time_remaining":"10:00"}`
+
+// newTestHandler 比赛 1 (主队 3, 客队 4) 已存在; 不推送实时比分, 不同步搜索索引也不重新归档, 重试退避缩短到 1ms
+func newTestHandler(t *testing.T, affected ...int64) (*StatsHandler, *fakeConn) {
+	db, conn := newFakeDB(t, affected...)
+	conn.tables = map[string]fakeTable{
+		"matches": {columns: []string{"id", "home_team_id", "visitor_team_id"}, rows: [][]driver.Value{{int64(1), int64(3), int64(4)}}},
+	}
+	h := NewStatsHandler(db, nil, nil, nil, nil, config.KafkaConfig{RetryBackoff: "1ms", MaxRetryBackoff: "2ms"})
+	return h, conn
+}
+
+func TestProcessEventDedupe(t *testing.T) {
+	tests := []struct {
+		name      string
+		inserted  int64 // 写入流水表的影响行数, 0 表示 event_id 已存在
+		wantExecs int
+	}{
+		{"首次投递累加技术统计", 1, 2},
+		{"重复投递只尝试写流水", 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, conn := newTestHandler(t, tt.inserted)
+			if err := h.processEvent([]byte(assistEvent)); err != nil {
+				t.Fatalf("processEvent: %v", err)
+			}
+			if len(conn.execs) != tt.wantExecs {
+				t.Fatalf("执行了 %d 条语句, want %d: %+v", len(conn.execs), tt.wantExecs, conn.execs)
+			}
+			insert := conn.execs[0]
+			if !strings.Contains(insert.query, "INSERT INTO `match_events`") || !strings.Contains(insert.query, "ON DUPLICATE KEY UPDATE") {
+				t.Errorf("流水应以 event_id 唯一索引去重写入, 实际: %s", insert.query)
+			}
+		})
+	}
+}
+
+// 计分事件按事件盖章的节次校验: 本节结束前入队、之后才消费的事件照常计分, 终场后拒绝
+func TestProcessEventRequiresLiveMatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int64
+		period        int64
+		periodEnded   bool
+		quarter       int
+		wantPermanent bool
+	}{
+		{"进行中", model.MatchStatusInProgress, 1, false, 1, false},
+		{"未开始", model.MatchStatusScheduled, 0, false, 1, true},
+		{"本节结束前入队", model.MatchStatusInProgress, 4, true, 4, false},
+		{"终场后不再计分", model.MatchStatusFinished, 4, true, 4, true},
+		{"事件节次晚于比赛进程", model.MatchStatusInProgress, 1, false, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, conn := newTestHandler(t)
+			conn.tables["matches"] = fakeTable{
+				columns: []string{"id", "home_team_id", "visitor_team_id", "status", "period", "period_ended"},
+				rows:    [][]driver.Value{{int64(1), int64(3), int64(4), tt.status, tt.period, tt.periodEnded}},
+			}
+			shot := fmt.Sprintf(`{"event_id":"6f1c2a7e-3b7d-4c1e-9a51-0d3f6b2c8e11","match_id":1,"player_id":2,"team_id":3,"type":1,"shot_type":6,"value":3,"quarter":%d,"time_remaining":"10:00"}`, tt.quarter)
+			err := h.processEvent([]byte(shot))
+			if isPermanent(err) != tt.wantPermanent || (err != nil && !tt.wantPermanent) {
+				t.Fatalf("processEvent() err = %v, wantPermanent %v", err, tt.wantPermanent)
+			}
+			if !tt.wantPermanent && conn.find("home_score") == nil {
+				t.Error("应累加主队比分")
+			}
+		})
+	}
+}
+
+func TestEventIDPtr(t *testing.T) {
+	if eventIDPtr("") != nil {
+		t.Error("空 event_id 应存为 NULL")
+	}
+	if p := eventIDPtr("abc"); p == nil || *p != "abc" {
+		t.Errorf("eventIDPtr(abc) = %v", p)
+	}
+}
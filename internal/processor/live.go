@@ -0,0 +1,75 @@
+package processor
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/model"
+)
+
+// invalidateMatch 事务提交后删除比赛详情缓存, 下次 GetMatch 回源
+func (h *StatsHandler) invalidateMatch(matchID uint64) {
+	if h.redisClient == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	h.cache.Invalidate(ctx, cache.MatchKey(int64(matchID)))
+}
+
+// publishLive 事务提交后把最新比分和本次事件推送到 Redis
+// 推送失败不影响消费 (比分已落库, 客户端可通过 GetMatch 兜底)
+func (h *StatsHandler) publishLive(event *EventDTO) {
+	if h.redisClient == nil {
+		return
+	}
+
+	var match model.Match
+	err := h.db.Select("id", "home_score", "visitor_score", "status", "period",
+		"clock_remaining_ms", "clock_running", "clock_started_at").First(&match, event.MatchID).Error
+	if err != nil {
+		log.Printf("[Live] 查询比分失败 match_id=%d err=%v", event.MatchID, err)
+		return
+	}
+
+	now := time.Now()
+	update := &pb.MatchUpdate{
+		MatchId:       int64(match.ID),
+		HomeScore:     int32(match.HomeScore),
+		VisitorScore:  int32(match.VisitorScore),
+		Status:        int32(match.Status),
+		Quarter:       int32(event.Quarter),
+		TimeRemaining: event.TimeRemaining,
+		LatestPlay: &pb.PlayByPlay{
+			EventId:       event.EventID,
+			PlayerId:      int32(event.PlayerID),
+			TeamId:        int32(event.TeamID),
+			Type:          event.Type,
+			ShotType:      event.ShotType,
+			SubType:       event.SubType,
+			Value:         int32(event.Value),
+			Quarter:       int32(event.Quarter),
+			TimeRemaining: event.TimeRemaining,
+			Action:        event.Action,
+			TargetEventId: event.TargetEventID,
+		},
+		UpdatedAt: now.Format(time.RFC3339),
+	}
+	// 节次和时钟以权威时钟为准 (事件可能是补录的)
+	if match.Period > 0 {
+		remaining := match.ClockRemaining(now)
+		update.Quarter = int32(match.Period)
+		update.TimeRemaining = model.FormatClock(remaining)
+		update.ClockRunning = match.ClockRunning
+		update.ClockRemainingMs = remaining.Milliseconds()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := cache.PublishMatchUpdate(ctx, h.redisClient, update); err != nil {
+		log.Printf("[Live] 推送失败 match_id=%d err=%v", event.MatchID, err)
+	}
+}
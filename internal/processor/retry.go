@@ -0,0 +1,63 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	defaultMaxRetries      = 3
+	defaultRetryBackoff    = 200 * time.Millisecond
+	defaultMaxRetryBackoff = 5 * time.Second
+)
+
+// permanentError 不可恢复的错误 (毒消息), 重试无意义, 直接进死信队列
+// 例如: JSON 解析失败, 事件数据不一致, 比赛不存在
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+func isPermanent(err error) bool {
+	var pe *permanentError
+	return errors.As(err, &pe)
+}
+
+// processWithRetry 对临时性错误做有限次数的指数退避重试
+// 返回实际尝试次数和最后一次的错误
+func (h *StatsHandler) processWithRetry(ctx context.Context, data []byte) (int, error) {
+	backoff := h.retryBackoff
+	attempts := 0
+	for {
+		attempts++
+		err := h.processEvent(data)
+		if err == nil || isPermanent(err) || attempts > h.maxRetries {
+			return attempts, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempts, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > h.maxRetryBackoff {
+			backoff = h.maxRetryBackoff
+		}
+	}
+}
+
+// parseDuration 解析配置里的时长字符串, 为空或非法时使用默认值
+func parseDuration(s string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
@@ -0,0 +1,95 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestIsPermanent(t *testing.T) {
+	base := errors.New("boom")
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"普通错误可重试", base, false},
+		{"毒消息", permanent(base), true},
+		{"包装后的毒消息", fmt.Errorf("处理失败: %w", permanent(base)), true},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		if got := isPermanent(tt.err); got != tt.want {
+			t.Errorf("%s: isPermanent() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProcessWithRetry(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		execErr       error
+		noMatch       bool
+		wantAttempts  int
+		wantErr       bool
+		wantPermanent bool
+	}{
+		{name: "成功", data: assistEvent, wantAttempts: 1},
+		{name: "JSON 无法解析", data: `{"match_id":`, wantAttempts: 1, wantErr: true, wantPermanent: true},
+		{name: "事件数据不一致", data: `{"match_id":1,"player_id":2,"team_id":3,"type":1,"shot_type":6,"value":2}`,
+			wantAttempts: 1, wantErr: true, wantPermanent: true},
+		{name: "比赛不存在", data: assistEvent, noMatch: true, wantAttempts: 1, wantErr: true, wantPermanent: true},
+		{name: "数据库临时故障重试到上限", data: assistEvent, execErr: errors.New("connection reset"),
+			wantAttempts: defaultMaxRetries + 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, conn := newTestHandler(t)
+			conn.execErr = tt.execErr
+			if tt.noMatch {
+				conn.tables = nil
+			}
+			attempts, err := h.processWithRetry(context.Background(), []byte(tt.data))
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if (err != nil) != tt.wantErr || isPermanent(err) != tt.wantPermanent {
+				t.Errorf("err = %v, wantErr %v, wantPermanent %v", err, tt.wantErr, tt.wantPermanent)
+			}
+		})
+	}
+}
+
+// 会话结束时不再等待退避, 返回 ctx 的错误 (调用方据此不提交 offset)
+func TestProcessWithRetryCanceled(t *testing.T) {
+	h, conn := newTestHandler(t)
+	conn.execErr = errors.New("connection reset")
+	h.retryBackoff = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts, err := h.processWithRetry(ctx, []byte(assistEvent))
+	if attempts != 1 || !errors.Is(err, context.Canceled) {
+		t.Errorf("processWithRetry() = %d, %v, want 1, context.Canceled", attempts, err)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"500ms", 500 * time.Millisecond},
+		{"", time.Second},
+		{"abc", time.Second},
+		{"-1s", time.Second},
+	}
+	for _, tt := range tests {
+		if got := parseDuration(tt.in, time.Second); got != tt.want {
+			t.Errorf("parseDuration(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package processor
+
+import (
+	"log"
+
+	"nba-remake/internal/model"
+)
+
+// indexEvents 事务提交后把本次写入/作废的事件同步到搜索索引
+// 以 MySQL 为准, 同步失败只记录日志 (可通过重建索引修复)
+func (h *StatsHandler) indexEvents(match *model.Match, eventIDs ...string) {
+	if h.eventIndex == nil {
+		return
+	}
+
+	ids := make([]string, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	var events []*model.MatchEvent
+	if err := h.db.Where("event_id IN ?", ids).Find(&events).Error; err != nil {
+		log.Printf("[Search] 查询事件失败 event_ids=%v err=%v", ids, err)
+		return
+	}
+	if err := h.eventIndex.BulkIndex(events, map[uint64]*model.Match{match.ID: match}); err != nil {
+		log.Printf("[Search] 写入索引失败 event_ids=%v err=%v", ids, err)
+	}
+}
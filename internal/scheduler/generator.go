@@ -0,0 +1,477 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// Team 参与排程的球队
+// Division 为空时只按东西部区分对手; Arena 为空时视为独占主场
+type Team struct {
+	ID           uint32
+	Abbreviation string
+	Conference   string
+	Division     string
+	Arena        string
+}
+
+// Config 排程参数, 零值字段使用默认值 (见 normalize)
+type Config struct {
+	StartDate    time.Time
+	EndDate      time.Time // 含当天
+	GamesPerTeam int
+
+	// 对手权重 (相对值): 同赛区 / 同联盟其他球队 / 另一联盟球队
+	// 每队与某个对手的场次按 权重/该队全部对手权重之和 分配
+	DivisionWeight   float64
+	ConferenceWeight float64
+	OtherWeight      float64
+
+	MaxGamesPerDay int                        // 单日比赛场次上限
+	Blackouts      map[string]map[string]bool // 场馆 -> 不可用日期 (YYYY-MM-DD)
+	Seed           int64                      // 随机种子, 相同输入和种子生成相同赛程
+}
+
+// 默认值: 82 场常规赛, 赛程约 25 周
+const (
+	DefaultGamesPerTeam     = 82
+	DefaultSeasonDays       = 175
+	DefaultDivisionWeight   = 4
+	DefaultConferenceWeight = 3
+	DefaultOtherWeight      = 2
+)
+
+// Game 一场比赛 (主队 Home)
+type Game struct {
+	Date    time.Time
+	Home    uint32
+	Visitor uint32
+}
+
+// Result 生成结果, Unscheduled 为在赛季窗口内排不下的比赛
+type Result struct {
+	Games       []Game
+	Unscheduled []Game
+	Report      *Report
+}
+
+func (c *Config) normalize(teams int) {
+	if c.GamesPerTeam <= 0 {
+		c.GamesPerTeam = DefaultGamesPerTeam
+	}
+	if c.EndDate.IsZero() {
+		c.EndDate = c.StartDate.AddDate(0, 0, DefaultSeasonDays-1)
+	}
+	if c.DivisionWeight <= 0 {
+		c.DivisionWeight = DefaultDivisionWeight
+	}
+	if c.ConferenceWeight <= 0 {
+		c.ConferenceWeight = DefaultConferenceWeight
+	}
+	if c.OtherWeight <= 0 {
+		c.OtherWeight = DefaultOtherWeight
+	}
+	if c.MaxGamesPerDay <= 0 {
+		c.MaxGamesPerDay = teams / 2
+	}
+}
+
+// Generate 生成整个常规赛赛程
+//  1. 按对手权重确定每对球队的交手场次, 使每队总场次等于 GamesPerTeam
+//  2. 分配主客场, 使每队主客场数相差不超过 1
+//  3. 逐日排入比赛: 每队每天最多一场, 不出现连续三天比赛, 同一场馆每天最多一场且避开不可用日期
+//
+// 参数本身不合法时返回 error; 排不下的比赛放在 Unscheduled 并记入报告
+func Generate(teams []Team, conf Config) (*Result, error) {
+	if len(teams) < 2 {
+		return nil, fmt.Errorf("至少需要 2 支球队")
+	}
+	conf.normalize(len(teams))
+	if conf.EndDate.Before(conf.StartDate) {
+		return nil, fmt.Errorf("结束日期早于开始日期")
+	}
+	if conf.GamesPerTeam*len(teams)%2 != 0 {
+		return nil, fmt.Errorf("球队数 × 每队场次必须为偶数: %d × %d", len(teams), conf.GamesPerTeam)
+	}
+	days := daysBetween(conf.StartDate, conf.EndDate) + 1
+	if maxGames := days - days/3; conf.GamesPerTeam > maxGames {
+		return nil, fmt.Errorf("赛季共 %d 天, 不连续三天比赛时每队最多 %d 场", days, maxGames)
+	}
+
+	rng := rand.New(rand.NewSource(conf.Seed))
+	g := &generator{teams: teams, conf: conf, rng: rng}
+	g.pairCounts()
+	games := g.assignHome()
+	scheduled, unscheduled := g.assignDates(games)
+
+	return &Result{
+		Games:       scheduled,
+		Unscheduled: unscheduled,
+		Report:      Check(teams, conf, scheduled, len(unscheduled)),
+	}, nil
+}
+
+type generator struct {
+	teams  []Team
+	conf   Config
+	rng    *rand.Rand
+	count  [][]int     // count[i][j] 交手场次
+	wanted [][]float64 // 按权重计算的理想场次
+}
+
+// Category 对手类别
+type Category int
+
+const (
+	SameDivision Category = iota
+	SameConference
+	OtherConference
+)
+
+// CategoryOf 两队的对手类别
+func CategoryOf(a, b *Team) Category {
+	switch {
+	case a.Conference != b.Conference:
+		return OtherConference
+	case a.Division != "" && a.Division == b.Division:
+		return SameDivision
+	default:
+		return SameConference
+	}
+}
+
+func (g *generator) weight(i, j int) float64 {
+	switch CategoryOf(&g.teams[i], &g.teams[j]) {
+	case SameDivision:
+		return g.conf.DivisionWeight
+	case SameConference:
+		return g.conf.ConferenceWeight
+	default:
+		return g.conf.OtherWeight
+	}
+}
+
+// pairCounts 确定每对球队的交手场次
+func (g *generator) pairCounts() {
+	n, total := len(g.teams), g.conf.GamesPerTeam
+	sum := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j {
+				sum[i] += g.weight(i, j)
+			}
+		}
+	}
+
+	g.count = make([][]int, n)
+	g.wanted = make([][]float64, n)
+	for i := range g.count {
+		g.count[i] = make([]int, n)
+		g.wanted[i] = make([]float64, n)
+	}
+	deg := make([]int, n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			w := g.weight(i, j)
+			want := (float64(total)*w/sum[i] + float64(total)*w/sum[j]) / 2
+			g.wanted[i][j], g.wanted[j][i] = want, want
+			g.add(i, j, int(math.Floor(want)), deg)
+		}
+	}
+
+	// 取整后可能超出: 从超出最多的交手中扣减
+	for i := 0; i < n; i++ {
+		for deg[i] > total {
+			j := g.mostOver(i, deg, total)
+			g.add(i, j, -1, deg)
+		}
+	}
+
+	// 按小数部分从大到小补足场次
+	type pair struct {
+		i, j int
+		frac float64
+	}
+	var pairs []pair
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			pairs = append(pairs, pair{i, j, g.wanted[i][j] - float64(g.count[i][j])})
+		}
+	}
+	g.rng.Shuffle(len(pairs), func(a, b int) { pairs[a], pairs[b] = pairs[b], pairs[a] })
+	sort.SliceStable(pairs, func(a, b int) bool { return pairs[a].frac > pairs[b].frac })
+	for _, p := range pairs {
+		if deg[p.i] < total && deg[p.j] < total {
+			g.add(p.i, p.j, 1, deg)
+		}
+	}
+	for {
+		a := -1
+		for i := 0; i < n; i++ {
+			if deg[i] < total {
+				a = i
+				break
+			}
+		}
+		if a < 0 {
+			return
+		}
+		if b := g.otherDeficit(a, deg, total); b >= 0 {
+			g.add(a, b, 1, deg)
+			continue
+		}
+		// 只剩一队差至少 2 场: 拆开一组交手 c-d, 改为 a-c 和 a-d
+		c, d := g.bestSwap(a)
+		if c < 0 {
+			return
+		}
+		g.add(c, d, -1, deg)
+		g.add(a, c, 1, deg)
+		g.add(a, d, 1, deg)
+	}
+}
+
+func (g *generator) add(i, j, k int, deg []int) {
+	g.count[i][j] += k
+	g.count[j][i] += k
+	deg[i] += k
+	deg[j] += k
+}
+
+func (g *generator) mostOver(i int, deg []int, total int) int {
+	best, bestScore := -1, math.Inf(-1)
+	for j := range g.teams {
+		if j == i || g.count[i][j] == 0 {
+			continue
+		}
+		score := float64(g.count[i][j]) - g.wanted[i][j]
+		if deg[j] > total {
+			score += 1
+		}
+		if score > bestScore {
+			best, bestScore = j, score
+		}
+	}
+	return best
+}
+
+// otherDeficit 另一支场次不足的球队, 优先选交手场次低于理想值最多的
+func (g *generator) otherDeficit(a int, deg []int, total int) int {
+	best, bestScore := -1, math.Inf(-1)
+	for b := range g.teams {
+		if b == a || deg[b] >= total {
+			continue
+		}
+		if score := g.wanted[a][b] - float64(g.count[a][b]); score > bestScore {
+			best, bestScore = b, score
+		}
+	}
+	return best
+}
+
+func (g *generator) bestSwap(a int) (int, int) {
+	bestC, bestD, bestScore := -1, -1, math.Inf(-1)
+	for c := range g.teams {
+		for d := c + 1; d < len(g.teams); d++ {
+			if c == a || d == a || g.count[c][d] == 0 {
+				continue
+			}
+			score := float64(g.count[c][d]) - g.wanted[c][d] +
+				g.wanted[a][c] - float64(g.count[a][c]) +
+				g.wanted[a][d] - float64(g.count[a][d])
+			if score > bestScore {
+				bestC, bestD, bestScore = c, d, score
+			}
+		}
+	}
+	return bestC, bestD
+}
+
+// assignHome 分配主客场: 偶数场各占一半, 奇数场多出的一场给主场数较少的一方, 之后局部调整
+func (g *generator) assignHome() []Game {
+	n := len(g.teams)
+	balance := make([]int, n) // 主场数 - 客场数
+	var odds []*odd
+	var games []Game
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			k := g.count[i][j]
+			for x := 0; x < k/2; x++ {
+				games = append(games,
+					Game{Home: g.teams[i].ID, Visitor: g.teams[j].ID},
+					Game{Home: g.teams[j].ID, Visitor: g.teams[i].ID})
+			}
+			if k%2 == 1 {
+				odds = append(odds, &odd{i, j})
+			}
+		}
+	}
+
+	g.rng.Shuffle(len(odds), func(a, b int) { odds[a], odds[b] = odds[b], odds[a] })
+	for _, o := range odds {
+		if balance[o.home] > balance[o.away] {
+			o.home, o.away = o.away, o.home
+		}
+		balance[o.home]++
+		balance[o.away]--
+	}
+	// 仍有球队主场多出 2 场及以上时, 沿 "主队 -> 客队" 的奇数场路径整体翻转,
+	// 路径起点主场减 1、终点主场加 1, 中间球队不变; 找不到可改进的路径为止
+	for {
+		flipped := false
+		for src := range balance {
+			if balance[src] < 2 {
+				continue
+			}
+			if path := flipPath(src, len(balance), odds, balance); path != nil {
+				for _, e := range path {
+					o := odds[e]
+					o.home, o.away = o.away, o.home
+				}
+				balance[src] -= 2
+				balance[odds[path[len(path)-1]].home] += 2
+				flipped = true
+			}
+		}
+		if !flipped {
+			break
+		}
+	}
+	for _, o := range odds {
+		games = append(games, Game{Home: g.teams[o.home].ID, Visitor: g.teams[o.away].ID})
+	}
+	g.rng.Shuffle(len(games), func(a, b int) { games[a], games[b] = games[b], games[a] })
+	return games
+}
+
+// odd 奇数场交手中多出的那一场
+type odd struct{ home, away int }
+
+// flipPath 从 src 出发沿奇数场 (主队 -> 客队) 广度优先搜索主场偏少的球队, 返回路径上的奇数场下标
+func flipPath(src, n int, odds []*odd, balance []int) []int {
+	out := make([][]int, n)
+	for e, o := range odds {
+		out[o.home] = append(out[o.home], e)
+	}
+	prev := make([]int, n)
+	for i := range prev {
+		prev[i] = -1
+	}
+	visited := make([]bool, n)
+	visited[src] = true
+	queue := []int{src}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, e := range out[node] {
+			next := odds[e].away
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			prev[next] = e
+			if balance[next] < 0 {
+				var path []int
+				for v := next; v != src; v = odds[prev[v]].home {
+					path = append([]int{prev[v]}, path...)
+				}
+				return path
+			}
+			queue = append(queue, next)
+		}
+	}
+	return nil
+}
+
+// backToBackPenalty 前一天刚比赛的球队排序时扣减的优先级, 用于减少背靠背
+const backToBackPenalty = 8
+
+// assignDates 逐日排入比赛, 每天按剩余场次多的球队优先 (刚比赛过的靠后), 使各队进度均匀
+func (g *generator) assignDates(pool []Game) ([]Game, []Game) {
+	arena := make(map[uint32]string, len(g.teams))
+	remaining := make(map[uint32]int, len(g.teams))
+	for _, t := range g.teams {
+		arena[t.ID] = t.Arena
+		if t.Arena == "" {
+			arena[t.ID] = fmt.Sprintf("team:%d", t.ID)
+		}
+	}
+	for _, game := range pool {
+		remaining[game.Home]++
+		remaining[game.Visitor]++
+	}
+
+	// history 最近两个比赛日, 用于判断连续三天
+	type history struct{ prev, prev2 time.Time }
+	played := make(map[uint32]*history, len(g.teams))
+	for _, t := range g.teams {
+		played[t.ID] = &history{}
+	}
+	thirdInRow := func(team uint32, day time.Time) bool {
+		h := played[team]
+		return h.prev.Equal(day.AddDate(0, 0, -1)) && h.prev2.Equal(day.AddDate(0, 0, -2))
+	}
+
+	days := daysBetween(g.conf.StartDate, g.conf.EndDate) + 1
+	scheduled := make([]Game, 0, len(pool))
+	for k := 0; k < days && len(pool) > 0; k++ {
+		day := g.conf.StartDate.AddDate(0, 0, k)
+		date := day.Format("2006-01-02")
+		quota := (len(pool) + days - k - 1) / (days - k)
+		if quota > g.conf.MaxGamesPerDay {
+			quota = g.conf.MaxGamesPerDay
+		}
+
+		yesterday := day.AddDate(0, 0, -1)
+		priority := func(game Game) int {
+			p := remaining[game.Home] + remaining[game.Visitor]
+			for _, team := range []uint32{game.Home, game.Visitor} {
+				if played[team].prev.Equal(yesterday) {
+					p -= backToBackPenalty
+				}
+			}
+			return p
+		}
+		sort.SliceStable(pool, func(a, b int) bool { return priority(pool[a]) > priority(pool[b]) })
+		busy := make(map[uint32]bool)
+		hosting := make(map[string]bool)
+		rest := pool[:0]
+		picked := 0
+		for _, game := range pool {
+			venue := arena[game.Home]
+			ok := picked < quota &&
+				!busy[game.Home] && !busy[game.Visitor] &&
+				!thirdInRow(game.Home, day) && !thirdInRow(game.Visitor, day) &&
+				!hosting[venue] && !g.conf.Blackouts[venue][date]
+			if !ok {
+				rest = append(rest, game)
+				continue
+			}
+			busy[game.Home], busy[game.Visitor] = true, true
+			hosting[venue] = true
+			game.Date = day
+			scheduled = append(scheduled, game)
+			picked++
+		}
+		pool = rest
+		for team := range busy {
+			h := played[team]
+			h.prev2, h.prev = h.prev, day
+		}
+		for _, game := range scheduled[len(scheduled)-picked:] {
+			remaining[game.Home]--
+			remaining[game.Visitor]--
+		}
+	}
+	return scheduled, pool
+}
+
+// daysBetween 两个日期相差的天数 (按日历日, 不受夏令时影响)
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
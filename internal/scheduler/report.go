@@ -0,0 +1,168 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// 约束名称 (Violation.Constraint)
+const (
+	ConstraintGameCount    = "game_count"           // 每队场次不等于 GamesPerTeam
+	ConstraintHomeAway     = "home_away"            // 主客场数相差超过 1
+	ConstraintThreeInARow  = "back_to_back_to_back" // 连续三天比赛
+	ConstraintDoubleBooked = "double_booked"        // 同一天两场
+	ConstraintArena        = "arena"                // 场馆同一天两场或不可用
+	ConstraintDateRange    = "date_range"           // 比赛日期不在赛季窗口内
+	ConstraintUnscheduled  = "unscheduled"          // 窗口内排不下的比赛
+)
+
+// Violation 违反的约束, TeamID 为 0 表示与具体球队无关
+type Violation struct {
+	Constraint string
+	TeamID     uint32
+	Date       string
+	Message    string
+}
+
+// TeamSummary 单队赛程统计
+type TeamSummary struct {
+	TeamID          uint32
+	Abbreviation    string
+	Games           int
+	Home            int
+	Away            int
+	DivisionGames   int
+	ConferenceGames int // 同联盟非同赛区
+	OtherGames      int // 另一联盟
+	BackToBacks     int // 背靠背次数
+}
+
+// Report 约束检查报告
+type Report struct {
+	TotalGames int
+	DaysUsed   int
+	FirstDate  time.Time
+	LastDate   time.Time
+	Teams      []TeamSummary
+	Violations []Violation
+}
+
+// OK 没有违反任何约束
+func (r *Report) OK() bool {
+	return len(r.Violations) == 0
+}
+
+// Check 按 conf 的约束检查一份赛程 (生成结果或人工调整后的赛程都可以)
+// conf 需已填好默认值; unscheduled 为未排入的场次数
+func Check(teams []Team, conf Config, games []Game, unscheduled int) *Report {
+	r := &Report{TotalGames: len(games)}
+	byID := make(map[uint32]*Team, len(teams))
+	summary := make(map[uint32]*TeamSummary, len(teams))
+	for i := range teams {
+		t := &teams[i]
+		byID[t.ID] = t
+		summary[t.ID] = &TeamSummary{TeamID: t.ID, Abbreviation: t.Abbreviation}
+	}
+	violate := func(constraint string, teamID uint32, date, format string, args ...interface{}) {
+		r.Violations = append(r.Violations, Violation{Constraint: constraint, TeamID: teamID, Date: date, Message: fmt.Sprintf(format, args...)})
+	}
+
+	dates := make(map[uint32]map[string]bool, len(teams))
+	venues := make(map[string]map[string]bool)
+	days := make(map[string]bool)
+	for _, game := range games {
+		date := game.Date.Format("2006-01-02")
+		days[date] = true
+		if r.FirstDate.IsZero() || game.Date.Before(r.FirstDate) {
+			r.FirstDate = game.Date
+		}
+		if game.Date.After(r.LastDate) {
+			r.LastDate = game.Date
+		}
+		if game.Date.Before(conf.StartDate) || game.Date.After(conf.EndDate) {
+			violate(ConstraintDateRange, 0, date, "比赛日期不在赛季窗口内")
+		}
+
+		home, visitor := byID[game.Home], byID[game.Visitor]
+		if home == nil || visitor == nil {
+			continue
+		}
+		summary[home.ID].Home++
+		summary[visitor.ID].Away++
+		for _, t := range []*Team{home, visitor} {
+			s := summary[t.ID]
+			s.Games++
+			switch CategoryOf(home, visitor) {
+			case SameDivision:
+				s.DivisionGames++
+			case SameConference:
+				s.ConferenceGames++
+			default:
+				s.OtherGames++
+			}
+			if dates[t.ID] == nil {
+				dates[t.ID] = make(map[string]bool)
+			}
+			if dates[t.ID][date] {
+				violate(ConstraintDoubleBooked, t.ID, date, "%s 同一天有两场比赛", t.Abbreviation)
+			}
+			dates[t.ID][date] = true
+		}
+
+		venue := home.Arena
+		if venue == "" {
+			venue = fmt.Sprintf("team:%d", home.ID)
+		}
+		if venues[venue] == nil {
+			venues[venue] = make(map[string]bool)
+		}
+		if venues[venue][date] {
+			violate(ConstraintArena, home.ID, date, "场馆 %s 同一天有两场比赛", venue)
+		}
+		if conf.Blackouts[venue][date] {
+			violate(ConstraintArena, home.ID, date, "场馆 %s 当天不可用", venue)
+		}
+		venues[venue][date] = true
+	}
+	r.DaysUsed = len(days)
+
+	for _, t := range teams {
+		s := summary[t.ID]
+		if s.Games != conf.GamesPerTeam {
+			violate(ConstraintGameCount, t.ID, "", "%s 共 %d 场, 应为 %d 场", t.Abbreviation, s.Games, conf.GamesPerTeam)
+		}
+		if diff := s.Home - s.Away; diff > 1 || diff < -1 {
+			violate(ConstraintHomeAway, t.ID, "", "%s 主场 %d 场, 客场 %d 场", t.Abbreviation, s.Home, s.Away)
+		}
+
+		played := make([]string, 0, len(dates[t.ID]))
+		for date := range dates[t.ID] {
+			played = append(played, date)
+		}
+		sort.Strings(played)
+		streak := 1
+		for i := 1; i < len(played); i++ {
+			if nextDay(played[i-1]) != played[i] {
+				streak = 1
+				continue
+			}
+			streak++
+			s.BackToBacks++
+			if streak == 3 {
+				violate(ConstraintThreeInARow, t.ID, played[i], "%s 连续三天比赛", t.Abbreviation)
+			}
+		}
+		r.Teams = append(r.Teams, *s)
+	}
+
+	if unscheduled > 0 {
+		violate(ConstraintUnscheduled, 0, "", "赛季窗口内有 %d 场比赛无法排入", unscheduled)
+	}
+	return r
+}
+
+func nextDay(date string) string {
+	d, _ := time.Parse("2006-01-02", date)
+	return d.AddDate(0, 0, 1).Format("2006-01-02")
+}
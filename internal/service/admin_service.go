@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "nba-remake/api/proto/v1"
+)
+
+// ReplayDeadLetters 重放死信队列
+// 事件带 event_id, 消费端幂等, 重复重放不会重复计分
+func (s *NBAService) ReplayDeadLetters(ctx context.Context, req *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit 不能为负数")
+	}
+
+	replayed, remaining, err := s.dlqReplayer.Replay(ctx, int(req.Limit))
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "重放死信失败(已重放 %d 条): %v", replayed, err)
+	}
+	return &pb.ReplayDeadLettersResponse{
+		Replayed:  int32(replayed),
+		Remaining: remaining,
+	}, nil
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/model"
+	"nba-remake/internal/mongodb"
+)
+
+// GetGameArchive 查询已结束比赛的完整归档, 只读 MongoDB
+func (s *NBAService) GetGameArchive(ctx context.Context, req *pb.GetMatchRequest) (*pb.GameArchiveResponse, error) {
+	doc, err := s.gameStore.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "比赛归档不存在")
+		}
+		return nil, status.Error(codes.Unavailable, "查询归档失败")
+	}
+	return convertGameDocToProto(doc), nil
+}
+
+// ArchiveMatch 生成/重新生成已结束比赛的归档 (补录或修复历史比赛用)
+func (s *NBAService) ArchiveMatch(ctx context.Context, req *pb.GetMatchRequest) (*pb.GameArchiveResponse, error) {
+	doc, err := s.archiveMatch(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return convertGameDocToProto(doc), nil
+}
+
+// RearchiveMatch 终场后补写/更正的事件落库后重新归档 (由消费者调用)
+func (s *NBAService) RearchiveMatch(ctx context.Context, matchID int64) error {
+	_, err := s.archiveMatch(ctx, matchID)
+	return err
+}
+
+// archiveMatch 从 MySQL 汇总整场比赛 (球队/比分/单节比分/技术统计/完整 play-by-play) 写入 games 集合
+// 只归档已结束的比赛; 重复归档会整体覆盖
+func (s *NBAService) archiveMatch(ctx context.Context, matchID int64) (*mongodb.GameDoc, error) {
+	match, err := s.matchDao.GetByID(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "比赛未找到")
+		}
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
+	}
+	if match.Status != model.MatchStatusFinished {
+		return nil, status.Error(codes.FailedPrecondition, "比赛尚未结束, 不能归档")
+	}
+
+	box, err := s.buildBoxScore(match)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.matchDao.ListEvents(matchID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询比赛事件失败: "+err.Error())
+	}
+
+	plays, periods := buildPlays(match, events)
+	if len(match.PeriodScores) > 0 {
+		// 以消费者维护的单节比分为准
+		periods = periods[:0]
+		for _, ps := range convertPeriodScores(match) {
+			periods = append(periods, mongodb.PeriodScore{
+				Period:  int(ps.Period),
+				Home:    int(ps.HomeScore),
+				Visitor: int(ps.VisitorScore),
+			})
+		}
+	}
+	doc := &mongodb.GameDoc{
+		MatchID:      int64(match.ID),
+		Season:       match.Season,
+		Date:         match.Date.Format("2006-01-02"),
+		StartTime:    match.StartTime,
+		Status:       match.Status,
+		Home:         newGameTeam(&match.HomeTeam, match.HomeScore, box.Home),
+		Visitor:      newGameTeam(&match.VisitorTeam, match.VisitorScore, box.Visitor),
+		PeriodScores: periods,
+		Plays:        plays,
+		ArchivedAt:   time.Now(),
+	}
+	if err := s.gameStore.Save(ctx, doc); err != nil {
+		return nil, status.Error(codes.Unavailable, "写入归档失败: "+err.Error())
+	}
+	log.Printf("[Archive] 比赛已归档 match_id=%d plays=%d", matchID, len(plays))
+	return doc, nil
+}
+
+// buildPlays 按比赛进程 (节次升序, 剩余时间降序, 同一时刻按写入顺序) 排列事件,
+// 同时计算每个事件之后的比分和单节比分; 作废事件保留但不计分
+func buildPlays(match *model.Match, events []*model.MatchEvent) ([]mongodb.Play, []mongodb.PeriodScore) {
+	remaining := make(map[uint64]int, len(events))
+	for _, e := range events {
+		secs, err := model.ParseClock(e.TimeRemaining)
+		if err != nil {
+			secs = -1 // 时间缺失的排在该节最后
+		}
+		remaining[e.ID] = secs
+	}
+	sorted := make([]*model.MatchEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Quarter != sorted[j].Quarter {
+			return sorted[i].Quarter < sorted[j].Quarter
+		}
+		return remaining[sorted[i].ID] > remaining[sorted[j].ID]
+	})
+
+	periods := make([]mongodb.PeriodScore, 4) // 常规时间 4 节, 加时按需追加
+	for i := range periods {
+		periods[i].Period = i + 1
+	}
+	plays := make([]mongodb.Play, 0, len(sorted))
+	homeScore, visitorScore := 0, 0
+	for i, e := range sorted {
+		if !e.Voided && model.IsScoringEvent(e.Type) && e.Quarter > 0 {
+			for len(periods) < int(e.Quarter) {
+				periods = append(periods, mongodb.PeriodScore{Period: len(periods) + 1})
+			}
+			period := &periods[e.Quarter-1]
+			switch uint(e.TeamID) {
+			case match.HomeTeamID:
+				homeScore += e.Value
+				period.Home += e.Value
+			case match.VisitorTeamID:
+				visitorScore += e.Value
+				period.Visitor += e.Value
+			}
+		}
+
+		play := mongodb.Play{
+			Seq:           i + 1,
+			PlayerID:      e.PlayerID,
+			TeamID:        e.TeamID,
+			Type:          e.Type.String(),
+			ShotType:      e.ShotType.String(),
+			SubType:       e.SubType,
+			Value:         e.Value,
+			Quarter:       int(e.Quarter),
+			TimeRemaining: e.TimeRemaining,
+			EventTime:     e.EventTime,
+			Voided:        e.Voided,
+			HomeScore:     homeScore,
+			VisitorScore:  visitorScore,
+		}
+		if e.EventID != nil {
+			play.EventID = *e.EventID
+		}
+		if e.AmendsEventID != nil {
+			play.AmendsEventID = *e.AmendsEventID
+		}
+		plays = append(plays, play)
+	}
+	return plays, periods
+}
+
+func newGameTeam(team *model.Team, score int, box *pb.TeamBoxScore) mongodb.GameTeam {
+	gt := mongodb.GameTeam{
+		TeamID:       team.ID,
+		Name:         team.Name,
+		City:         team.City,
+		Abbreviation: team.Abbreviation,
+		Conference:   team.Conference,
+		LogoURL:      team.LogoURL,
+		Score:        score,
+		Totals:       newStatLine(box.Totals),
+	}
+	for _, line := range box.Players {
+		gt.Players = append(gt.Players, newStatLine(line))
+	}
+	return gt
+}
+
+func newStatLine(l *pb.PlayerStatLine) mongodb.StatLine {
+	return mongodb.StatLine{
+		PlayerID:   uint32(l.PlayerId),
+		PlayerName: l.PlayerName,
+		Points:     int(l.Points),
+		FGM:        int(l.Fgm),
+		FGA:        int(l.Fga),
+		FG3M:       int(l.Fg3M),
+		FG3A:       int(l.Fg3A),
+		FTM:        int(l.Ftm),
+		FTA:        int(l.Fta),
+		OREB:       int(l.Oreb),
+		DREB:       int(l.Dreb),
+		AST:        int(l.Ast),
+		STL:        int(l.Stl),
+		BLK:        int(l.Blk),
+		TOV:        int(l.Tov),
+		PF:         int(l.Pf),
+		Seconds:    int(l.SecondsPlayed),
+	}
+}
+
+// convertGameDocToProto 辅助方法
+func convertGameDocToProto(doc *mongodb.GameDoc) *pb.GameArchiveResponse {
+	resp := &pb.GameArchiveResponse{
+		MatchId:      doc.MatchID,
+		Season:       doc.Season,
+		Date:         doc.Date,
+		StartTime:    doc.StartTime.Format("15:04"),
+		Status:       int32(doc.Status),
+		HomeTeam:     convertGameTeamToProto(&doc.Home),
+		VisitorTeam:  convertGameTeamToProto(&doc.Visitor),
+		HomeScore:    int32(doc.Home.Score),
+		VisitorScore: int32(doc.Visitor.Score),
+		BoxScore: &pb.BoxScoreResponse{
+			MatchId: doc.MatchID,
+			Home:    convertTeamBoxToProto(&doc.Home),
+			Visitor: convertTeamBoxToProto(&doc.Visitor),
+		},
+		ArchivedAt: doc.ArchivedAt.Format(time.RFC3339),
+	}
+	for _, p := range doc.PeriodScores {
+		resp.PeriodScores = append(resp.PeriodScores, &pb.PeriodScore{
+			Period:       int32(p.Period),
+			HomeScore:    int32(p.Home),
+			VisitorScore: int32(p.Visitor),
+		})
+	}
+	for _, p := range doc.Plays {
+		resp.Plays = append(resp.Plays, &pb.ArchivedPlay{
+			Seq: int32(p.Seq),
+			Play: &pb.PlayByPlay{
+				EventId:       p.EventID,
+				PlayerId:      int32(p.PlayerID),
+				TeamId:        int32(p.TeamID),
+				Type:          pb.EventType(pb.EventType_value[p.Type]),
+				ShotType:      pb.ShotType(pb.ShotType_value[p.ShotType]),
+				SubType:       p.SubType,
+				Value:         int32(p.Value),
+				Quarter:       int32(p.Quarter),
+				TimeRemaining: p.TimeRemaining,
+			},
+			Voided:        p.Voided,
+			AmendsEventId: p.AmendsEventID,
+			HomeScore:     int32(p.HomeScore),
+			VisitorScore:  int32(p.VisitorScore),
+			EventTime:     p.EventTime.Format(time.RFC3339),
+		})
+	}
+	return resp
+}
+
+func convertGameTeamToProto(t *mongodb.GameTeam) *pb.TeamResponse {
+	return &pb.TeamResponse{
+		Id:           int32(t.TeamID),
+		Name:         t.Name,
+		City:         t.City,
+		Abbreviation: t.Abbreviation,
+		Conference:   t.Conference,
+		LogoUrl:      t.LogoURL,
+	}
+}
+
+func convertTeamBoxToProto(t *mongodb.GameTeam) *pb.TeamBoxScore {
+	box := &pb.TeamBoxScore{TeamId: int32(t.TeamID), Totals: convertStatLineToProto(&t.Totals, t.TeamID)}
+	for i := range t.Players {
+		box.Players = append(box.Players, convertStatLineToProto(&t.Players[i], t.TeamID))
+	}
+	return box
+}
+
+func convertStatLineToProto(l *mongodb.StatLine, teamID uint32) *pb.PlayerStatLine {
+	return &pb.PlayerStatLine{
+		PlayerId:      int32(l.PlayerID),
+		PlayerName:    l.PlayerName,
+		TeamId:        int32(teamID),
+		Points:        int32(l.Points),
+		Fgm:           int32(l.FGM),
+		Fga:           int32(l.FGA),
+		Fg3M:          int32(l.FG3M),
+		Fg3A:          int32(l.FG3A),
+		Ftm:           int32(l.FTM),
+		Fta:           int32(l.FTA),
+		Oreb:          int32(l.OREB),
+		Dreb:          int32(l.DREB),
+		Reb:           int32(l.OREB + l.DREB),
+		Ast:           int32(l.AST),
+		Stl:           int32(l.STL),
+		Blk:           int32(l.BLK),
+		Tov:           int32(l.TOV),
+		Pf:            int32(l.PF),
+		SecondsPlayed: int32(l.Seconds),
+		Minutes:       formatMinutes(l.Seconds),
+	}
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
+)
+
+// CreateContract 为阵容中的球员新建合同 (续约/延期), 由球员当前球队承担; 与未被裁的现有合同赛季不能重叠
+func (s *NBAService) CreateContract(ctx context.Context, req *pb.CreateContractRequest) (*pb.ContractResponse, error) {
+	signed, err := parseEffectiveDate(req.SignedDate)
+	if err != nil {
+		return nil, err
+	}
+	contract := &model.Contract{PlayerID: uint32(req.PlayerId), SignedDate: signed}
+	for _, cs := range req.Seasons {
+		contract.Seasons = append(contract.Seasons, model.ContractSeason{
+			Season:     cs.Season,
+			Salary:     cs.Salary,
+			Guaranteed: cs.Guaranteed,
+			Option:     cs.Option,
+		})
+	}
+	if err := contract.Validate(); err != nil {
+		return nil, err
+	}
+
+	var history []*model.PlayerTransaction
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		player, err := txDao.GetForUpdate(contract.PlayerID)
+		if err != nil {
+			return err
+		}
+		if player.TeamID == 0 {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "自由球员需先签入球队阵容", "")
+		}
+		contract.TeamID = player.TeamID
+
+		existing, err := txDao.Contracts().ByPlayer(player.ID)
+		if err != nil {
+			return err
+		}
+		for _, c := range existing {
+			if c.WaivedDate == nil && c.Overlaps(contract) {
+				return myErrors.NewError(myErrors.CodeInvalidPlayerData, "与现有合同的赛季重叠", "")
+			}
+		}
+		if err := txDao.Contracts().Create(contract); err != nil {
+			return err
+		}
+		history, err = txDao.Transactions().History(player.ID)
+		return err
+	})
+	if err != nil {
+		return nil, playerError(err)
+	}
+	return convertContractToProto(contract, history), nil
+}
+
+// GetPlayerContract 球员当前合同 (优先未被裁的最近一份), 鸟权按当前承担工资的球队计算
+func (s *NBAService) GetPlayerContract(ctx context.Context, req *pb.GetPlayerContractRequest) (*pb.ContractResponse, error) {
+	contracts, err := s.playerDao.Contracts().ByPlayer(uint32(req.PlayerId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询合同失败: "+err.Error())
+	}
+	if len(contracts) == 0 {
+		if _, err := s.playerDao.GetPlayerByID(uint32(req.PlayerId)); err != nil {
+			return nil, playerError(err)
+		}
+		return nil, myErrors.NewError(myErrors.CodeDataNotFound, "球员没有合同", "")
+	}
+	contract := contracts[0]
+	for _, c := range contracts {
+		if c.WaivedDate == nil {
+			contract = c
+			break
+		}
+	}
+
+	history, err := s.playerDao.Transactions().History(contract.PlayerID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询异动记录失败: "+err.Error())
+	}
+	return convertContractToProto(contract, history), nil
+}
+
+// GetTeamPayroll 球队某赛季的工资明细, 含已裁球员的保障工资
+func (s *NBAService) GetTeamPayroll(ctx context.Context, req *pb.GetTeamPayrollRequest) (*pb.TeamPayrollResponse, error) {
+	season, err := resolveSeason(req.Season)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.teamDao.GetByID(req.TeamId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, myErrors.NewError(myErrors.CodeTeamNotFound, "球队不存在", "")
+		}
+		return nil, status.Error(codes.Internal, "查询球队失败: "+err.Error())
+	}
+
+	contracts, err := s.playerDao.Contracts().TeamContracts([]uint32{uint32(req.TeamId)}, season)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询合同失败: "+err.Error())
+	}
+	playerIDs := make([]uint32, 0, len(contracts))
+	for _, c := range contracts {
+		playerIDs = append(playerIDs, c.PlayerID)
+	}
+	players, err := s.playerDao.GetPlayersByIDs(playerIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球员失败: "+err.Error())
+	}
+	names := make(map[uint32]string, len(players))
+	for _, p := range players {
+		names[p.ID] = p.Name
+	}
+
+	caps := s.capThresholds(season)
+	resp := &pb.TeamPayrollResponse{
+		TeamId:      req.TeamId,
+		Season:      season,
+		SalaryCap:   caps.SalaryCap,
+		LuxuryTax:   caps.LuxuryTax,
+		FirstApron:  caps.FirstApron,
+		SecondApron: caps.SecondApron,
+	}
+	for _, c := range contracts {
+		entry := &pb.PayrollEntry{
+			ContractId: int64(c.ID),
+			PlayerId:   int32(c.PlayerID),
+			PlayerName: names[c.PlayerID],
+			CapHit:     c.CapHit(season),
+			Option:     c.Season(season).Option,
+			Dead:       c.Waived(season),
+		}
+		resp.Total += entry.CapHit
+		resp.Entries = append(resp.Entries, entry)
+	}
+	sort.SliceStable(resp.Entries, func(i, j int) bool { return resp.Entries[i].CapHit > resp.Entries[j].CapHit })
+	resp.CapSpace = caps.SalaryCap - resp.Total
+	resp.TaxSpace = caps.LuxuryTax - resp.Total
+	resp.Level = caps.Level(resp.Total)
+	return resp, nil
+}
+
+// capThresholds 配置中某赛季的工资帽等阈值
+func (s *NBAService) capThresholds(season string) model.CapThresholds {
+	t := s.salaryCap.For(season)
+	return model.CapThresholds{
+		SalaryCap:   t.SalaryCap,
+		LuxuryTax:   t.LuxuryTax,
+		FirstApron:  t.FirstApron,
+		SecondApron: t.SecondApron,
+	}
+}
+
+// convertContractToProto 辅助方法, history 为球员异动记录 (计算鸟权)
+func convertContractToProto(c *model.Contract, history []*model.PlayerTransaction) *pb.ContractResponse {
+	resp := &pb.ContractResponse{
+		Id:         int64(c.ID),
+		PlayerId:   int32(c.PlayerID),
+		TeamId:     int32(c.TeamID),
+		SignedDate: c.SignedDate.Format("2006-01-02"),
+		TotalValue: c.TotalValue(),
+	}
+	if c.WaivedDate != nil {
+		resp.WaivedDate = c.WaivedDate.Format("2006-01-02")
+	} else {
+		resp.BirdRights = model.BirdRightsOf(history, c.TeamID, time.Now())
+	}
+	for _, cs := range c.Seasons {
+		resp.Seasons = append(resp.Seasons, &pb.ContractSeason{
+			Season:     cs.Season,
+			Salary:     cs.Salary,
+			Guaranteed: cs.Guaranteed,
+			Option:     cs.Option,
+		})
+	}
+	return resp
+}
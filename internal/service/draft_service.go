@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/draft"
+	"nba-remake/internal/model"
+)
+
+// CreateDraft 新建选秀年份, 为每支球队生成各轮选秀权 (原属和持有球队均为本队)
+func (s *NBAService) CreateDraft(ctx context.Context, req *pb.CreateDraftRequest) (*pb.DraftBoardResponse, error) {
+	if req.Year <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "选秀年份无效")
+	}
+	d := &model.Draft{Year: int(req.Year), Season: req.Season, Rounds: 2, LotteryTeams: int8(len(draft.LotteryOdds))}
+	if d.Season == "" {
+		d.Season = model.SeasonOf(time.Date(d.Year-1, time.October, 1, 0, 0, 0, 0, time.Local))
+	} else if _, err := model.ParseSeason(d.Season); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Rounds != 0 {
+		if req.Rounds < 0 || req.Rounds > model.MaxDraftRounds {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("选秀轮数应在 1-%d 之间", model.MaxDraftRounds))
+		}
+		d.Rounds = int8(req.Rounds)
+	}
+	if req.LotteryTeams != 0 {
+		if req.LotteryTeams < 0 || int(req.LotteryTeams) > len(draft.LotteryOdds) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("乐透球队数应在 1-%d 之间", len(draft.LotteryOdds)))
+		}
+		d.LotteryTeams = int8(req.LotteryTeams)
+	}
+
+	teams, err := s.teamDao.GetAll()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球队失败: "+err.Error())
+	}
+	picks := make([]*model.DraftPick, 0, len(teams)*int(d.Rounds))
+	for round := 1; round <= int(d.Rounds); round++ {
+		for _, t := range teams {
+			picks = append(picks, &model.DraftPick{Year: d.Year, Round: int8(round), OriginalTeamID: t.ID, OwnerTeamID: t.ID})
+		}
+	}
+
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		if _, err := txDao.Drafts().Get(d.Year); err == nil {
+			return myErrors.NewError(myErrors.CodeDuplicateData, fmt.Sprintf("%d 年选秀已存在", d.Year), "")
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return txDao.Drafts().Create(d, picks)
+	})
+	if err != nil {
+		return nil, draftError(err)
+	}
+	return s.draftBoard(d.Year, 0)
+}
+
+// RunDraftLottery 按赛季战绩排定抽签前排名并抽签, 确定全部选秀权的顺位; 每届只能抽一次
+// 落在保护范围内的选秀权回到附加保护的球队 (ProtectionHolder), 未经交易附加保护时即原属球队
+func (s *NBAService) RunDraftLottery(ctx context.Context, req *pb.RunDraftLotteryRequest) (*pb.DraftBoardResponse, error) {
+	d, err := s.playerDao.Drafts().Get(int(req.Year))
+	if err != nil {
+		return nil, draftError(err)
+	}
+	standings, err := s.computeStandings(d.Season)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "计算战绩失败: "+err.Error())
+	}
+	records := make(map[uint32]draft.Record, len(standings.Standings))
+	for _, e := range standings.Standings {
+		id := uint32(e.Team.Id)
+		records[id] = draft.Record{TeamID: id, Wins: int(e.Wins), Losses: int(e.Losses)}
+	}
+	seed := req.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		if d, err = txDao.Drafts().GetForUpdate(d.Year); err != nil {
+			return err
+		}
+		if d.Drawn() {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("%d 年选秀已抽签", d.Year))
+		}
+		listed, err := txDao.Drafts().Picks(d.Year, 0)
+		if err != nil {
+			return err
+		}
+		ids := make([]uint32, 0, len(listed))
+		for _, p := range listed {
+			ids = append(ids, p.ID)
+		}
+		picks, err := txDao.Transactions().GetPicksForUpdate(ids)
+		if err != nil {
+			return err
+		}
+
+		// 拥有本届选秀权的球队参加排名, 没有战绩的按 0 胜 0 负
+		byRound := make(map[int8]map[uint32]*model.DraftPick)
+		var teams []draft.Record
+		for _, p := range picks {
+			if byRound[p.Round] == nil {
+				byRound[p.Round] = make(map[uint32]*model.DraftPick)
+			}
+			byRound[p.Round][p.OriginalTeamID] = p
+		}
+		for id := range byRound[1] {
+			r, ok := records[id]
+			if !ok {
+				r = draft.Record{TeamID: id}
+			}
+			teams = append(teams, r)
+		}
+		res := draft.Draw(teams, int(d.LotteryTeams), seed)
+
+		lotteryRank := make(map[uint32]int, res.Lottery)
+		for i, id := range res.Standings[:res.Lottery] {
+			lotteryRank[id] = i + 1
+		}
+		number := 0
+		for round := int8(1); round <= d.Rounds; round++ {
+			order := res.Standings
+			if round == 1 {
+				order = res.FirstRound
+			}
+			for _, id := range order {
+				p := byRound[round][id]
+				if p == nil {
+					continue
+				}
+				number++
+				rank := 0
+				if round == 1 {
+					rank = lotteryRank[id]
+				}
+				p.Resolve(number, rank)
+				if err := txDao.Drafts().SavePick(p); err != nil {
+					return err
+				}
+			}
+		}
+
+		now := time.Now()
+		d.Seed, d.LotteryDate = seed, &now
+		return txDao.Drafts().Save(d)
+	})
+	if err != nil {
+		return nil, draftError(err)
+	}
+	return s.draftBoard(d.Year, 0)
+}
+
+// AddProspect 为某届选秀新增新秀
+func (s *NBAService) AddProspect(ctx context.Context, req *pb.AddProspectRequest) (*pb.ProspectResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "新秀姓名不能为空")
+	}
+	if req.Rank < 0 {
+		return nil, status.Error(codes.InvalidArgument, "大板排名不能为负")
+	}
+	p := &model.Prospect{
+		Year:      int(req.Year),
+		Name:      name,
+		Position:  req.Position,
+		Height:    req.Height,
+		Weight:    req.Weight,
+		School:    strings.TrimSpace(req.School),
+		BoardRank: int(req.Rank),
+	}
+	if req.Birthday != "" {
+		birthday, err := time.ParseInLocation("2006-01-02", req.Birthday, time.Local)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "生日格式错误, 应为 YYYY-MM-DD")
+		}
+		p.Birthday = &birthday
+	}
+	if _, err := s.playerDao.Drafts().Get(p.Year); err != nil {
+		return nil, draftError(err)
+	}
+	if err := s.playerDao.Drafts().CreateProspect(p); err != nil {
+		return nil, status.Error(codes.Internal, "保存新秀失败: "+err.Error())
+	}
+	return convertProspectToProto(p), nil
+}
+
+// MakePick 持有球队用当前轮到的选秀权选中新秀: 新秀生成球员并签入该队阵容 (记一条签约异动)
+// 加锁顺序: 选秀 -> 球队 -> 选秀权, 与交易 (球队 -> 选秀权) 一致
+func (s *NBAService) MakePick(ctx context.Context, req *pb.MakePickRequest) (*pb.MakePickResponse, error) {
+	slot := req.RosterSlot
+	if slot == pb.RosterSlot_ROSTER_SLOT_UNKNOWN {
+		slot = pb.RosterSlot_STANDARD
+	}
+	date, err := parseEffectiveDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	pick, err := s.playerDao.Drafts().GetPick(uint32(req.PickId))
+	if err != nil {
+		return nil, pickError(err)
+	}
+
+	var player *model.Player
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		d, err := txDao.Drafts().GetForUpdate(pick.Year)
+		if err != nil {
+			return err
+		}
+		if !d.Drawn() {
+			return myErrors.NewError(myErrors.CodeDraftNotReady, fmt.Sprintf("%d 年选秀尚未抽签", d.Year), "")
+		}
+		roster, err := lockRoster(txDao, pick.OwnerTeamID)
+		if err != nil {
+			return err
+		}
+		locked, err := txDao.Transactions().GetPicksForUpdate([]uint32{pick.ID})
+		if err != nil {
+			return err
+		}
+		if pick = locked[pick.ID]; pick == nil || pick.OwnerTeamID != roster.TeamID {
+			return status.Error(codes.Aborted, "选秀权持有球队已变化, 请重试")
+		}
+		if pick.Used() {
+			return myErrors.NewError(myErrors.CodePickUsed, "选秀权已使用", "")
+		}
+		next, err := txDao.Drafts().NextPick(d.Year)
+		if err != nil {
+			return err
+		}
+		if next.ID != pick.ID {
+			return myErrors.NewError(myErrors.CodePickNotOnClock, fmt.Sprintf("当前轮到第 %d 顺位", next.Number), "")
+		}
+
+		prospect, err := txDao.Drafts().GetProspectForUpdate(uint32(req.ProspectId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return myErrors.NewError(myErrors.CodeDataNotFound, "新秀不存在", "")
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case prospect.Year != d.Year:
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, fmt.Sprintf("新秀没有参加 %d 年选秀", d.Year), "")
+		case prospect.PlayerID != 0:
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "新秀已被选中", "")
+		}
+		if err := roster.CheckJersey(req.JerseyNumber, 0); err != nil {
+			return err
+		}
+		if err := roster.CheckSlot(slot); err != nil {
+			return err
+		}
+
+		player = prospect.Rookie()
+		player.Join(roster.TeamID, req.JerseyNumber, slot)
+		if err := txDao.CreatePlayer(player); err != nil {
+			return err
+		}
+		record := signing(player, date)
+		record.Note = fmt.Sprintf("%s, %d 年选秀第 %d 顺位", record.Note, d.Year, pick.Number)
+		if err := txDao.Transactions().Record(record); err != nil {
+			return err
+		}
+		pick.PlayerID = player.ID
+		if err := txDao.Drafts().SavePick(pick); err != nil {
+			return err
+		}
+		prospect.PickID, prospect.PlayerID = pick.ID, player.ID
+		return txDao.Drafts().SaveProspect(prospect)
+	})
+	if err != nil {
+		return nil, draftError(err)
+	}
+	s.playerDao.SyncIndex(player)
+
+	d, err := s.playerDao.Drafts().Get(pick.Year)
+	if err != nil {
+		return nil, draftError(err)
+	}
+	entry := convertDraftPickToProto(pick, d)
+	entry.PlayerName = player.Name
+	return &pb.MakePickResponse{Pick: entry, Player: convertPlayerModelToProto(player)}, nil
+}
+
+// GetDraftBoard 选秀看板: 各选秀权的顺位/持有球队/保护/抽签概率, 当前轮到的选秀权及剩余新秀
+func (s *NBAService) GetDraftBoard(ctx context.Context, req *pb.GetDraftBoardRequest) (*pb.DraftBoardResponse, error) {
+	return s.draftBoard(int(req.Year), uint32(req.TeamId))
+}
+
+// draftBoard ownerTeamID 为 0 时包含全部选秀权
+func (s *NBAService) draftBoard(year int, ownerTeamID uint32) (*pb.DraftBoardResponse, error) {
+	d, err := s.playerDao.Drafts().Get(year)
+	if err != nil {
+		return nil, draftError(err)
+	}
+	picks, err := s.playerDao.Drafts().Picks(year, ownerTeamID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询选秀权失败: "+err.Error())
+	}
+	prospects, err := s.playerDao.Drafts().AvailableProspects(year)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询新秀失败: "+err.Error())
+	}
+
+	var playerIDs []uint32
+	for _, p := range picks {
+		if p.Used() {
+			playerIDs = append(playerIDs, p.PlayerID)
+		}
+	}
+	players, err := s.playerDao.GetPlayersByIDs(playerIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球员失败: "+err.Error())
+	}
+	names := make(map[uint32]string, len(players))
+	for _, p := range players {
+		names[p.ID] = p.Name
+	}
+
+	resp := &pb.DraftBoardResponse{
+		Year:         int32(d.Year),
+		Season:       d.Season,
+		Rounds:       int32(d.Rounds),
+		LotteryTeams: int32(d.LotteryTeams),
+		LotteryDrawn: d.Drawn(),
+		Seed:         d.Seed,
+	}
+	if d.Drawn() {
+		next, err := s.playerDao.Drafts().NextPick(year)
+		switch {
+		case err == nil:
+			resp.OnTheClock = int32(next.ID)
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.Internal, "查询选秀权失败: "+err.Error())
+		}
+	}
+	for _, p := range picks {
+		entry := convertDraftPickToProto(p, d)
+		entry.PlayerName = names[p.PlayerID]
+		resp.Picks = append(resp.Picks, entry)
+	}
+	for _, p := range prospects {
+		resp.Prospects = append(resp.Prospects, convertProspectToProto(p))
+	}
+	return resp, nil
+}
+
+// draftError 选秀不存在转换为业务错误, 其余同 playerError
+func draftError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return myErrors.NewError(myErrors.CodeDataNotFound, "选秀不存在", "")
+	}
+	return playerError(err)
+}
+
+func pickError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return myErrors.NewError(myErrors.CodePickNotFound, "选秀权不存在", "")
+	}
+	return status.Error(codes.Internal, "查询选秀权失败: "+err.Error())
+}
+
+// convertDraftPickToProto 辅助方法
+func convertDraftPickToProto(p *model.DraftPick, d *model.Draft) *pb.DraftPickEntry {
+	return &pb.DraftPickEntry{
+		Id:                  int32(p.ID),
+		Year:                int32(p.Year),
+		Round:               int32(p.Round),
+		Number:              int32(p.Number),
+		OriginalTeamId:      int32(p.OriginalTeamID),
+		OwnerTeamId:         int32(p.OwnerTeamID),
+		Protection:          int32(p.Protection),
+		ProtectionTriggered: p.Protected,
+		LotteryRank:         int32(p.LotteryRank),
+		LotteryOdds:         draft.Odds(int(p.LotteryRank), int(d.LotteryTeams)),
+		PlayerId:            int32(p.PlayerID),
+		ProtectedByTeamId:   int32(p.ProtectionHolder()),
+	}
+}
+
+// convertProspectToProto 辅助方法
+func convertProspectToProto(p *model.Prospect) *pb.ProspectResponse {
+	resp := &pb.ProspectResponse{
+		Id:       int32(p.ID),
+		Year:     int32(p.Year),
+		Name:     p.Name,
+		Position: p.Position,
+		Height:   p.Height,
+		Weight:   p.Weight,
+		School:   p.School,
+		Rank:     int32(p.BoardRank),
+		PickId:   int32(p.PickID),
+		PlayerId: int32(p.PlayerID),
+	}
+	if p.Birthday != nil {
+		resp.Birthday = p.Birthday.Format("2006-01-02")
+	}
+	return resp
+}
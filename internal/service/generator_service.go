@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
+	"nba-remake/internal/scheduler"
+)
+
+// defaultStartTime 生成赛程的默认开赛时间
+const defaultStartTime = "19:30"
+
+// GenerateSchedule 为 teams 表中的全部球队生成常规赛赛程
+// 约束报告有违反项时只返回赛程和报告, 不写入; 写入前检查与已有比赛的同日冲突, 整批在同一事务中完成
+func (s *NBAService) GenerateSchedule(ctx context.Context, req *pb.GenerateScheduleRequest) (*pb.GenerateScheduleResponse, error) {
+	conf, err := newSchedulerConfig(req)
+	if err != nil {
+		return nil, err
+	}
+	season := req.Season
+	if season == "" {
+		season = model.SeasonOf(conf.StartDate)
+	}
+	if err := model.ValidateSeason(season, conf.StartDate); err != nil {
+		return nil, myErrors.NewError(myErrors.CodeInvalidMatchData, err.Error(), "")
+	}
+	if !conf.EndDate.IsZero() && model.SeasonOf(conf.EndDate) != season {
+		return nil, myErrors.NewError(myErrors.CodeInvalidMatchData, "结束日期不在 "+season+" 赛季内", "")
+	}
+	startTime := req.StartTime
+	if startTime == "" {
+		startTime = defaultStartTime
+	}
+
+	allTeams, err := s.teamDao.GetAll()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球队失败: "+err.Error())
+	}
+	// 赛区/联盟默认按该赛季的赛区归属, 请求中指定的赛区优先
+	alignment, err := s.leagueDao.DivisionsOf(season)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询赛区归属失败: "+err.Error())
+	}
+	overrides := make(map[uint32]string, len(req.Divisions))
+	for _, d := range req.Divisions {
+		overrides[uint32(d.TeamId)] = d.Division
+	}
+	teams := make(map[uint32]*model.Team, len(allTeams))
+	input := make([]scheduler.Team, 0, len(allTeams))
+	for _, t := range allTeams {
+		teams[t.ID] = t
+		team := scheduler.Team{ID: t.ID, Abbreviation: t.Abbreviation, Conference: t.Conference, Arena: t.HomeArena}
+		if d, ok := alignment[t.ID]; ok {
+			team.Conference, team.Division = d.Conference.Name, d.Name
+		}
+		if division, ok := overrides[t.ID]; ok {
+			team.Division = division
+		}
+		input = append(input, team)
+	}
+
+	result, err := scheduler.Generate(input, conf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	matches := make([]*model.Match, 0, len(result.Games))
+	for _, game := range result.Games {
+		match, err := newScheduledMatch(game.Date.Format("2006-01-02"), startTime, game.Home, game.Visitor, season, teams)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	resp := &pb.GenerateScheduleResponse{
+		Season: season,
+		Seed:   conf.Seed,
+		DryRun: req.DryRun,
+		Report: convertScheduleReport(result.Report),
+	}
+	if !req.DryRun && result.Report.OK() {
+		err = s.matchDao.WithTx(func(txDao *dao.MatchDao) error {
+			if err := checkScheduleConflicts(txDao, matches, 0, teams); err != nil {
+				return err
+			}
+			return txDao.CreateMatches(matches)
+		})
+		if err != nil {
+			return nil, scheduleError(err)
+		}
+		resp.Created = int32(len(matches))
+		log.Printf("[Schedule] 已生成赛程 season=%s seed=%d games=%d", season, conf.Seed, len(matches))
+	}
+
+	for _, m := range matches {
+		m.HomeTeam, m.VisitorTeam = *teams[uint32(m.HomeTeamID)], *teams[uint32(m.VisitorTeamID)]
+		resp.Matches = append(resp.Matches, convertMatchToProto(m))
+	}
+	return resp, nil
+}
+
+// newSchedulerConfig 请求参数转换为生成器配置, 未传的数值参数由生成器取默认值
+func newSchedulerConfig(req *pb.GenerateScheduleRequest) (scheduler.Config, error) {
+	conf := scheduler.Config{
+		GamesPerTeam:     int(req.GamesPerTeam),
+		DivisionWeight:   req.DivisionWeight,
+		ConferenceWeight: req.ConferenceWeight,
+		OtherWeight:      req.OtherWeight,
+		MaxGamesPerDay:   int(req.MaxGamesPerDay),
+		Seed:             req.Seed,
+	}
+	if conf.Seed == 0 {
+		conf.Seed = rand.Int63()
+	}
+
+	var err error
+	if conf.StartDate, err = time.ParseInLocation("2006-01-02", req.StartDate, time.Local); err != nil {
+		return conf, myErrors.NewError(myErrors.CodeInvalidMatchTime, "开始日期格式错误, 应为 YYYY-MM-DD", req.StartDate)
+	}
+	if req.EndDate != "" {
+		if conf.EndDate, err = time.ParseInLocation("2006-01-02", req.EndDate, time.Local); err != nil {
+			return conf, myErrors.NewError(myErrors.CodeInvalidMatchTime, "结束日期格式错误, 应为 YYYY-MM-DD", req.EndDate)
+		}
+	}
+
+	conf.Blackouts = make(map[string]map[string]bool)
+	for _, b := range req.ArenaBlackouts {
+		if _, err := time.Parse("2006-01-02", b.Date); err != nil {
+			return conf, myErrors.NewError(myErrors.CodeInvalidMatchTime, "场馆不可用日期格式错误, 应为 YYYY-MM-DD", b.Date)
+		}
+		if conf.Blackouts[b.Arena] == nil {
+			conf.Blackouts[b.Arena] = make(map[string]bool)
+		}
+		conf.Blackouts[b.Arena][b.Date] = true
+	}
+	return conf, nil
+}
+
+// convertScheduleReport 辅助方法
+func convertScheduleReport(r *scheduler.Report) *pb.ScheduleReport {
+	resp := &pb.ScheduleReport{
+		Ok:         r.OK(),
+		TotalGames: int32(r.TotalGames),
+		DaysUsed:   int32(r.DaysUsed),
+	}
+	if !r.FirstDate.IsZero() {
+		resp.FirstDate = r.FirstDate.Format("2006-01-02")
+		resp.LastDate = r.LastDate.Format("2006-01-02")
+	}
+	for _, t := range r.Teams {
+		resp.Teams = append(resp.Teams, &pb.TeamScheduleSummary{
+			TeamId:          int32(t.TeamID),
+			Abbreviation:    t.Abbreviation,
+			Games:           int32(t.Games),
+			Home:            int32(t.Home),
+			Away:            int32(t.Away),
+			DivisionGames:   int32(t.DivisionGames),
+			ConferenceGames: int32(t.ConferenceGames),
+			OtherGames:      int32(t.OtherGames),
+			BackToBacks:     int32(t.BackToBacks),
+		})
+	}
+	for _, v := range r.Violations {
+		resp.Violations = append(resp.Violations, &pb.ScheduleViolation{
+			Constraint: v.Constraint,
+			TeamId:     int32(v.TeamID),
+			Date:       v.Date,
+			Message:    v.Message,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
+)
+
+// ReportInjury 登记伤病, 缺阵/出战成疑时球员状态自动改为 INJURED
+func (s *NBAService) ReportInjury(ctx context.Context, req *pb.ReportInjuryRequest) (*pb.InjuryResponse, error) {
+	bodyPart := strings.TrimSpace(req.BodyPart)
+	if bodyPart == "" {
+		return nil, status.Error(codes.InvalidArgument, "受伤部位不能为空")
+	}
+	designation := req.Designation
+	if designation == pb.InjuryDesignation_DESIGNATION_UNKNOWN {
+		designation = pb.InjuryDesignation_OUT
+	}
+	if err := checkDesignation(designation); err != nil {
+		return nil, err
+	}
+	injuryDate, err := parseEffectiveDate(req.InjuryDate)
+	if err != nil {
+		return nil, err
+	}
+	if injuryDate.After(today()) {
+		return nil, status.Error(codes.InvalidArgument, "受伤日期不能晚于今天")
+	}
+	injury := &model.Injury{
+		PlayerID:    uint32(req.PlayerId),
+		BodyPart:    bodyPart,
+		Description: strings.TrimSpace(req.Description),
+		InjuryDate:  injuryDate,
+		Designation: designation,
+	}
+	if req.ExpectedReturn != "" {
+		if injury.ExpectedReturn, err = parseInjuryDate(req.ExpectedReturn, injuryDate); err != nil {
+			return nil, err
+		}
+	}
+
+	var player *model.Player
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		if player, err = txDao.GetForUpdate(injury.PlayerID); err != nil {
+			return err
+		}
+		if player.Status == pb.PlayerStatus_RETIRED {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "退役球员不能登记伤病", "")
+		}
+		injury.TeamID = player.TeamID
+		if err := txDao.Injuries().Create(injury); err != nil {
+			return err
+		}
+		return syncInjuryStatus(txDao, player)
+	})
+	if err != nil {
+		return nil, playerError(err)
+	}
+	s.afterInjuryChange(ctx, player)
+
+	injury.Player = *player
+	return s.injuryResponse(injury, today())
+}
+
+// UpdateInjury 更新伤病的出战状态/预计复出/伤情, 传复出日期表示已复出; 球员状态随之重新计算
+func (s *NBAService) UpdateInjury(ctx context.Context, req *pb.UpdateInjuryRequest) (*pb.InjuryResponse, error) {
+	if req.Designation != pb.InjuryDesignation_DESIGNATION_UNKNOWN {
+		if err := checkDesignation(req.Designation); err != nil {
+			return nil, err
+		}
+	}
+
+	var injury *model.Injury
+	var player *model.Player
+	err := s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		var err error
+		if injury, err = txDao.Injuries().GetForUpdate(uint64(req.Id)); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return myErrors.NewError(myErrors.CodeDataNotFound, "伤病记录不存在", "")
+			}
+			return err
+		}
+		if req.Designation != pb.InjuryDesignation_DESIGNATION_UNKNOWN {
+			injury.Designation = req.Designation
+		}
+		if req.ExpectedReturn != "" {
+			if injury.ExpectedReturn, err = parseInjuryDate(req.ExpectedReturn, injury.InjuryDate); err != nil {
+				return err
+			}
+		}
+		if req.ReturnedDate != "" {
+			if injury.ReturnedDate, err = parseInjuryDate(req.ReturnedDate, injury.InjuryDate); err != nil {
+				return err
+			}
+			if injury.ReturnedDate.After(today()) {
+				return status.Error(codes.InvalidArgument, "复出日期不能晚于今天")
+			}
+		}
+		if req.Description != "" {
+			injury.Description = strings.TrimSpace(req.Description)
+		}
+		if err := txDao.Injuries().Save(injury); err != nil {
+			return err
+		}
+
+		if player, err = txDao.GetForUpdate(injury.PlayerID); err != nil {
+			return err
+		}
+		return syncInjuryStatus(txDao, player)
+	})
+	if err != nil {
+		return nil, playerError(err)
+	}
+	s.afterInjuryChange(ctx, player)
+
+	injury.Player = *player
+	return s.injuryResponse(injury, today())
+}
+
+// GetInjuryReport 某天仍在伤病中的球员 (默认当天), 可只看一支球队
+func (s *NBAService) GetInjuryReport(ctx context.Context, req *pb.GetInjuryReportRequest) (*pb.InjuryReportResponse, error) {
+	date, err := parseEffectiveDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	injuries, err := s.playerDao.Injuries().ActiveOn(date, uint32(req.TeamId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询伤病失败: "+err.Error())
+	}
+
+	resp := &pb.InjuryReportResponse{Date: date.Format("2006-01-02"), Injuries: make([]*pb.InjuryResponse, 0, len(injuries))}
+	for _, i := range injuries {
+		item, err := s.injuryResponse(i, date)
+		if err != nil {
+			return nil, err
+		}
+		resp.Injuries = append(resp.Injuries, item)
+	}
+	return resp, nil
+}
+
+// syncInjuryStatus 按球员尚未复出的伤病重算状态, 有变化时保存 (在事务中调用, 球员行已锁定)
+func syncInjuryStatus(txDao *dao.PlayerDao, player *model.Player) error {
+	unreturned, err := txDao.Injuries().Unreturned(player.ID)
+	if err != nil {
+		return err
+	}
+	next := model.InjuryStatus(player.Status, unreturned)
+	if next == player.Status {
+		return nil
+	}
+	player.Status = next
+	return txDao.UpdatePlayer(player)
+}
+
+// afterInjuryChange 事务提交后同步搜索索引并清除球员缓存
+func (s *NBAService) afterInjuryChange(ctx context.Context, player *model.Player) {
+	s.playerDao.SyncIndex(player)
+	s.cache.Invalidate(ctx, cache.PlayerKey(int32(player.ID)))
+}
+
+// injuryResponse 转换伤病记录, 缺席场次统计到 asOf 当天 (含)
+func (s *NBAService) injuryResponse(i *model.Injury, asOf time.Time) (*pb.InjuryResponse, error) {
+	missed, err := s.playerDao.Injuries().GamesMissed(i, asOf.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "统计缺席场次失败: "+err.Error())
+	}
+	resp := convertInjuryToProto(i)
+	resp.GamesMissed = int32(missed)
+	return resp, nil
+}
+
+func checkDesignation(d pb.InjuryDesignation) error {
+	if _, ok := pb.InjuryDesignation_name[int32(d)]; !ok || d == pb.InjuryDesignation_DESIGNATION_UNKNOWN {
+		return status.Error(codes.InvalidArgument, "无效的出战状态")
+	}
+	return nil
+}
+
+// parseInjuryDate 解析预计复出/复出日期, 不能早于受伤日期
+func parseInjuryDate(date string, injuryDate time.Time) (*time.Time, error) {
+	d, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "日期格式错误, 应为 YYYY-MM-DD")
+	}
+	if d.Before(injuryDate) {
+		return nil, status.Error(codes.InvalidArgument, "复出日期不能早于受伤日期")
+	}
+	return &d, nil
+}
+
+// convertInjuryToProto 辅助方法
+func convertInjuryToProto(i *model.Injury) *pb.InjuryResponse {
+	resp := &pb.InjuryResponse{
+		Id:          int64(i.ID),
+		PlayerId:    int32(i.PlayerID),
+		PlayerName:  i.Player.Name,
+		TeamId:      int32(i.TeamID),
+		BodyPart:    i.BodyPart,
+		Description: i.Description,
+		InjuryDate:  i.InjuryDate.Format("2006-01-02"),
+		Designation: i.Designation,
+	}
+	if i.ExpectedReturn != nil {
+		resp.ExpectedReturn = i.ExpectedReturn.Format("2006-01-02")
+	}
+	if i.ReturnedDate != nil {
+		resp.ReturnedDate = i.ReturnedDate.Format("2006-01-02")
+	}
+	return resp
+}
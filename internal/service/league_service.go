@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/model"
+)
+
+// ListDivisions 赛区列表, 每个赛区带上该赛季的球队
+func (s *NBAService) ListDivisions(ctx context.Context, req *pb.ListDivisionsRequest) (*pb.ListDivisionsResponse, error) {
+	season, err := resolveSeason(req.Season)
+	if err != nil {
+		return nil, err
+	}
+	divisions, err := s.leagueDao.ListDivisions(req.Conference)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询赛区失败: "+err.Error())
+	}
+	members, err := s.divisionMembers(season)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListDivisionsResponse{}
+	for _, d := range divisions {
+		resp.Divisions = append(resp.Divisions, convertDivisionToProto(d, season, members[d.ID]))
+	}
+	return resp, nil
+}
+
+// GetDivision 赛区详情
+func (s *NBAService) GetDivision(ctx context.Context, req *pb.GetDivisionRequest) (*pb.DivisionResponse, error) {
+	season, err := resolveSeason(req.Season)
+	if err != nil {
+		return nil, err
+	}
+	division, err := s.leagueDao.GetDivision(uint32(req.Id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "赛区不存在")
+		}
+		return nil, status.Error(codes.Internal, "查询赛区失败: "+err.Error())
+	}
+	members, err := s.divisionMembers(season)
+	if err != nil {
+		return nil, err
+	}
+	return convertDivisionToProto(division, season, members[division.ID]), nil
+}
+
+// divisionMembers 某赛季各赛区的球队, 返回 division_id -> teams (按球队名排序)
+func (s *NBAService) divisionMembers(season string) (map[uint32][]*pb.TeamResponse, error) {
+	teams, err := s.teamDao.GetAll()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球队失败: "+err.Error())
+	}
+	divisions, err := s.leagueDao.DivisionsOf(season)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询赛区归属失败: "+err.Error())
+	}
+	members := make(map[uint32][]*pb.TeamResponse)
+	for _, t := range teams {
+		if d, ok := divisions[t.ID]; ok {
+			members[d.ID] = append(members[d.ID], convertTeamWithDivision(t, d))
+		}
+	}
+	return members, nil
+}
+
+// convertDivisionToProto 辅助方法
+func convertDivisionToProto(d *model.Division, season string, teams []*pb.TeamResponse) *pb.DivisionResponse {
+	return &pb.DivisionResponse{
+		Id:             int32(d.ID),
+		Name:           d.Name,
+		Conference:     d.Conference.Name,
+		ConferenceName: d.Conference.FullName,
+		Season:         season,
+		Teams:          teams,
+	}
+}
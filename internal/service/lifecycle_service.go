@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/model"
+)
+
+// StartMatch 开赛
+func (s *NBAService) StartMatch(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.Start()
+	})
+}
+
+// EndPeriod 结束当前节
+func (s *NBAService) EndPeriod(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.EndPeriod(int8(req.Period))
+	})
+}
+
+// StartOvertime 开始加时
+func (s *NBAService) StartOvertime(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.StartOvertime()
+	})
+}
+
+// FinalizeMatch 终场, 成功后删除该赛季的排名缓存并归档到 MongoDB
+func (s *NBAService) FinalizeMatch(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	resp, err := s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.Finalize()
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.cache.Invalidate(ctx, cache.StandingsKey(resp.Season))
+	// 归档失败不影响终场, 可以通过 ArchiveMatch 补录
+	if _, err := s.archiveMatch(ctx, req.MatchId); err != nil {
+		log.Printf("[Lifecycle] 比赛归档失败 match_id=%d err=%v", req.MatchId, err)
+	}
+	return resp, nil
+}
+
+// PostponeMatch 延期 (仅限开赛前)
+func (s *NBAService) PostponeMatch(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.Postpone(req.Reason)
+	})
+}
+
+// StartClock 启动比赛时钟
+func (s *NBAService) StartClock(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.StartClock(time.Now())
+	})
+}
+
+// StopClock 暂停比赛时钟
+func (s *NBAService) StopClock(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.StopClock(time.Now())
+	})
+}
+
+// ResetClock 校正比赛时钟 (需先暂停), 不传时间则重置为整节时长
+func (s *NBAService) ResetClock(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	var remaining *time.Duration
+	if req.TimeRemaining != "" {
+		secs, err := model.ParseClock(req.TimeRemaining)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "剩余时间格式错误, 应为 mm:ss")
+		}
+		d := time.Duration(secs) * time.Second
+		remaining = &d
+	}
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.ResetClock(remaining)
+	})
+}
+
+// transitionMatch 在行锁内执行状态流转, 提交后删除缓存并推送最新状态
+// 非法流转返回 *myErrors.AppError (携带 CodeMatchInProgress/CodeMatchFinished 等业务错误码)
+func (s *NBAService) transitionMatch(ctx context.Context, matchID int64, fn func(m *model.Match) error) (*pb.MatchResponse, error) {
+	if matchID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数缺失: match_id 必填")
+	}
+
+	if _, err := s.matchDao.Transition(matchID, fn); err != nil {
+		var appErr *myErrors.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "比赛未找到")
+		default:
+			return nil, status.Error(codes.Internal, "更新比赛状态失败: "+err.Error())
+		}
+	}
+	s.cache.Invalidate(ctx, cache.MatchKey(matchID))
+
+	match, err := s.matchDao.GetByID(matchID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
+	}
+	s.publishMatchState(ctx, match)
+	return convertMatchToProto(match), nil
+}
+
+// publishMatchState 推送状态变化 (开赛/换节/终场/时钟启停等), 推送失败只记录日志
+func (s *NBAService) publishMatchState(ctx context.Context, match *model.Match) {
+	now := time.Now()
+	remaining := match.ClockRemaining(now)
+	update := &pb.MatchUpdate{
+		MatchId:          int64(match.ID),
+		HomeScore:        int32(match.HomeScore),
+		VisitorScore:     int32(match.VisitorScore),
+		Status:           int32(match.Status),
+		Quarter:          int32(match.Period),
+		TimeRemaining:    model.FormatClock(remaining),
+		ClockRunning:     match.ClockRunning,
+		ClockRemainingMs: remaining.Milliseconds(),
+		UpdatedAt:        now.Format(time.RFC3339),
+	}
+	if err := cache.PublishMatchUpdate(ctx, s.redisClient, update); err != nil {
+		log.Printf("[Lifecycle] 推送失败 match_id=%d err=%v", match.ID, err)
+	}
+}
+
+// checkScorable 提前校验比赛是否可以计分, 避免明显无效的事件进入死信队列 (消费者会再校验一次)
+func (s *NBAService) checkScorable(matchID int64) error {
+	match, err := s.getMatchState(matchID)
+	if err != nil {
+		return err
+	}
+	return match.CheckScorable()
+}
+
+// 客户端上报的剩余时间与权威时钟的允许偏差:
+// 不能晚于当前时钟 (留 1 秒取整误差), 最多比当前时钟早 30 秒 (录入延迟)
+const (
+	clockLeadTolerance = time.Second
+	clockLagTolerance  = 30 * time.Second
+)
+
+// stampEventClock 比赛进行中时用权威时钟补全事件的节次/剩余时间, 客户端传了则校验
+// 计分事件同时校验比赛是否可以计分
+func (s *NBAService) stampEventClock(req *pb.RecordMatchEventRequest) error {
+	match, err := s.getMatchState(req.MatchId)
+	if err != nil {
+		return err
+	}
+	if model.IsScoringEvent(req.Type) {
+		if err := match.CheckScorable(); err != nil {
+			return err
+		}
+	}
+	if match.Status != model.MatchStatusInProgress {
+		return nil
+	}
+
+	if req.Quarter == 0 {
+		req.Quarter = int32(match.Period)
+	} else if req.Quarter != int32(match.Period) {
+		return myErrors.NewError(myErrors.CodeInvalidMatchTime,
+			fmt.Sprintf("节次与比赛进程不一致, 当前为第 %d 节", match.Period), "")
+	}
+
+	clock := match.ClockRemaining(time.Now())
+	if req.TimeRemaining == "" {
+		req.TimeRemaining = model.FormatClock(clock)
+		return nil
+	}
+	secs, err := model.ParseClock(req.TimeRemaining)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "剩余时间格式错误, 应为 mm:ss")
+	}
+	if d := time.Duration(secs) * time.Second; d < clock-clockLeadTolerance || d > clock+clockLagTolerance {
+		return myErrors.NewError(myErrors.CodeInvalidMatchTime,
+			"事件时间与比赛时钟不一致, 当前时钟 "+model.FormatClock(clock), "")
+	}
+	return nil
+}
+
+func (s *NBAService) getMatchState(matchID int64) (*model.Match, error) {
+	match, err := s.matchDao.GetState(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "比赛未找到")
+		}
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
+	}
+	return match, nil
+}
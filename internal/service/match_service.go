@@ -5,11 +5,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
+	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 
 	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/cache"
 	"nba-remake/internal/model"
 )
 
@@ -28,78 +34,370 @@ func (s *NBAService) ListMatches(ctx context.Context, req *pb.ListMatchesRequest
 }
 
 // GetMatch 查详情
+// 读穿透缓存: 消费者每处理完一个事件会删除 match:{id}, 下次读取时回源
 func (s *NBAService) GetMatch(ctx context.Context, req *pb.GetMatchRequest) (*pb.MatchResponse, error) {
-	cacheKey := fmt.Sprintf("match:%d", req.Id)
-
-	// 1. 先查 Redis
-	val, err := s.redisClient.Get(ctx, cacheKey).Result()
-	if err == nil {
-		// 缓存命中，直接反序列化返回
-		var resp pb.MatchResponse
-		err := json.Unmarshal([]byte(val), &resp)
+	resp, err := cache.Fetch(ctx, s.cache, cache.MatchKey(req.Id), cache.MatchTTL, func() (*pb.MatchResponse, error) {
+		match, err := s.matchDao.GetByID(req.Id)
 		if err != nil {
-			return nil, errors.New("缓存数据反序列化失败: " + err.Error())
+			return nil, err
 		}
-		return &resp, nil
+		return convertMatchToProto(match), nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "比赛未找到")
+		}
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
 	}
+	return withLiveClock(resp), nil
+}
 
-	// 2. 缓存未命中，查 MySQL
-	match, err := s.matchDao.GetByID(req.Id)
+// withLiveClock 缓存中的时钟是写入时刻的值, 时钟在走时按启动时刻重新推算剩余时间
+// 缓存对象可能被并发请求共享, 修改前先复制
+func withLiveClock(resp *pb.MatchResponse) *pb.MatchResponse {
+	if !resp.ClockRunning {
+		return resp
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, resp.ClockStartedAt)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "比赛未找到")
+		return resp
+	}
+	remaining := time.Duration(resp.ClockRemainingMs)*time.Millisecond - time.Since(startedAt)
+	live := proto.Clone(resp).(*pb.MatchResponse)
+	live.TimeRemaining = model.FormatClock(remaining)
+	return live
+}
+
+// WatchMatch 实时比分推送
+// 消费者处理完事件后发布到 Redis, 这里订阅后转发, 多个 gRPC 副本之间天然同步
+func (s *NBAService) WatchMatch(req *pb.GetMatchRequest, stream pb.NBAService_WatchMatchServer) error {
+	ctx := stream.Context()
+
+	// 1. 先订阅再取快照, 避免两者之间的更新丢失
+	sub := cache.SubscribeMatch(ctx, s.redisClient, req.Id)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		return status.Error(codes.Unavailable, "订阅实时比分失败: "+err.Error())
+	}
+
+	// 2. 推送当前快照
+	snapshot, err := s.matchSnapshot(req.Id)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(snapshot); err != nil {
+		return err
+	}
+
+	// 3. 转发后续更新, 直到客户端断开
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return status.Error(codes.Unavailable, "实时比分订阅已断开")
+			}
+			update, err := cache.DecodeMatchUpdate(msg.Payload)
+			if err != nil {
+				log.Printf("[WatchMatch] 消息解析失败 match_id=%d err=%v", req.Id, err)
+				continue
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// matchSnapshot 当前比分快照, 节次和时钟取权威时钟
+func (s *NBAService) matchSnapshot(matchID int64) (*pb.MatchUpdate, error) {
+	match, err := s.matchDao.GetByID(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "比赛未找到")
+		}
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
+	}
+
+	now := time.Now()
+	snapshot := &pb.MatchUpdate{
+		MatchId:      int64(match.ID),
+		HomeScore:    int32(match.HomeScore),
+		VisitorScore: int32(match.VisitorScore),
+		Status:       int32(match.Status),
+		UpdatedAt:    now.Format(time.RFC3339),
+	}
+	if match.Period > 0 {
+		remaining := match.ClockRemaining(now)
+		snapshot.Quarter = int32(match.Period)
+		snapshot.TimeRemaining = model.FormatClock(remaining)
+		snapshot.ClockRunning = match.ClockRunning
+		snapshot.ClockRemainingMs = remaining.Milliseconds()
+	} else if latest, err := s.matchDao.GetLatestEvent(matchID); err == nil {
+		// 没有比赛进程的历史数据, 取最近一条有效事件
+		snapshot.Quarter = int32(latest.Quarter)
+		snapshot.TimeRemaining = latest.TimeRemaining
 	}
-	return convertMatchToProto(match), nil
+	return snapshot, nil
 }
 
 // RecordMatchEvent 写入 Kafka
 func (s *NBAService) RecordMatchEvent(ctx context.Context, req *pb.RecordMatchEventRequest) (*pb.RecordMatchEventResponse, error) {
-	// 1. 校验 (现在 team_id 也是必填)
-	if req.MatchId == 0 || req.PlayerId == 0 || req.TeamId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "参数缺失: match_id, player_id, team_id 必填")
+	// 1. 校验
+	if err := validateEventRequest(req); err != nil {
+		return nil, err
+	}
+	if err := s.stampEventClock(req); err != nil {
+		return nil, err
+	}
+
+	// event_id 用于消费端幂等去重, 客户端重试时应复用同一个 ID
+	eventID, err := resolveEventID(req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 构造完整的 Payload 并发送
+	if err := s.publishEvent(req.MatchId, buildEventPayload(req, eventID)); err != nil {
+		return nil, err
+	}
+	return &pb.RecordMatchEventResponse{Success: true, Message: "已推送", EventId: eventID}, nil
+}
+
+// VoidMatchEvent 作废事件: 发送补偿事件, 由消费者冲正比分和技术统计
+func (s *NBAService) VoidMatchEvent(ctx context.Context, req *pb.VoidMatchEventRequest) (*pb.RecordMatchEventResponse, error) {
+	if req.MatchId == 0 || req.TargetEventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "参数缺失: match_id, target_event_id 必填")
+	}
+	targetEventID, err := resolveTargetEventID(req.TargetEventId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCorrectable(req.MatchId, targetEventID); err != nil {
+		return nil, err
+	}
+	eventID, err := resolveEventID(req.EventId)
+	if err != nil {
+		return nil, err
 	}
 
-	// 2. 构造完整的 Payload
-	// 消费者拿到这个 JSON 后，会解析并写入 match_events 表
 	payload := map[string]interface{}{
+		"event_id":        eventID,
+		"match_id":        req.MatchId,
+		"action":          model.EventActionVoid,
+		"target_event_id": targetEventID,
+		"reason":          req.Reason,
+	}
+	if err := s.publishEvent(req.MatchId, payload); err != nil {
+		return nil, err
+	}
+	return &pb.RecordMatchEventResponse{Success: true, Message: "作废已推送", EventId: eventID}, nil
+}
+
+// AmendMatchEvent 更正事件: 消费者在同一事务内作废原事件并写入更正后的事件
+func (s *NBAService) AmendMatchEvent(ctx context.Context, req *pb.AmendMatchEventRequest) (*pb.RecordMatchEventResponse, error) {
+	if req.TargetEventId == "" || req.Corrected == nil {
+		return nil, status.Error(codes.InvalidArgument, "参数缺失: target_event_id, corrected 必填")
+	}
+	corrected := req.Corrected
+	if err := validateEventRequest(corrected); err != nil {
+		return nil, err
+	}
+	if !model.IsReversible(corrected.Type) {
+		return nil, status.Error(codes.InvalidArgument, corrected.Type.String()+" 事件不支持更正")
+	}
+	if model.IsScoringEvent(corrected.Type) {
+		if err := s.checkScorable(corrected.MatchId); err != nil {
+			return nil, err
+		}
+	}
+	targetEventID, err := resolveTargetEventID(req.TargetEventId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCorrectable(corrected.MatchId, targetEventID); err != nil {
+		return nil, err
+	}
+	eventID, err := resolveEventID(corrected.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := buildEventPayload(corrected, eventID)
+	payload["action"] = model.EventActionAmend
+	payload["target_event_id"] = targetEventID
+	payload["reason"] = req.Reason
+	if err := s.publishEvent(corrected.MatchId, payload); err != nil {
+		return nil, err
+	}
+	return &pb.RecordMatchEventResponse{Success: true, Message: "更正已推送", EventId: eventID}, nil
+}
+
+// checkCorrectable 提前校验原事件是否可以作废/更正, 避免明显无效的补偿事件进入死信队列
+// 原事件可能仍在队列中未落库, 因此这里查不到时也放行, 交由消费者按顺序处理
+// 已结束比赛只能更正非计分事件, 消费者落库后会重新归档
+// targetEventID 须已经过 resolveTargetEventID 规范化
+func (s *NBAService) checkCorrectable(matchID int64, targetEventID string) error {
+	orig, err := s.matchDao.GetEventByEventID(targetEventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return status.Error(codes.Internal, "查询原事件失败: "+err.Error())
+	}
+	if orig.MatchID != uint64(matchID) {
+		return status.Error(codes.InvalidArgument, "原事件不属于该比赛")
+	}
+	if orig.Voided {
+		return status.Error(codes.FailedPrecondition, "原事件已被作废")
+	}
+	if !model.IsReversible(orig.Type) {
+		return status.Error(codes.FailedPrecondition, orig.Type.String()+" 事件不支持作废/更正")
+	}
+	if model.IsScoringEvent(orig.Type) {
+		match, err := s.getMatchState(matchID)
+		if err != nil {
+			return err
+		}
+		return match.CheckScorableAt(orig.Quarter)
+	}
+	return nil
+}
+
+// validateEventRequest 校验事件上报参数
+// 暂停/单节起止等事件不需要球员, 其余事件 player_id, team_id 必填; 单节开始必须带双方首发
+func validateEventRequest(req *pb.RecordMatchEventRequest) error {
+	if req.MatchId == 0 {
+		return status.Error(codes.InvalidArgument, "参数缺失: match_id 必填")
+	}
+	if model.RequiresPlayer(req.Type) && req.PlayerId == 0 {
+		return status.Error(codes.InvalidArgument, "参数缺失: "+req.Type.String()+" 事件 player_id 必填")
+	}
+	if model.RequiresTeam(req.Type) && req.TeamId == 0 {
+		return status.Error(codes.InvalidArgument, "参数缺失: "+req.Type.String()+" 事件 team_id 必填")
+	}
+	if err := model.ValidateEvent(req.Type, req.ShotType, int(req.Value)); err != nil {
+		return status.Error(codes.InvalidArgument, "事件数据不一致: "+err.Error())
+	}
+	if err := model.ValidateLineups(req.Type, lineupIDs(req.HomeLineup), lineupIDs(req.VisitorLineup)); err != nil {
+		return status.Error(codes.InvalidArgument, "首发数据有误: "+err.Error())
+	}
+	return nil
+}
+
+// lineupIDs 转换首发球员ID, 非正数按 0 处理 (校验时报错)
+func lineupIDs(ids []int32) []uint32 {
+	out := make([]uint32, len(ids))
+	for i, id := range ids {
+		out[i] = uint32(max(id, 0))
+	}
+	return out
+}
+
+// resolveEventID 校验客户端传入的事件ID, 为空时生成新的 UUID
+// 统一转成小写带连字符的标准格式, 大写或 urn:uuid: 前缀等写法不会绕过消费端去重
+func resolveEventID(eventID string) (string, error) {
+	if eventID == "" {
+		return uuid.NewString(), nil
+	}
+	parsed, err := uuid.Parse(eventID)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "event_id 必须是合法的 UUID")
+	}
+	return parsed.String(), nil
+}
+
+// resolveTargetEventID 校验并规范化被作废/更正的原事件ID, 与落库的 event_id 格式一致
+func resolveTargetEventID(eventID string) (string, error) {
+	parsed, err := uuid.Parse(eventID)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "target_event_id 必须是合法的 UUID")
+	}
+	return parsed.String(), nil
+}
+
+// buildEventPayload 构造 Kafka 消息体
+// 消费者拿到这个 JSON 后，会解析并写入 match_events 表
+func buildEventPayload(req *pb.RecordMatchEventRequest, eventID string) map[string]interface{} {
+	return map[string]interface{}{
+		"event_id":       eventID,
 		"match_id":       req.MatchId,
 		"player_id":      req.PlayerId,
-		"team_id":        req.TeamId, // 新增
+		"team_id":        req.TeamId,
 		"type":           req.Type,
-		"sub_type":       req.SubType, // 新增
+		"shot_type":      req.ShotType,
+		"sub_type":       req.SubType,
 		"value":          req.Value,
-		"quarter":        req.Quarter,       // 新增
-		"time_remaining": req.TimeRemaining, // 新增
+		"quarter":        req.Quarter,
+		"time_remaining": req.TimeRemaining,
 		"event_time":     req.EventTime,
+		"home_lineup":    lineupIDs(req.HomeLineup),
+		"visitor_lineup": lineupIDs(req.VisitorLineup),
 	}
+}
 
+// publishEvent 发送 Kafka
+// Key: MatchId (确保同一场比赛的消息顺序一致, 补偿事件一定排在原事件之后)
+func (s *NBAService) publishEvent(matchID int64, payload map[string]interface{}) error {
 	data, err := json.Marshal(payload)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "JSON序列化失败")
+		return status.Error(codes.Internal, "JSON序列化失败")
 	}
-
-	// 3. 发送 Kafka
-	// Topic: "nba_match_events"
-	// Key: MatchId (确保同一场比赛的消息顺序一致)
-	err = s.kafkaProducer.Send(fmt.Sprintf("%d", req.MatchId), data)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, "MQ 服务不可用")
+	if err := s.kafkaProducer.Send(fmt.Sprintf("%d", matchID), data); err != nil {
+		return status.Error(codes.Unavailable, "MQ 服务不可用")
 	}
-
-	return &pb.RecordMatchEventResponse{Success: true, Message: "已推送"}, nil
+	return nil
 }
 
 // convertMatchToProto 辅助方法
 func convertMatchToProto(m *model.Match) *pb.MatchResponse {
-	return &pb.MatchResponse{
-		Id:            int64(m.ID),
-		Date:          m.Date.Format("2006-01-02"),
-		HomeTeamId:    int32(m.HomeTeamID),
-		VisitorTeamId: int32(m.VisitorTeamID),
-		HomeScore:     int32(m.HomeScore),
-		VisitorScore:  int32(m.VisitorScore),
-		Status:        int32(m.Status),
-		StartTime:     m.StartTime.Format("15:04"), // 显示几点开始
-		HomeTeam:      convertTeamModelToProto(&m.HomeTeam),
-		VisitorTeam:   convertTeamModelToProto(&m.VisitorTeam),
+	resp := &pb.MatchResponse{
+		Id:               int64(m.ID),
+		Date:             m.Date.Format("2006-01-02"),
+		HomeTeamId:       int32(m.HomeTeamID),
+		VisitorTeamId:    int32(m.VisitorTeamID),
+		HomeScore:        int32(m.HomeScore),
+		VisitorScore:     int32(m.VisitorScore),
+		Status:           int32(m.Status),
+		StartTime:        m.StartTime.Format("15:04"), // 显示几点开始
+		HomeTeam:         convertTeamModelToProto(&m.HomeTeam),
+		VisitorTeam:      convertTeamModelToProto(&m.VisitorTeam),
+		Period:           int32(m.Period),
+		PeriodEnded:      m.PeriodEnded,
+		PostponeReason:   m.PostponeReason,
+		Season:           m.Season,
+		CancelReason:     m.CancelReason,
+		TimeRemaining:    model.FormatClock(m.ClockRemaining(time.Now())),
+		ClockRunning:     m.ClockRunning,
+		ClockRemainingMs: m.ClockRemainingMs,
+	}
+	if m.ClockStartedAt != nil {
+		resp.ClockStartedAt = m.ClockStartedAt.Format(time.RFC3339Nano)
+	}
+	resp.PeriodScores = convertPeriodScores(m)
+	return resp
+}
+
+// convertPeriodScores 单节比分, 已开始的节次即使没有得分也补 0
+func convertPeriodScores(m *model.Match) []*pb.PeriodScore {
+	n := int(m.Period)
+	for _, ps := range m.PeriodScores {
+		if int(ps.Period) > n {
+			n = int(ps.Period)
+		}
+	}
+	scores := make([]*pb.PeriodScore, n)
+	for i := range scores {
+		scores[i] = &pb.PeriodScore{Period: int32(i + 1)}
+	}
+	for _, ps := range m.PeriodScores {
+		if ps.Period < 1 {
+			continue
+		}
+		scores[ps.Period-1].HomeScore = int32(ps.HomeScore)
+		scores[ps.Period-1].VisitorScore = int32(ps.VisitorScore)
 	}
+	return scores
 }
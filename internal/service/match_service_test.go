@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveEventID(t *testing.T) {
+	const canonical = "6f1c2a7e-3b7d-4c1e-9a51-0d3f6b2c8e11"
+	// 同一个 UUID 的不同写法都应规范成相同的 event_id, 否则消费端按 event_id 去重会失效
+	for _, in := range []string{
+		canonical,
+		"6F1C2A7E-3B7D-4C1E-9A51-0D3F6B2C8E11",
+		"urn:uuid:6f1c2a7e-3b7d-4c1e-9a51-0d3f6b2c8e11",
+		"{6f1c2a7e-3b7d-4c1e-9a51-0d3f6b2c8e11}",
+		"6f1c2a7e3b7d4c1e9a510d3f6b2c8e11",
+	} {
+		if got, err := resolveEventID(in); err != nil || got != canonical {
+			t.Errorf("resolveEventID(%q) = %q, %v, want %q", in, got, err, canonical)
+		}
+		if got, err := resolveTargetEventID(in); err != nil || got != canonical {
+			t.Errorf("resolveTargetEventID(%q) = %q, %v, want %q", in, got, err, canonical)
+		}
+	}
+
+	if got, err := resolveEventID(""); err != nil || len(got) != len(canonical) {
+		t.Errorf("resolveEventID(\"\") = %q, %v, want 新生成的 UUID", got, err)
+	}
+	if _, err := resolveEventID("not-a-uuid"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("非法 event_id 应返回 InvalidArgument, got %v", err)
+	}
+	// 原事件ID必填, 不能为空
+	for _, in := range []string{"", "not-a-uuid"} {
+		if _, err := resolveTargetEventID(in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("resolveTargetEventID(%q) 应返回 InvalidArgument, got %v", in, err)
+		}
+	}
+}
@@ -6,35 +6,59 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/config"
 	"nba-remake/internal/dao"
+	"nba-remake/internal/es"
 	"nba-remake/internal/model"
+	"nba-remake/internal/mongodb"
 	"nba-remake/internal/mq"
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type NBAService struct {
 	pb.UnimplementedNBAServiceServer
 	playerDao     *dao.PlayerDao
 	teamDao       *dao.TeamDao
+	leagueDao     *dao.LeagueDao
 	matchDao      *dao.MatchDao
+	statsDao      *dao.StatsDao
 	kafkaProducer *mq.Producer
+	dlqReplayer   *mq.DeadLetterReplayer
 	redisClient   *redis.Client
+	cache         *cache.Store
 	mongodbClient *mongo.Client
 	esClient      *elasticsearch.Client
+	playerIndex   *es.PlayerIndex
+	eventIndex    *es.EventIndex
+	gameStore     *mongodb.GameStore
+	salaryCap     config.SalaryCapConfig
 }
 
-func NewNBAService(playerDao *dao.PlayerDao, teamDao *dao.TeamDao, matchDao *dao.MatchDao, kafkaProducer *mq.Producer, redisClient *redis.Client, mongodbClient *mongo.Client, esClient *elasticsearch.Client) *NBAService {
+func NewNBAService(playerDao *dao.PlayerDao, teamDao *dao.TeamDao, leagueDao *dao.LeagueDao, matchDao *dao.MatchDao, statsDao *dao.StatsDao, kafkaProducer *mq.Producer, dlqReplayer *mq.DeadLetterReplayer, redisClient *redis.Client, mongodbClient *mongo.Client, esClient *elasticsearch.Client, playerIndex *es.PlayerIndex, eventIndex *es.EventIndex, gameStore *mongodb.GameStore, salaryCap config.SalaryCapConfig) *NBAService {
 	return &NBAService{
 		playerDao:     playerDao,
 		teamDao:       teamDao,
+		leagueDao:     leagueDao,
 		matchDao:      matchDao,
+		statsDao:      statsDao,
 		kafkaProducer: kafkaProducer,
+		dlqReplayer:   dlqReplayer,
 		redisClient:   redisClient,
+		cache:         cache.NewStore(redisClient),
 		mongodbClient: mongodbClient,
 		esClient:      esClient,
+		playerIndex:   playerIndex,
+		eventIndex:    eventIndex,
+		gameStore:     gameStore,
+		salaryCap:     salaryCap,
 	}
 }
 
+// CreatePlayer 创建球员; 指定 team_id 时按标准合同签入该队阵容 (校验球衣号和名额)
 func (s *NBAService) CreatePlayer(ctx context.Context, req *pb.CreatePlayerRequest) (*pb.PlayerResponse, error) {
 	birthday, err := time.Parse("2006-01-02", req.Birthday)
 	if err != nil {
@@ -49,77 +73,119 @@ func (s *NBAService) CreatePlayer(ctx context.Context, req *pb.CreatePlayerReque
 		Weight:       req.Weight,
 		Birthday:     &birthday,
 		Status:       req.Status,
+		NoTrade:      req.NoTradeClause,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
-	err = s.playerDao.CreatePlayer(p)
+	p.SetAliases(req.Aliases)
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		if req.TeamId != 0 {
+			roster, err := lockRoster(txDao, uint32(req.TeamId))
+			if err != nil {
+				return err
+			}
+			if err := roster.CheckJersey(req.JerseyNumber, 0); err != nil {
+				return err
+			}
+			if err := roster.CheckSlot(pb.RosterSlot_STANDARD); err != nil {
+				return err
+			}
+			p.Join(roster.TeamID, req.JerseyNumber, pb.RosterSlot_STANDARD)
+		}
+		if err := p.CheckStatus(); err != nil {
+			return err
+		}
+		if err := txDao.CreatePlayer(p); err != nil {
+			return err
+		}
+		if p.TeamID == 0 {
+			return nil
+		}
+		return txDao.Transactions().Record(signing(p, today()))
+	})
 	if err != nil {
-		return nil, err
-	}
-	resp := &pb.PlayerResponse{
-		Id:           int32(p.ID),
-		Name:         p.Name,
-		JerseyNumber: req.JerseyNumber,
-		Position:     req.Position,
-		Height:       req.Height,
-		Weight:       req.Weight,
-		Birthday:     req.Birthday,
-		Status:       req.Status,
-		CreatedAt:    p.CreatedAt.Format("time.RFC3339"),
-		UpdatedAt:    p.UpdatedAt.Format(time.RFC3339),
+		return nil, playerError(err)
 	}
-	return resp, nil
+	s.playerDao.SyncIndex(p)
+	return convertPlayerModelToProto(p), nil
 }
 
 func (s *NBAService) GetPlayer(ctx context.Context, req *pb.GetPlayerRequest) (*pb.PlayerResponse, error) {
-	player, err := s.playerDao.GetPlayerByID(uint32(req.Id))
-	if err != nil {
-		return nil, err
-	}
-	resp := &pb.PlayerResponse{
-		Id:           int32(player.ID),
-		Name:         player.Name,
-		JerseyNumber: int32(player.JerseyNumber),
-		Position:     player.Position,
-		Height:       player.Height,
-		Weight:       player.Weight,
-		Birthday:     player.Birthday.Format("2006-01-02"),
-		Status:       player.Status,
-		CreatedAt:    player.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:    player.UpdatedAt.Format(time.RFC3339),
-	}
-	return resp, nil
+	return cache.Fetch(ctx, s.cache, cache.PlayerKey(req.Id), cache.PlayerTTL, func() (*pb.PlayerResponse, error) {
+		player, err := s.playerDao.GetPlayerByID(uint32(req.Id))
+		if err != nil {
+			return nil, err
+		}
+		return convertPlayerModelToProto(player), nil
+	})
 }
 
+// UpdatePlayer 更新球员信息; 换队走 AddToRoster/ReleasePlayer, 这里忽略 team_id
+// 在阵容中的球员改号时校验队内唯一
 func (s *NBAService) UpdatePlayer(ctx context.Context, req *pb.UpdatePlayerRequest) (*pb.PlayerResponse, error) {
-	player, err := s.playerDao.GetPlayerByID(uint32(req.Id))
-	if err != nil {
-		return nil, err
-	}
-	player.Name = req.Name
-	player.JerseyNumber = uint8(req.JerseyNumber)
-	player.Position = req.Position
-	player.Height = req.Height
-	player.Weight = req.Weight
-	player.Status = req.Status
-	player.UpdatedAt = time.Now()
-	err = s.playerDao.UpdatePlayer(player)
+	var player *model.Player
+	err := s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		current, err := txDao.GetPlayerByID(uint32(req.Id))
+		if err != nil {
+			return err
+		}
+		// 先锁球队再锁球员, 与阵容变更的加锁顺序一致
+		var roster *model.Roster
+		if current.TeamID != 0 {
+			if roster, err = lockRoster(txDao, current.TeamID); err != nil {
+				return err
+			}
+		}
+		if player, err = txDao.GetForUpdate(current.ID); err != nil {
+			return err
+		}
+		if player.TeamID != current.TeamID {
+			return status.Error(codes.Aborted, "球员阵容已变化, 请重试")
+		}
+		if roster != nil && int32(player.JerseyNumber) != req.JerseyNumber {
+			if err := roster.CheckJersey(req.JerseyNumber, player.ID); err != nil {
+				return err
+			}
+		}
+
+		assigned := player.Status == pb.PlayerStatus_ASSIGNED
+		player.Name = req.Name
+		player.JerseyNumber = uint8(req.JerseyNumber)
+		player.Position = req.Position
+		player.Height = req.Height
+		player.Weight = req.Weight
+		player.Status = req.Status
+		player.NoTrade = req.NoTradeClause
+		player.SetAliases(req.Aliases)
+		player.UpdatedAt = time.Now()
+		if err := player.CheckStatus(); err != nil {
+			return err
+		}
+		if err := txDao.UpdatePlayer(player); err != nil {
+			return err
+		}
+		// 下放/召回记入异动记录
+		if nowAssigned := player.Status == pb.PlayerStatus_ASSIGNED; nowAssigned != assigned {
+			kind := pb.TransactionType_G_LEAGUE_RECALL
+			if nowAssigned {
+				kind = pb.TransactionType_G_LEAGUE_ASSIGNMENT
+			}
+			return txDao.Transactions().Record(&model.PlayerTransaction{
+				PlayerID:      player.ID,
+				Type:          kind,
+				FromTeamID:    player.TeamID,
+				ToTeamID:      player.TeamID,
+				EffectiveDate: today(),
+			})
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, playerError(err)
 	}
-	resp := &pb.PlayerResponse{
-		Id:           int32(player.ID),
-		Name:         player.Name,
-		JerseyNumber: int32(player.JerseyNumber),
-		Position:     player.Position,
-		Height:       player.Height,
-		Weight:       player.Weight,
-		Birthday:     player.Birthday.Format("2006-01-02"),
-		Status:       player.Status,
-		CreatedAt:    player.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:    player.UpdatedAt.Format(time.RFC3339),
-	}
-	return resp, nil
+	s.playerDao.SyncIndex(player)
+	s.cache.Invalidate(ctx, cache.PlayerKey(req.Id))
+	return convertPlayerModelToProto(player), nil
 }
 
 func (s *NBAService) DeletePlayer(ctx context.Context, req *pb.DeletePlayerRequest) (*pb.DeletePlayerResponse, error) {
@@ -127,6 +193,7 @@ func (s *NBAService) DeletePlayer(ctx context.Context, req *pb.DeletePlayerReque
 	if err != nil {
 		return nil, err
 	}
+	s.cache.Invalidate(ctx, cache.PlayerKey(req.Id))
 	return &pb.DeletePlayerResponse{Success: true}, nil
 }
 
@@ -147,24 +214,88 @@ func (s *NBAService) ListPlayers(ctx context.Context, req *pb.ListPlayersRequest
 
 	pbPlayers := make([]*pb.PlayerResponse, len(players))
 	for i, player := range players {
+		pbPlayers[i] = convertPlayerModelToProto(player)
+	}
+	return &pb.ListPlayersResponse{
+		Players:  pbPlayers,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// SearchPlayers 全文搜索球员 (ES), 支持拼音/别名/拼写错误, 并返回分面统计
+func (s *NBAService) SearchPlayers(ctx context.Context, req *pb.SearchPlayersRequest) (*pb.SearchPlayersResponse, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	query := es.PlayerQuery{
+		Keyword: req.Query,
+		TeamID:  uint32(req.TeamId),
+		Status:  int32(req.Status),
+		From:    (page - 1) * pageSize,
+		Size:    pageSize,
+	}
+	if req.Position != pb.Position_POSITION_UNKNOWN {
+		query.Position = req.Position.String()
+	}
+
+	result, err := s.playerIndex.Search(query)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "搜索服务不可用")
+	}
+
+	pbPlayers := make([]*pb.PlayerResponse, len(result.Players))
+	for i, doc := range result.Players {
 		pbPlayers[i] = &pb.PlayerResponse{
-			Id:           int32(player.ID),
-			Name:         player.Name,
-			JerseyNumber: int32(player.JerseyNumber),
-			Position:     player.Position,
-			Height:       player.Height,
-			Weight:       player.Weight,
-			Birthday:     player.Birthday.Format("2006-01-02"),
-			Status:       player.Status,
-			CreatedAt:    player.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:    player.UpdatedAt.Format(time.RFC3339),
+			Id:           int32(doc.ID),
+			Name:         doc.Name,
+			JerseyNumber: int32(doc.JerseyNumber),
+			Position:     pb.Position(pb.Position_value[doc.Position]),
+			Height:       doc.Height,
+			Weight:       doc.Weight,
+			Birthday:     doc.Birthday,
+			Status:       pb.PlayerStatus(doc.Status),
+			Aliases:      doc.Aliases,
+			CreatedAt:    doc.CreatedAt,
+			UpdatedAt:    doc.UpdatedAt,
 		}
 	}
 
-	return &pb.ListPlayersResponse{
+	return &pb.SearchPlayersResponse{
 		Players:  pbPlayers,
-		Total:    int32(total),
+		Total:    result.Total,
 		Page:     int32(page),
 		PageSize: int32(pageSize),
+		Facets:   convertFacets(result.Facets),
 	}, nil
 }
+
+// convertPlayerModelToProto 辅助方法, 没有生日时为空字符串
+func convertPlayerModelToProto(p *model.Player) *pb.PlayerResponse {
+	resp := &pb.PlayerResponse{
+		Id:            int32(p.ID),
+		TeamId:        int32(p.TeamID),
+		Name:          p.Name,
+		JerseyNumber:  int32(p.JerseyNumber),
+		Position:      p.Position,
+		Height:        p.Height,
+		Weight:        p.Weight,
+		Status:        p.Status,
+		Aliases:       p.AliasList(),
+		RosterSlot:    p.Slot(),
+		NoTradeClause: p.NoTrade,
+		CreatedAt:     p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     p.UpdatedAt.Format(time.RFC3339),
+	}
+	if p.Birthday != nil {
+		resp.Birthday = p.Birthday.Format("2006-01-02")
+	}
+	return resp
+}
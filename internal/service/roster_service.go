@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
+)
+
+// GetPlayersByTeam 球队当前阵容 (不分页)
+func (s *NBAService) GetPlayersByTeam(ctx context.Context, req *pb.GetPlayersByTeamRequest) (*pb.ListPlayersResponse, error) {
+	roster, err := s.getRoster(req.TeamId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListPlayersResponse{Total: int32(len(roster.Players)), Page: 1, PageSize: int32(len(roster.Players))}
+	for _, p := range roster.Players {
+		resp.Players = append(resp.Players, convertPlayerModelToProto(p))
+	}
+	return resp, nil
+}
+
+// GetRoster 球队阵容及名额使用情况
+func (s *NBAService) GetRoster(ctx context.Context, req *pb.GetRosterRequest) (*pb.RosterResponse, error) {
+	roster, err := s.getRoster(req.TeamId)
+	if err != nil {
+		return nil, err
+	}
+	return convertRosterToProto(roster), nil
+}
+
+// AddToRoster 自由球员签入球队阵容, 队内球衣号唯一, 名额已满返回 CodeTeamFull
+func (s *NBAService) AddToRoster(ctx context.Context, req *pb.AddToRosterRequest) (*pb.PlayerResponse, error) {
+	slot := req.Slot
+	if slot == pb.RosterSlot_ROSTER_SLOT_UNKNOWN {
+		slot = pb.RosterSlot_STANDARD
+	}
+	date, err := parseEffectiveDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	var player *model.Player
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		roster, err := lockRoster(txDao, uint32(req.TeamId))
+		if err != nil {
+			return err
+		}
+		if player, err = txDao.GetForUpdate(uint32(req.PlayerId)); err != nil {
+			return err
+		}
+		switch {
+		case player.Status == pb.PlayerStatus_RETIRED:
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "球员已退役", "")
+		case player.TeamID == roster.TeamID:
+			return myErrors.NewError(myErrors.CodePlayerInUse, "球员已在该队阵容中", "")
+		case player.TeamID != 0:
+			return myErrors.NewError(myErrors.CodePlayerInUse, fmt.Sprintf("球员在球队 %d 的阵容中, 需先裁掉或通过交易转会", player.TeamID), "")
+		}
+		if err := roster.CheckJersey(req.JerseyNumber, 0); err != nil {
+			return err
+		}
+		if err := roster.CheckSlot(slot); err != nil {
+			return err
+		}
+		player.Join(roster.TeamID, req.JerseyNumber, slot)
+		player.UpdatedAt = time.Now()
+		if err := txDao.UpdatePlayer(player); err != nil {
+			return err
+		}
+		return txDao.Transactions().Record(signing(player, date))
+	})
+	if err != nil {
+		return nil, playerError(err)
+	}
+	s.playerDao.SyncIndex(player)
+	s.cache.Invalidate(ctx, cache.PlayerKey(req.PlayerId))
+	return convertPlayerModelToProto(player), nil
+}
+
+// ReleasePlayer 裁掉球员, 球员成为自由球员
+func (s *NBAService) ReleasePlayer(ctx context.Context, req *pb.ReleasePlayerRequest) (*pb.PlayerResponse, error) {
+	date, err := parseEffectiveDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	kind := pb.TransactionType_RELEASE
+	if req.Waive {
+		kind = pb.TransactionType_WAIVER
+	}
+
+	var player *model.Player
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		current, err := txDao.GetPlayerByID(uint32(req.PlayerId))
+		if err != nil {
+			return err
+		}
+		if current.TeamID == 0 {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "球员不在任何球队阵容中", "")
+		}
+		if _, err := lockRoster(txDao, current.TeamID); err != nil {
+			return err
+		}
+		if player, err = txDao.GetForUpdate(current.ID); err != nil {
+			return err
+		}
+		if player.TeamID != current.TeamID {
+			return status.Error(codes.Aborted, "球员阵容已变化, 请重试")
+		}
+		player.Release()
+		player.UpdatedAt = time.Now()
+		if err := txDao.UpdatePlayer(player); err != nil {
+			return err
+		}
+		// 合同留在原球队, 之后只计保障部分
+		if err := txDao.Contracts().Waive(player.ID, current.TeamID, date); err != nil {
+			return err
+		}
+		return txDao.Transactions().Record(&model.PlayerTransaction{
+			PlayerID:      player.ID,
+			Type:          kind,
+			FromTeamID:    current.TeamID,
+			EffectiveDate: date,
+		})
+	})
+	if err != nil {
+		return nil, playerError(err)
+	}
+	s.playerDao.SyncIndex(player)
+	s.cache.Invalidate(ctx, cache.PlayerKey(req.PlayerId))
+	return convertPlayerModelToProto(player), nil
+}
+
+// ChangeRosterSlot 标准合同与双向合同互转, 目标名额需有空余
+func (s *NBAService) ChangeRosterSlot(ctx context.Context, req *pb.ChangeRosterSlotRequest) (*pb.PlayerResponse, error) {
+	date, err := parseEffectiveDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	var player *model.Player
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		current, err := txDao.GetPlayerByID(uint32(req.PlayerId))
+		if err != nil {
+			return err
+		}
+		if current.TeamID == 0 {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "球员不在任何球队阵容中", "")
+		}
+		roster, err := lockRoster(txDao, current.TeamID)
+		if err != nil {
+			return err
+		}
+		if player, err = txDao.GetForUpdate(current.ID); err != nil {
+			return err
+		}
+		if player.TeamID != current.TeamID {
+			return status.Error(codes.Aborted, "球员阵容已变化, 请重试")
+		}
+		if player.Slot() == req.Slot {
+			return myErrors.NewError(myErrors.CodeInvalidPlayerData, "球员已是该名额类型", req.Slot.String())
+		}
+		if err := roster.CheckSlot(req.Slot); err != nil {
+			return err
+		}
+		player.RosterSlot = req.Slot
+		player.UpdatedAt = time.Now()
+		if err := txDao.UpdatePlayer(player); err != nil {
+			return err
+		}
+		return txDao.Transactions().Record(&model.PlayerTransaction{
+			PlayerID:      player.ID,
+			Type:          pb.TransactionType_TWO_WAY_CONVERSION,
+			FromTeamID:    player.TeamID,
+			ToTeamID:      player.TeamID,
+			EffectiveDate: date,
+			Note:          req.Slot.String(),
+		})
+	})
+	if err != nil {
+		return nil, playerError(err)
+	}
+	s.playerDao.SyncIndex(player)
+	s.cache.Invalidate(ctx, cache.PlayerKey(req.PlayerId))
+	return convertPlayerModelToProto(player), nil
+}
+
+// getRoster 查阵容前确认球队存在
+func (s *NBAService) getRoster(teamID int32) (*model.Roster, error) {
+	if _, err := s.teamDao.GetByID(teamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, myErrors.NewError(myErrors.CodeTeamNotFound, "球队不存在", "")
+		}
+		return nil, status.Error(codes.Internal, "查询球队失败: "+err.Error())
+	}
+	roster, err := s.playerDao.GetRoster(uint32(teamID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询阵容失败: "+err.Error())
+	}
+	return roster, nil
+}
+
+// lockRoster 锁定球队阵容, 球队不存在时返回业务错误 (避免与球员不存在混淆)
+func lockRoster(txDao *dao.PlayerDao, teamID uint32) (*model.Roster, error) {
+	roster, err := txDao.LockRoster(teamID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, myErrors.NewError(myErrors.CodeTeamNotFound, fmt.Sprintf("球队不存在: %d", teamID), "")
+	}
+	return roster, err
+}
+
+// playerError 业务错误和 gRPC 错误原样返回, 其余转换为 gRPC 错误
+func playerError(err error) error {
+	var appErr *myErrors.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return myErrors.NewError(myErrors.CodePlayerNotFound, "球员不存在", "")
+	}
+	return status.Error(codes.Internal, "保存球员失败: "+err.Error())
+}
+
+// convertRosterToProto 辅助方法
+func convertRosterToProto(r *model.Roster) *pb.RosterResponse {
+	resp := &pb.RosterResponse{
+		TeamId:        int32(r.TeamID),
+		StandardCount: int32(r.Count(pb.RosterSlot_STANDARD)),
+		TwoWayCount:   int32(r.Count(pb.RosterSlot_TWO_WAY)),
+		AssignedCount: int32(r.Assigned()),
+		MaxStandard:   model.MaxStandardRoster,
+		MaxTwoWay:     model.MaxTwoWayRoster,
+	}
+	for _, p := range r.Players {
+		resp.Players = append(resp.Players, convertPlayerModelToProto(p))
+	}
+	return resp
+}
@@ -0,0 +1,435 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
+)
+
+// CreateMatch 新建比赛
+func (s *NBAService) CreateMatch(ctx context.Context, req *pb.CreateMatchRequest) (*pb.MatchResponse, error) {
+	teams, err := s.teamDao.GetByIDs([]uint32{uint32(req.HomeTeamId), uint32(req.VisitorTeamId)})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球队失败: "+err.Error())
+	}
+	match, err := newScheduledMatch(req.Date, req.StartTime, uint32(req.HomeTeamId), uint32(req.VisitorTeamId), req.Season, teams)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.matchDao.WithTx(func(txDao *dao.MatchDao) error {
+		if err := checkScheduleConflicts(txDao, []*model.Match{match}, 0, teams); err != nil {
+			return err
+		}
+		return txDao.CreateMatches([]*model.Match{match})
+	})
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	return s.reloadMatch(int64(match.ID))
+}
+
+// UpdateMatch 修改赛程 (改期/更换对阵/修正赛季), 只允许未开始或延期的比赛
+func (s *NBAService) UpdateMatch(ctx context.Context, req *pb.UpdateMatchRequest) (*pb.MatchResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数缺失: id 必填")
+	}
+
+	err := s.matchDao.WithTx(func(txDao *dao.MatchDao) error {
+		match, err := txDao.GetForUpdate(req.Id)
+		if err != nil {
+			return err
+		}
+		if err := match.CheckEditable(); err != nil {
+			return err
+		}
+
+		date := match.Date.Format("2006-01-02")
+		if req.Date != "" {
+			date = req.Date
+		}
+		startTime := match.StartTime.Format("15:04")
+		if req.StartTime != "" {
+			startTime = req.StartTime
+		}
+		homeID, visitorID := uint32(match.HomeTeamID), uint32(match.VisitorTeamID)
+		if req.HomeTeamId != 0 {
+			homeID = uint32(req.HomeTeamId)
+		}
+		if req.VisitorTeamId != 0 {
+			visitorID = uint32(req.VisitorTeamId)
+		}
+		season := req.Season
+		if season == "" && req.Date == "" {
+			season = match.Season
+		}
+
+		teams, err := s.teamDao.GetByIDs([]uint32{homeID, visitorID})
+		if err != nil {
+			return err
+		}
+		updated, err := newScheduledMatch(date, startTime, homeID, visitorID, season, teams)
+		if err != nil {
+			return err
+		}
+		match.Date, match.StartTime, match.Season = updated.Date, updated.StartTime, updated.Season
+		match.HomeTeamID, match.VisitorTeamID = updated.HomeTeamID, updated.VisitorTeamID
+		if req.Date != "" || req.StartTime != "" {
+			match.Reschedule()
+		}
+
+		if match.OccupiesDate() {
+			if err := checkScheduleConflicts(txDao, []*model.Match{match}, match.ID, teams); err != nil {
+				return err
+			}
+		}
+		return txDao.UpdateSchedule(match)
+	})
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	s.cache.Invalidate(ctx, cache.MatchKey(req.Id))
+	return s.reloadMatch(req.Id)
+}
+
+// CancelMatch 取消比赛 (仅限开赛前)
+func (s *NBAService) CancelMatch(ctx context.Context, req *pb.MatchTransitionRequest) (*pb.MatchResponse, error) {
+	return s.transitionMatch(ctx, req.MatchId, func(m *model.Match) error {
+		return m.Cancel(req.Reason)
+	})
+}
+
+// scheduleRow 导入赛程的一行, home/visitor 为球队ID或缩写
+type scheduleRow struct {
+	Date      string `json:"date"`
+	StartTime string `json:"start_time"`
+	Home      string `json:"home"`
+	Visitor   string `json:"visitor"`
+	Season    string `json:"season"`
+}
+
+// UnmarshalJSON 允许 home/visitor 直接写数字ID
+func (r *scheduleRow) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	str := func(key string) string {
+		switch v := raw[key].(type) {
+		case string:
+			return v
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			return ""
+		}
+	}
+	*r = scheduleRow{Date: str("date"), StartTime: str("start_time"), Home: str("home"), Visitor: str("visitor"), Season: str("season")}
+	return nil
+}
+
+// ImportSchedule 批量导入赛程
+// 先逐行校验 (球队/日期/赛季), 再检查批次内和数据库中的同日冲突; 任一行有错误时整批不写入
+func (s *NBAService) ImportSchedule(ctx context.Context, req *pb.ImportScheduleRequest) (*pb.ImportScheduleResponse, error) {
+	rows, err := parseScheduleRows(req.Format, req.Data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(rows) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "导入数据为空")
+	}
+
+	allTeams, err := s.teamDao.GetAll()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球队失败: "+err.Error())
+	}
+	teams := make(map[uint32]*model.Team, len(allTeams))
+	byAbbr := make(map[string]*model.Team, len(allTeams))
+	for _, t := range allTeams {
+		teams[t.ID] = t
+		byAbbr[t.Abbreviation] = t
+	}
+	resolve := func(ref string) uint32 {
+		ref = strings.TrimSpace(ref)
+		if id, err := strconv.ParseUint(ref, 10, 32); err == nil {
+			return uint32(id)
+		}
+		if t, ok := byAbbr[strings.ToUpper(ref)]; ok {
+			return t.ID
+		}
+		return 0
+	}
+
+	resp := &pb.ImportScheduleResponse{DryRun: req.DryRun}
+	rowErr := func(row int, msg string) {
+		resp.Errors = append(resp.Errors, &pb.ScheduleRowError{Row: int32(row), Message: msg})
+	}
+
+	matches := make([]*model.Match, 0, len(rows))
+	rowOf := make(map[*model.Match]int, len(rows))
+	for i, r := range rows {
+		homeID, visitorID := resolve(r.Home), resolve(r.Visitor)
+		if homeID == 0 || visitorID == 0 {
+			rowErr(i+1, fmt.Sprintf("球队不存在: %s / %s", r.Home, r.Visitor))
+			continue
+		}
+		match, err := newScheduledMatch(strings.TrimSpace(r.Date), strings.TrimSpace(r.StartTime), homeID, visitorID, strings.TrimSpace(r.Season), teams)
+		if err != nil {
+			rowErr(i+1, errorMessage(err))
+			continue
+		}
+		matches = append(matches, match)
+		rowOf[match] = i + 1
+	}
+
+	// 批次内冲突: 同一球队同一天只能有一场
+	seen := make(map[string]int)
+	for _, m := range matches {
+		for _, teamID := range []uint{m.HomeTeamID, m.VisitorTeamID} {
+			key := m.Date.Format("2006-01-02") + "|" + strconv.Itoa(int(teamID))
+			if first, ok := seen[key]; ok {
+				rowErr(rowOf[m], fmt.Sprintf("%s 在 %s 已有比赛 (第 %d 行)", teams[uint32(teamID)].Abbreviation, m.Date.Format("2006-01-02"), first))
+				continue
+			}
+			seen[key] = rowOf[m]
+		}
+	}
+
+	// 与数据库已有比赛的冲突; 整批在同一事务中检查并写入
+	err = s.matchDao.WithTx(func(txDao *dao.MatchDao) error {
+		if len(resp.Errors) == 0 {
+			existing, err := findTeamGames(txDao, matches, 0)
+			if err != nil {
+				return err
+			}
+			for _, m := range matches {
+				if conflict := existing.conflictOf(m, teams); conflict != "" {
+					rowErr(rowOf[m], conflict)
+				}
+			}
+		}
+		if len(resp.Errors) > 0 || req.DryRun {
+			return nil
+		}
+		return txDao.CreateMatches(matches)
+	})
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	if len(resp.Errors) > 0 {
+		return resp, nil
+	}
+
+	for _, m := range matches {
+		m.HomeTeam, m.VisitorTeam = *teams[uint32(m.HomeTeamID)], *teams[uint32(m.VisitorTeamID)]
+		resp.Matches = append(resp.Matches, convertMatchToProto(m))
+	}
+	if !req.DryRun {
+		resp.Created = int32(len(matches))
+	}
+	return resp, nil
+}
+
+// parseScheduleRows 解析 CSV (首行为表头, 列顺序不限) 或 JSON 数组
+func parseScheduleRows(format string, data []byte) ([]scheduleRow, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		var rows []scheduleRow
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, fmt.Errorf("JSON 格式错误: %v", err)
+		}
+		return rows, nil
+	case "csv", "":
+		r := csv.NewReader(bytes.NewReader(data))
+		r.TrimLeadingSpace = true
+		header, err := r.Read()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV 格式错误: %v", err)
+		}
+		cols := make(map[string]int, len(header))
+		for i, h := range header {
+			cols[strings.ToLower(strings.TrimSpace(h))] = i
+		}
+		for _, required := range []string{"date", "start_time", "home", "visitor"} {
+			if _, ok := cols[required]; !ok {
+				return nil, fmt.Errorf("CSV 缺少列: %s", required)
+			}
+		}
+		field := func(record []string, name string) string {
+			if i, ok := cols[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		var rows []scheduleRow
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("CSV 格式错误: %v", err)
+			}
+			rows = append(rows, scheduleRow{
+				Date:      field(record, "date"),
+				StartTime: field(record, "start_time"),
+				Home:      field(record, "home"),
+				Visitor:   field(record, "visitor"),
+				Season:    field(record, "season"),
+			})
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("不支持的导入格式: %s", format)
+	}
+}
+
+// newScheduledMatch 校验赛程字段并构造未开始的比赛; season 为空时按日期推算
+// teams 需包含主客队, 缺失视为球队不存在
+func newScheduledMatch(date, startTime string, homeID, visitorID uint32, season string, teams map[uint32]*model.Team) (*model.Match, error) {
+	if homeID == 0 || visitorID == 0 {
+		return nil, myErrors.NewError(myErrors.CodeInvalidMatchData, "主客队必填", "")
+	}
+	if homeID == visitorID {
+		return nil, myErrors.NewError(myErrors.CodeInvalidMatchData, "主客队不能是同一支球队", "")
+	}
+	for _, id := range []uint32{homeID, visitorID} {
+		if _, ok := teams[id]; !ok {
+			return nil, myErrors.NewError(myErrors.CodeTeamNotFound, fmt.Sprintf("球队不存在: %d", id), "")
+		}
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	if err != nil {
+		return nil, myErrors.NewError(myErrors.CodeInvalidMatchTime, "日期格式错误, 应为 YYYY-MM-DD", date)
+	}
+	clock, err := time.Parse("15:04", startTime)
+	if err != nil {
+		return nil, myErrors.NewError(myErrors.CodeInvalidMatchTime, "开赛时间格式错误, 应为 HH:MM", startTime)
+	}
+
+	if season == "" {
+		season = model.SeasonOf(day)
+	}
+	if err := model.ValidateSeason(season, day); err != nil {
+		return nil, myErrors.NewError(myErrors.CodeInvalidMatchData, err.Error(), "")
+	}
+
+	return &model.Match{
+		Date:          day,
+		StartTime:     day.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute),
+		Season:        season,
+		HomeTeamID:    uint(homeID),
+		VisitorTeamID: uint(visitorID),
+		Status:        model.MatchStatusScheduled,
+	}, nil
+}
+
+// teamGames 已排入赛程的比赛, key 为 "日期|球队ID"
+type teamGames map[string]*model.Match
+
+func findTeamGames(txDao *dao.MatchDao, matches []*model.Match, excludeID uint64) (teamGames, error) {
+	var dates []string
+	var teamIDs []uint
+	seenDate, seenTeam := make(map[string]bool), make(map[uint]bool)
+	for _, m := range matches {
+		if date := m.Date.Format("2006-01-02"); !seenDate[date] {
+			seenDate[date] = true
+			dates = append(dates, date)
+		}
+		for _, id := range []uint{m.HomeTeamID, m.VisitorTeamID} {
+			if !seenTeam[id] {
+				seenTeam[id] = true
+				teamIDs = append(teamIDs, id)
+			}
+		}
+	}
+	existing, err := txDao.FindTeamGames(dates, teamIDs, excludeID)
+	if err != nil {
+		return nil, err
+	}
+	games := make(teamGames, len(existing)*2)
+	for _, m := range existing {
+		date := m.Date.Format("2006-01-02")
+		games[date+"|"+strconv.Itoa(int(m.HomeTeamID))] = m
+		games[date+"|"+strconv.Itoa(int(m.VisitorTeamID))] = m
+	}
+	return games, nil
+}
+
+// conflictOf 返回冲突描述, 没有冲突返回空串
+func (g teamGames) conflictOf(m *model.Match, teams map[uint32]*model.Team) string {
+	date := m.Date.Format("2006-01-02")
+	for _, teamID := range []uint{m.HomeTeamID, m.VisitorTeamID} {
+		if other, ok := g[date+"|"+strconv.Itoa(int(teamID))]; ok {
+			return fmt.Sprintf("%s 在 %s 已有比赛 (match_id=%d)", teams[uint32(teamID)].Abbreviation, date, other.ID)
+		}
+	}
+	return ""
+}
+
+// checkScheduleConflicts 同一球队同一天只能有一场比赛 (延期/取消的不算)
+func checkScheduleConflicts(txDao *dao.MatchDao, matches []*model.Match, excludeID uint64, teams map[uint32]*model.Team) error {
+	existing, err := findTeamGames(txDao, matches, excludeID)
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		if conflict := existing.conflictOf(m, teams); conflict != "" {
+			return myErrors.NewError(myErrors.CodeMatchExists, conflict, "")
+		}
+	}
+	return nil
+}
+
+// reloadMatch 写入后重新查询 (带球队和单节比分)
+func (s *NBAService) reloadMatch(id int64) (*pb.MatchResponse, error) {
+	match, err := s.matchDao.GetByID(id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
+	}
+	return convertMatchToProto(match), nil
+}
+
+// scheduleError 业务错误原样返回, 其余转换为 gRPC 错误
+func scheduleError(err error) error {
+	var appErr *myErrors.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "比赛未找到")
+	default:
+		return status.Error(codes.Internal, "保存赛程失败: "+err.Error())
+	}
+}
+
+// errorMessage 导入逐行报错用, 只取业务错误的提示信息
+func errorMessage(err error) string {
+	var appErr *myErrors.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Message
+	}
+	return err.Error()
+}
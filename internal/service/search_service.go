@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/es"
+	"nba-remake/internal/model"
+)
+
+// SearchEvents 跨比赛搜索事件 (ES), 例如 "某球员第四节最后两分钟的所有扣篮"
+func (s *NBAService) SearchEvents(ctx context.Context, req *pb.SearchEventsRequest) (*pb.SearchEventsResponse, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if req.Quarter < 0 {
+		return nil, status.Error(codes.InvalidArgument, "节次不能为负数")
+	}
+
+	query := es.EventQuery{
+		Keyword:       req.Query,
+		MatchID:       uint64(req.MatchId),
+		PlayerID:      uint32(req.PlayerId),
+		TeamID:        uint32(req.TeamId),
+		Season:        req.Season,
+		SubType:       req.SubType,
+		Quarter:       int8(req.Quarter),
+		IncludeVoided: req.IncludeVoided,
+		From:          (page - 1) * pageSize,
+		Size:          pageSize,
+	}
+	if req.Type != pb.EventType_EVENT_TYPE_UNKNOWN {
+		query.Type = req.Type.String()
+	}
+	if req.ShotType != pb.ShotType_SHOT_TYPE_UNKNOWN {
+		query.ShotType = req.ShotType.String()
+	}
+
+	// 比赛时钟范围
+	if req.MinTimeRemaining != "" {
+		secs, err := model.ParseClock(req.MinTimeRemaining)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "剩余时间下限格式错误, 应为 mm:ss")
+		}
+		query.MinSecondsRemaining = &secs
+	}
+	if req.MaxTimeRemaining != "" {
+		secs, err := model.ParseClock(req.MaxTimeRemaining)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "剩余时间上限格式错误, 应为 mm:ss")
+		}
+		query.MaxSecondsRemaining = &secs
+	}
+	if query.MinSecondsRemaining != nil && query.MaxSecondsRemaining != nil &&
+		*query.MinSecondsRemaining > *query.MaxSecondsRemaining {
+		return nil, status.Error(codes.InvalidArgument, "剩余时间下限不能大于上限")
+	}
+
+	result, err := s.eventIndex.Search(query)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "搜索服务不可用")
+	}
+
+	hits := make([]*pb.EventHit, len(result.Events))
+	for i, doc := range result.Events {
+		hits[i] = &pb.EventHit{
+			MatchId:   int64(doc.MatchID),
+			Season:    doc.Season,
+			MatchDate: doc.MatchDate,
+			Play: &pb.PlayByPlay{
+				EventId:       doc.EventID,
+				PlayerId:      int32(doc.PlayerID),
+				TeamId:        int32(doc.TeamID),
+				Type:          pb.EventType(pb.EventType_value[doc.Type]),
+				ShotType:      pb.ShotType(pb.ShotType_value[doc.ShotType]),
+				SubType:       doc.SubType,
+				Value:         int32(doc.Value),
+				Quarter:       int32(doc.Quarter),
+				TimeRemaining: doc.TimeRemaining,
+			},
+			Voided:    doc.Voided,
+			EventTime: doc.EventTime,
+		}
+	}
+
+	return &pb.SearchEventsResponse{
+		Events:   hits,
+		Total:    result.Total,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+		Facets:   convertFacets(result.Facets),
+	}, nil
+}
+
+// convertFacets 分面统计转 proto, map 无序, 按字段名排序保证输出稳定
+func convertFacets(facets map[string][]es.FacetBucket) []*pb.Facet {
+	fields := make([]string, 0, len(facets))
+	for field := range facets {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	result := make([]*pb.Facet, 0, len(fields))
+	for _, field := range fields {
+		facet := &pb.Facet{Field: field}
+		for _, b := range facets[field] {
+			facet.Buckets = append(facet.Buckets, &pb.FacetBucket{Key: b.Key, Count: b.Count})
+		}
+		result = append(result, facet)
+	}
+	return result
+}
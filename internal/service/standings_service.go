@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/model"
+	"nba-remake/internal/standings"
+)
+
+// GetStandings 联盟排名
+// 读穿透缓存: 按赛季缓存两个联盟的完整排名, 比赛终场后删除, 下次读取时按已结束比赛重新计算
+func (s *NBAService) GetStandings(ctx context.Context, req *pb.GetStandingsRequest) (*pb.StandingsResponse, error) {
+	if _, err := model.ParseSeason(req.Season); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := cache.Fetch(ctx, s.cache, cache.StandingsKey(req.Season), cache.StandingsTTL, func() (*pb.StandingsResponse, error) {
+		return s.computeStandings(req.Season)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "计算排名失败: "+err.Error())
+	}
+
+	if req.Conference == "" {
+		return resp, nil
+	}
+	filtered := &pb.StandingsResponse{Season: resp.Season}
+	for _, entry := range resp.Standings {
+		if entry.Conference == req.Conference {
+			filtered.Standings = append(filtered.Standings, entry)
+		}
+	}
+	if len(filtered.Standings) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "联盟不存在: "+req.Conference)
+	}
+	return filtered, nil
+}
+
+// computeStandings 联盟/赛区按该赛季的赛区归属, 没有归属的球队按 Team.Conference
+func (s *NBAService) computeStandings(season string) (*pb.StandingsResponse, error) {
+	teams, err := s.teamDao.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := s.teamDao.SeasonProfiles(season, nil)
+	if err != nil {
+		return nil, err
+	}
+	divisions, err := s.leagueDao.DivisionsOf(season)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.matchDao.ListFinished(season)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint32]*model.Team, len(teams))
+	input := make([]standings.Team, 0, len(teams))
+	for _, t := range teams {
+		t.ApplySeason(profiles[t.ID])
+		byID[t.ID] = t
+		team := standings.Team{ID: t.ID, Conference: t.Conference}
+		if d, ok := divisions[t.ID]; ok {
+			team.Conference, team.Division = d.Conference.Name, d.Name
+		}
+		input = append(input, team)
+	}
+	games := make([]standings.Game, 0, len(matches))
+	for _, m := range matches {
+		games = append(games, standings.Game{
+			Home:         uint32(m.HomeTeamID),
+			Visitor:      uint32(m.VisitorTeamID),
+			HomeScore:    m.HomeScore,
+			VisitorScore: m.VisitorScore,
+		})
+	}
+
+	result := standings.Compute(input, games)
+	confs := make([]string, 0, len(result))
+	for conf := range result {
+		confs = append(confs, conf)
+	}
+	sort.Strings(confs)
+
+	resp := &pb.StandingsResponse{Season: season}
+	for _, conf := range confs {
+		for _, r := range result[conf] {
+			resp.Standings = append(resp.Standings, convertStandingToProto(r, byID[r.TeamID], divisions[r.TeamID]))
+		}
+	}
+	return resp, nil
+}
+
+// convertStandingToProto 辅助方法
+func convertStandingToProto(r *standings.Record, team *model.Team, division *model.Division) *pb.StandingsEntry {
+	return &pb.StandingsEntry{
+		Rank:             int32(r.Rank),
+		Team:             convertTeamWithDivision(team, division),
+		Conference:       r.Conference,
+		Wins:             int32(r.Wins),
+		Losses:           int32(r.Losses),
+		WinPct:           r.Pct(),
+		GamesBehind:      r.GamesBehind,
+		Home:             formatRecord(r.HomeWins, r.HomeLosses),
+		Away:             formatRecord(r.AwayWins, r.AwayLosses),
+		ConferenceRecord: formatRecord(r.ConfWins, r.ConfLosses),
+		Last_10:          formatRecord(r.Last10Wins, r.Last10Losses),
+		Streak:           formatStreak(r.Streak),
+		PointsFor:        int32(r.PointsFor),
+		PointsAgainst:    int32(r.PointsAgainst),
+		Tiebreaker:       r.Tiebreaker,
+		Division:         r.Division,
+		DivisionRank:     int32(r.DivisionRank),
+		DivisionRecord:   formatRecord(r.DivWins, r.DivLosses),
+	}
+}
+
+func formatRecord(wins, losses int) string {
+	return fmt.Sprintf("%d-%d", wins, losses)
+}
+
+// formatStreak 连胜 W3 / 连败 L2, 未比赛为空
+func formatStreak(streak int) string {
+	switch {
+	case streak > 0:
+		return fmt.Sprintf("W%d", streak)
+	case streak < 0:
+		return fmt.Sprintf("L%d", -streak)
+	default:
+		return ""
+	}
+}
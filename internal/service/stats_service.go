@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/model"
+)
+
+// GetMatchBoxScore 查单场技术统计
+func (s *NBAService) GetMatchBoxScore(ctx context.Context, req *pb.GetMatchRequest) (*pb.BoxScoreResponse, error) {
+	match, err := s.matchDao.GetByID(req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "比赛未找到")
+		}
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
+	}
+
+	return s.buildBoxScore(match)
+}
+
+// buildBoxScore 汇总单场球员技术统计, 按主客队分组并计算全队合计
+func (s *NBAService) buildBoxScore(match *model.Match) (*pb.BoxScoreResponse, error) {
+	stats, err := s.statsDao.ListByMatch(int64(match.ID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询技术统计失败: "+err.Error())
+	}
+
+	// 批量查球员姓名
+	ids := make([]uint32, 0, len(stats))
+	for _, st := range stats {
+		ids = append(ids, st.PlayerID)
+	}
+	players, err := s.playerDao.GetPlayersByIDs(ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询球员失败: "+err.Error())
+	}
+	names := make(map[uint32]string, len(players))
+	for _, p := range players {
+		names[p.ID] = p.Name
+	}
+
+	home := &pb.TeamBoxScore{TeamId: int32(match.HomeTeamID), Totals: &pb.PlayerStatLine{TeamId: int32(match.HomeTeamID)}}
+	visitor := &pb.TeamBoxScore{TeamId: int32(match.VisitorTeamID), Totals: &pb.PlayerStatLine{TeamId: int32(match.VisitorTeamID)}}
+	for _, st := range stats {
+		line := convertStatsToProto(st, names[st.PlayerID])
+		switch uint(st.TeamID) {
+		case match.HomeTeamID:
+			home.Players = append(home.Players, line)
+			addStatLine(home.Totals, line)
+		case match.VisitorTeamID:
+			visitor.Players = append(visitor.Players, line)
+			addStatLine(visitor.Totals, line)
+		}
+	}
+
+	return &pb.BoxScoreResponse{
+		MatchId: int64(match.ID),
+		Home:    home,
+		Visitor: visitor,
+	}, nil
+}
+
+// convertStatsToProto 辅助方法
+func convertStatsToProto(st *model.PlayerGameStats, name string) *pb.PlayerStatLine {
+	return &pb.PlayerStatLine{
+		PlayerId:      int32(st.PlayerID),
+		PlayerName:    name,
+		TeamId:        int32(st.TeamID),
+		Points:        int32(st.Points),
+		Fgm:           int32(st.FGM),
+		Fga:           int32(st.FGA),
+		Fg3M:          int32(st.FG3M),
+		Fg3A:          int32(st.FG3A),
+		Ftm:           int32(st.FTM),
+		Fta:           int32(st.FTA),
+		Oreb:          int32(st.OREB),
+		Dreb:          int32(st.DREB),
+		Reb:           int32(st.OREB + st.DREB),
+		Ast:           int32(st.AST),
+		Stl:           int32(st.STL),
+		Blk:           int32(st.BLK),
+		Tov:           int32(st.TOV),
+		Pf:            int32(st.PF),
+		SecondsPlayed: int32(st.Seconds),
+		Minutes:       formatMinutes(st.Seconds),
+	}
+}
+
+// addStatLine 把球员数据累加到全队合计
+func addStatLine(total, line *pb.PlayerStatLine) {
+	total.Points += line.Points
+	total.Fgm += line.Fgm
+	total.Fga += line.Fga
+	total.Fg3M += line.Fg3M
+	total.Fg3A += line.Fg3A
+	total.Ftm += line.Ftm
+	total.Fta += line.Fta
+	total.Oreb += line.Oreb
+	total.Dreb += line.Dreb
+	total.Reb += line.Reb
+	total.Ast += line.Ast
+	total.Stl += line.Stl
+	total.Blk += line.Blk
+	total.Tov += line.Tov
+	total.Pf += line.Pf
+	total.SecondsPlayed += line.SecondsPlayed
+	total.Minutes = formatMinutes(int(total.SecondsPlayed))
+}
+
+// formatMinutes 秒数转 "mm:ss"
+func formatMinutes(seconds int) string {
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
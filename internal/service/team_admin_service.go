@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
+)
+
+// CreateTeam 创建球队, 同时写入首个赛季的展示信息和赛区归属
+func (s *NBAService) CreateTeam(ctx context.Context, req *pb.CreateTeamRequest) (*pb.TeamResponse, error) {
+	season, err := resolveSeason(req.Season)
+	if err != nil {
+		return nil, err
+	}
+	team := &model.Team{
+		Name:         strings.TrimSpace(req.Name),
+		City:         strings.TrimSpace(req.City),
+		Abbreviation: req.Abbreviation,
+		Conference:   req.Conference,
+		LogoURL:      req.LogoUrl,
+		HomeArena:    req.HomeArena,
+	}
+	if team.Name == "" || team.City == "" {
+		return nil, myErrors.NewError(myErrors.CodeInvalidTeamData, "球队名称和城市必填", "")
+	}
+	division, err := s.resolveTeamConference(team, uint32(req.DivisionId))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.teamDao.WithTx(func(txDao *dao.TeamDao) error {
+		if err := checkAbbreviation(txDao, team.Abbreviation, 0); err != nil {
+			return err
+		}
+		if err := txDao.Create(team); err != nil {
+			return err
+		}
+		if err := txDao.SaveSeason(team.SeasonProfile(season)); err != nil {
+			return err
+		}
+		if division != nil {
+			return txDao.League().SetTeamDivision(team.ID, season, division.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, teamError(err)
+	}
+	s.invalidateTeams(ctx, team.ID)
+	return convertTeamWithDivision(team, division), nil
+}
+
+// UpdateTeam 更新球队
+// 展示信息写入 season 的赛季记录; 只有 season 不早于最近一条记录时才更新 teams 表的当前信息 (补录历史时不影响当前)
+func (s *NBAService) UpdateTeam(ctx context.Context, req *pb.UpdateTeamRequest) (*pb.TeamResponse, error) {
+	season, err := resolveSeason(req.Season)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *pb.TeamResponse
+	err = s.teamDao.WithTx(func(txDao *dao.TeamDao) error {
+		current, err := txDao.GetByID(req.Id)
+		if err != nil {
+			return err
+		}
+		profiles, err := txDao.SeasonProfiles(season, []uint32{current.ID})
+		if err != nil {
+			return err
+		}
+		// 在该赛季生效的信息基础上修改
+		team := *current
+		team.ApplySeason(profiles[current.ID])
+		if req.Name != "" {
+			team.Name = strings.TrimSpace(req.Name)
+		}
+		if req.City != "" {
+			team.City = strings.TrimSpace(req.City)
+		}
+		if req.Abbreviation != "" {
+			team.Abbreviation = req.Abbreviation
+		}
+		if req.LogoUrl != "" {
+			team.LogoURL = req.LogoUrl
+		}
+		if req.HomeArena != "" {
+			team.HomeArena = req.HomeArena
+		}
+		if req.Conference != "" {
+			team.Conference = req.Conference
+		}
+		if err := checkAbbreviation(txDao, team.Abbreviation, team.ID); err != nil {
+			return err
+		}
+		division, err := s.resolveTeamConference(&team, uint32(req.DivisionId))
+		if err != nil {
+			return err
+		}
+
+		latest, err := txDao.LatestSeason(team.ID)
+		if err != nil {
+			return err
+		}
+		// 还没有赛季记录 (启动时未补写基线) 时先保存修改前的信息, 之前赛季的比赛不受这次修改影响
+		if latest == "" && season > model.BaselineSeason {
+			if err := txDao.SaveSeason(current.SeasonProfile(model.BaselineSeason)); err != nil {
+				return err
+			}
+			latest = model.BaselineSeason
+		}
+		if err := txDao.SaveSeason(team.SeasonProfile(season)); err != nil {
+			return err
+		}
+		if division != nil {
+			if err := txDao.League().SetTeamDivision(team.ID, season, division.ID); err != nil {
+				return err
+			}
+		}
+		if season >= latest {
+			if err := txDao.Update(&team); err != nil {
+				return err
+			}
+		}
+
+		if division == nil {
+			divisions, err := txDao.League().DivisionsOf(season)
+			if err != nil {
+				return err
+			}
+			division = divisions[team.ID]
+		}
+		resp = convertTeamWithDivision(&team, division)
+		return nil
+	})
+	if err != nil {
+		return nil, teamError(err)
+	}
+	s.invalidateTeams(ctx, uint32(req.Id))
+	return resp, nil
+}
+
+// DeleteTeam 删除球队, 已有比赛或球员引用时拒绝 (历史比赛需要球队信息)
+func (s *NBAService) DeleteTeam(ctx context.Context, req *pb.DeleteTeamRequest) (*pb.DeleteTeamResponse, error) {
+	err := s.teamDao.WithTx(func(txDao *dao.TeamDao) error {
+		if _, err := txDao.GetByID(req.Id); err != nil {
+			return err
+		}
+		matches, players, err := txDao.CountReferences(uint32(req.Id))
+		if err != nil {
+			return err
+		}
+		if matches > 0 || players > 0 {
+			return myErrors.NewError(myErrors.CodeInvalidTeamData,
+				fmt.Sprintf("球队已有 %d 场比赛、%d 名球员, 不能删除", matches, players), "")
+		}
+		return txDao.Delete(uint32(req.Id))
+	})
+	if err != nil {
+		return nil, teamError(err)
+	}
+	s.invalidateTeams(ctx, uint32(req.Id))
+	return &pb.DeleteTeamResponse{Success: true}, nil
+}
+
+// GetTeamHistory 球队按赛季的名称/城市/场馆/队徽变更记录
+func (s *NBAService) GetTeamHistory(ctx context.Context, req *pb.GetTeamRequest) (*pb.TeamHistoryResponse, error) {
+	if _, err := s.teamDao.GetByID(req.Id); err != nil {
+		return nil, teamError(err)
+	}
+	rows, err := s.teamDao.History(uint32(req.Id))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询失败: "+err.Error())
+	}
+	resp := &pb.TeamHistoryResponse{TeamId: req.Id}
+	for _, row := range rows {
+		resp.Seasons = append(resp.Seasons, &pb.TeamSeasonProfile{
+			Season:       row.Season,
+			Name:         row.Name,
+			City:         row.City,
+			Abbreviation: row.Abbreviation,
+			LogoUrl:      row.LogoURL,
+			HomeArena:    row.HomeArena,
+		})
+	}
+	return resp, nil
+}
+
+// resolveTeamConference 校验联盟/赛区; 指定赛区时联盟以赛区所属联盟为准
+func (s *NBAService) resolveTeamConference(team *model.Team, divisionID uint32) (*model.Division, error) {
+	if divisionID != 0 {
+		division, err := s.leagueDao.GetDivision(divisionID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, myErrors.NewError(myErrors.CodeInvalidTeamData, fmt.Sprintf("赛区不存在: %d", divisionID), "")
+			}
+			return nil, err
+		}
+		if team.Conference != "" && team.Conference != division.Conference.Name {
+			return nil, myErrors.NewError(myErrors.CodeInvalidTeamData,
+				fmt.Sprintf("赛区 %s 属于 %s, 与联盟 %s 不一致", division.Name, division.Conference.Name, team.Conference), "")
+		}
+		team.Conference = division.Conference.Name
+		return division, nil
+	}
+
+	conferences, err := s.leagueDao.ListConferences()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range conferences {
+		if c.Name == team.Conference {
+			return nil, nil
+		}
+	}
+	return nil, myErrors.NewError(myErrors.CodeInvalidTeamData, fmt.Sprintf("联盟不存在: %q", team.Conference), "")
+}
+
+// checkAbbreviation 缩写格式及唯一性
+func checkAbbreviation(txDao *dao.TeamDao, abbr string, excludeID uint32) error {
+	if err := model.ValidateAbbreviation(abbr); err != nil {
+		return myErrors.NewError(myErrors.CodeInvalidTeamData, err.Error(), "")
+	}
+	taken, err := txDao.AbbreviationTaken(abbr, excludeID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return myErrors.NewError(myErrors.CodeTeamExists, "球队缩写已存在: "+abbr, "")
+	}
+	return nil
+}
+
+// invalidateTeams 球队信息变化后删除球队、球队列表和排名缓存 (都按赛季缓存, 按通配符删除)
+// 比赛详情缓存中的队名依赖 TTL 过期
+func (s *NBAService) invalidateTeams(ctx context.Context, teamID uint32) {
+	s.cache.InvalidatePattern(ctx, fmt.Sprintf("team:%d:*", teamID), "teams:all:*", "standings:*")
+}
+
+// teamError 业务错误原样返回, 其余转换为 gRPC 错误
+func teamError(err error) error {
+	var appErr *myErrors.AppError
+	switch {
+	case errors.As(err, &appErr):
+		return appErr
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return myErrors.NewError(myErrors.CodeTeamNotFound, "球队不存在", "")
+	case dao.IsDuplicateKey(err):
+		return myErrors.NewError(myErrors.CodeTeamExists, "球队缩写已存在", "")
+	default:
+		return status.Error(codes.Internal, "保存球队失败: "+err.Error())
+	}
+}
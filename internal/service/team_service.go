@@ -3,19 +3,43 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 
 	pb "nba-remake/api/proto/v1"
+	"nba-remake/internal/cache"
 	"nba-remake/internal/model"
 )
 
 // GetTeam 实现 gRPC GetTeam 接口
 func (s *NBAService) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.TeamResponse, error) {
-	// 1. 调用 DAO
-	team, err := s.teamDao.GetByID(req.Id)
+	season, err := resolveSeason(req.Season)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 查缓存, 未命中调用 DAO
+	resp, err := cache.Fetch(ctx, s.cache, cache.TeamKey(req.Id, season), cache.TeamTTL, func() (*pb.TeamResponse, error) {
+		team, err := s.teamDao.GetByID(req.Id)
+		if err != nil {
+			return nil, err
+		}
+		// 名称/城市/场馆等按该赛季生效的信息展示
+		profiles, err := s.teamDao.SeasonProfiles(season, []uint32{team.ID})
+		if err != nil {
+			return nil, err
+		}
+		team.ApplySeason(profiles[team.ID])
+		divisions, err := s.leagueDao.DivisionsOf(season)
+		if err != nil {
+			return nil, err
+		}
+		// 转换 Model -> Proto Response
+		return convertTeamWithDivision(team, divisions[team.ID]), nil
+	})
 
 	// 2. 错误处理
 	if err != nil {
@@ -25,27 +49,65 @@ func (s *NBAService) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.T
 		return nil, status.Error(codes.Internal, "数据库查询失败")
 	}
 
-	// 3. 转换 Model -> Proto Response
-	return convertTeamModelToProto(team), nil
+	return resp, nil
 }
 
 // ListTeams 实现 gRPC ListTeams 接口
+// 缓存按赛季保存全部球队, 联盟/赛区过滤在内存中完成
 func (s *NBAService) ListTeams(ctx context.Context, req *pb.ListTeamsRequest) (*pb.ListTeamsResponse, error) {
-	// 1. 调用 DAO
-	teams, err := s.teamDao.GetAll()
+	season, err := resolveSeason(req.Season)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 查缓存, 未命中调用 DAO
+	resp, err := cache.Fetch(ctx, s.cache, cache.TeamListKey(season), cache.TeamTTL, func() (*pb.ListTeamsResponse, error) {
+		teams, err := s.teamDao.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		profiles, err := s.teamDao.SeasonProfiles(season, nil)
+		if err != nil {
+			return nil, err
+		}
+		divisions, err := s.leagueDao.DivisionsOf(season)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. 批量转换
+		var respTeams []*pb.TeamResponse
+		for _, t := range teams {
+			t.ApplySeason(profiles[t.ID])
+			respTeams = append(respTeams, convertTeamWithDivision(t, divisions[t.ID]))
+		}
+		return &pb.ListTeamsResponse{Teams: respTeams}, nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "获取球队列表失败")
 	}
 
-	// 2. 批量转换
-	var respTeams []*pb.TeamResponse
-	for _, t := range teams {
-		respTeams = append(respTeams, convertTeamModelToProto(t))
+	if req.Conference == "" && req.Division == "" {
+		return resp, nil
 	}
+	filtered := &pb.ListTeamsResponse{}
+	for _, t := range resp.Teams {
+		if (req.Conference == "" || t.Conference == req.Conference) && (req.Division == "" || t.Division == req.Division) {
+			filtered.Teams = append(filtered.Teams, t)
+		}
+	}
+	return filtered, nil
+}
 
-	return &pb.ListTeamsResponse{
-		Teams: respTeams,
-	}, nil
+// resolveSeason 校验赛季参数, 为空时取当前赛季
+func resolveSeason(season string) (string, error) {
+	if season == "" {
+		return model.SeasonOf(time.Now()), nil
+	}
+	if _, err := model.ParseSeason(season); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return season, nil
 }
 
 // 辅助函数：Model 转 Proto
@@ -57,5 +119,17 @@ func convertTeamModelToProto(t *model.Team) *pb.TeamResponse {
 		Abbreviation: t.Abbreviation,
 		Conference:   t.Conference,
 		LogoUrl:      t.LogoURL,
+		HomeArena:    t.HomeArena,
+	}
+}
+
+// convertTeamWithDivision 带上赛季内的赛区归属, 联盟以赛区所属联盟为准; division 为 nil 时只有 Team.Conference
+func convertTeamWithDivision(t *model.Team, division *model.Division) *pb.TeamResponse {
+	resp := convertTeamModelToProto(t)
+	if division != nil {
+		resp.DivisionId = int32(division.ID)
+		resp.Division = division.Name
+		resp.Conference = division.Conference.Name
 	}
+	return resp
 }
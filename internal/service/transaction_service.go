@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/cache"
+	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
+	"nba-remake/internal/trade"
+)
+
+// TradePlayers 交易: 所有球员和选秀权在同一事务中转移, 违反任何交易规则则整笔交易不生效
+// 涉及的球队按ID升序加锁, 避免与阵容变更互相死锁
+func (s *NBAService) TradePlayers(ctx context.Context, req *pb.TradePlayersRequest) (*pb.TradeResponse, error) {
+	proposal, err := newTradeProposal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &model.Trade{Date: proposal.Date, Note: req.Note}
+	var players []*model.Player
+	var txs []*model.PlayerTransaction
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		st, err := loadTradeState(txDao, proposal)
+		if err != nil {
+			return err
+		}
+		if violations := s.validateTrade(proposal, st); len(violations) > 0 {
+			return tradeViolationError(violations)
+		}
+
+		for _, a := range proposal.Assets {
+			if a.PickID != 0 {
+				record.Picks = append(record.Picks, model.TradePick{PickID: a.PickID, FromTeamID: a.From, ToTeamID: a.To, Protection: a.Protection})
+				continue
+			}
+			// 交易后从发展联盟回到一线队, 名额类型和球衣号不变
+			player := st.Players[a.PlayerID]
+			player.TeamID = a.To
+			if player.Status == pb.PlayerStatus_ASSIGNED {
+				player.Status = pb.PlayerStatus_ACTIVE
+			}
+			player.UpdatedAt = time.Now()
+			if err := txDao.Contracts().Move(player.ID, a.From, a.To); err != nil {
+				return err
+			}
+			players = append(players, player)
+			txs = append(txs, &model.PlayerTransaction{
+				PlayerID:      player.ID,
+				Type:          pb.TransactionType_TRADE,
+				FromTeamID:    a.From,
+				ToTeamID:      a.To,
+				EffectiveDate: proposal.Date,
+				Note:          req.Note,
+			})
+		}
+
+		if err := txDao.Transactions().CreateTrade(record); err != nil {
+			return err
+		}
+		for _, pick := range record.Picks {
+			if err := txDao.Transactions().SetPickOwner(pick.PickID, pick.ToTeamID); err != nil {
+				return err
+			}
+			if pick.Protection == 0 {
+				continue
+			}
+			if err := txDao.Transactions().SetPickProtection(pick.PickID, pick.Protection, pick.FromTeamID); err != nil {
+				return err
+			}
+		}
+		for _, player := range players {
+			if err := txDao.UpdatePlayer(player); err != nil {
+				return err
+			}
+		}
+		for _, t := range txs {
+			t.TradeID = record.ID
+		}
+		return txDao.Transactions().Record(txs...)
+	})
+	if err != nil {
+		return nil, playerError(err)
+	}
+
+	keys := make([]string, 0, len(players))
+	for _, p := range players {
+		keys = append(keys, cache.PlayerKey(int32(p.ID)))
+	}
+	s.playerDao.SyncIndex(players...)
+	s.cache.Invalidate(ctx, keys...)
+
+	resp := &pb.TradeResponse{TradeId: int64(record.ID), Date: proposal.Date.Format("2006-01-02"), Assets: req.Assets}
+	for _, t := range txs {
+		resp.Transactions = append(resp.Transactions, convertTransactionToProto(t))
+	}
+	return resp, nil
+}
+
+// ValidateTrade 交易预检, 返回全部违反的规则 (与 TradePlayers 使用同一套规则)
+func (s *NBAService) ValidateTrade(ctx context.Context, req *pb.TradePlayersRequest) (*pb.ValidateTradeResponse, error) {
+	proposal, err := newTradeProposal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []trade.Violation
+	err = s.playerDao.WithTx(func(txDao *dao.PlayerDao) error {
+		st, err := loadTradeState(txDao, proposal)
+		if err != nil {
+			return err
+		}
+		violations = s.validateTrade(proposal, st)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "交易预检失败: "+err.Error())
+	}
+
+	resp := &pb.ValidateTradeResponse{Valid: len(violations) == 0}
+	for _, v := range violations {
+		resp.Violations = append(resp.Violations, &pb.TradeViolation{
+			Code:     int32(v.Code),
+			Rule:     v.Rule,
+			TeamId:   int32(v.TeamID),
+			PlayerId: int32(v.PlayerID),
+			PickId:   int32(v.PickID),
+			Message:  v.Message,
+		})
+	}
+	return resp, nil
+}
+
+// GetPlayerTeamHistory 球员异动记录和效力区间; 指定 date 时返回当天所在球队
+func (s *NBAService) GetPlayerTeamHistory(ctx context.Context, req *pb.GetPlayerTeamHistoryRequest) (*pb.PlayerTeamHistoryResponse, error) {
+	player, err := s.playerDao.GetPlayerByID(uint32(req.PlayerId))
+	if err != nil {
+		return nil, playerError(err)
+	}
+	history, err := s.playerDao.Transactions().History(player.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询异动记录失败: "+err.Error())
+	}
+
+	resp := &pb.PlayerTeamHistoryResponse{PlayerId: req.PlayerId, TeamId: int32(player.TeamID)}
+	for _, t := range history {
+		resp.Transactions = append(resp.Transactions, convertTransactionToProto(t))
+	}
+	for _, stint := range model.Stints(history) {
+		ts := &pb.TeamStint{TeamId: int32(stint.TeamID), FromDate: stint.From.Format("2006-01-02")}
+		if !stint.To.IsZero() {
+			ts.ToDate = stint.To.Format("2006-01-02")
+		}
+		resp.Stints = append(resp.Stints, ts)
+	}
+	if req.Date != "" {
+		date, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "日期格式错误, 应为 YYYY-MM-DD")
+		}
+		// 没有早于该日期的记录时按自由球员
+		teamID, _ := model.TeamOn(history, date)
+		resp.TeamId = int32(teamID)
+	}
+	return resp, nil
+}
+
+// newTradeProposal 请求转换为交易方案
+func newTradeProposal(req *pb.TradePlayersRequest) (*trade.Proposal, error) {
+	date, err := parseEffectiveDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	p := &trade.Proposal{Date: date, NoTradeWaived: make(map[uint32]bool, len(req.NoTradeWaived))}
+	for _, a := range req.Assets {
+		p.Assets = append(p.Assets, trade.Asset{
+			From:       uint32(a.FromTeamId),
+			To:         uint32(a.ToTeamId),
+			PlayerID:   uint32(a.PlayerId),
+			PickID:     uint32(a.PickId),
+			Protection: int(a.Protection),
+		})
+	}
+	for _, id := range req.NoTradeWaived {
+		p.NoTradeWaived[uint32(id)] = true
+	}
+	return p, nil
+}
+
+// loadTradeState 锁定并读取交易涉及的阵容/球员/选秀权 (球队按ID升序加锁), 不存在的球队不放入 Rosters
+func loadTradeState(txDao *dao.PlayerDao, p *trade.Proposal) (*trade.State, error) {
+	st := &trade.State{Rosters: make(map[uint32]*model.Roster)}
+	for _, id := range p.Teams() {
+		roster, err := txDao.LockRoster(id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		st.Rosters[id] = roster
+	}
+
+	var err error
+	if st.Players, err = txDao.LockPlayers(p.PlayerIDs()); err != nil {
+		return nil, err
+	}
+	if st.Picks, err = txDao.Transactions().GetPicksForUpdate(p.PickIDs()); err != nil {
+		return nil, err
+	}
+	if st.SignedAt, err = txDao.Transactions().LastSignings(p.PlayerIDs()); err != nil {
+		return nil, err
+	}
+	season := model.SeasonOf(p.Date)
+	if st.Salaries, err = txDao.Contracts().CapHits(p.PlayerIDs(), season); err != nil {
+		return nil, err
+	}
+	if st.Payrolls, err = txDao.Contracts().Payrolls(p.Teams(), season); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// validateTrade 默认交易规则加上按交易所在赛季阈值的工资匹配
+func (s *NBAService) validateTrade(p *trade.Proposal, st *trade.State) []trade.Violation {
+	return trade.Validate(p, st, trade.SalaryMatching(s.capThresholds(model.SeasonOf(p.Date))))
+}
+
+// tradeViolationError 第一项违反的规则作为业务错误返回, 完整列表由 ValidateTrade 查询
+func tradeViolationError(violations []trade.Violation) error {
+	detail := ""
+	if len(violations) > 1 {
+		detail = fmt.Sprintf("共 %d 项违反交易规则", len(violations))
+	}
+	return myErrors.NewError(violations[0].Code, violations[0].Message, detail)
+}
+
+// signing 签约记录 (自由球员加入 p 当前所在球队)
+func signing(p *model.Player, date time.Time) *model.PlayerTransaction {
+	return &model.PlayerTransaction{
+		PlayerID:      p.ID,
+		Type:          pb.TransactionType_SIGNING,
+		ToTeamID:      p.TeamID,
+		EffectiveDate: date,
+		Note:          p.Slot().String(),
+	}
+}
+
+// parseEffectiveDate 异动生效日期, 为空时取当天
+func parseEffectiveDate(date string) (time.Time, error) {
+	if date == "" {
+		return today(), nil
+	}
+	d, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	if err != nil {
+		return d, status.Error(codes.InvalidArgument, "生效日期格式错误, 应为 YYYY-MM-DD")
+	}
+	return d, nil
+}
+
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// convertTransactionToProto 辅助方法
+func convertTransactionToProto(t *model.PlayerTransaction) *pb.PlayerTransaction {
+	return &pb.PlayerTransaction{
+		Id:         int64(t.ID),
+		Type:       t.Type,
+		PlayerId:   int32(t.PlayerID),
+		FromTeamId: int32(t.FromTeamID),
+		ToTeamId:   int32(t.ToTeamID),
+		Date:       t.EffectiveDate.Format("2006-01-02"),
+		TradeId:    int64(t.TradeID),
+		Note:       t.Note,
+	}
+}
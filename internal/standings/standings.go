@@ -0,0 +1,362 @@
+package standings
+
+import (
+	"math"
+	"sort"
+)
+
+// Team 参与排名的球队, Division 为空时不使用赛区相关的排名规则
+type Team struct {
+	ID         uint32
+	Conference string
+	Division   string
+}
+
+// Game 已结束的比赛
+type Game struct {
+	Home         uint32
+	Visitor      uint32
+	HomeScore    int
+	VisitorScore int
+}
+
+// PlayoffSpots 每个联盟有季后赛资格 (含附加赛) 的名额, 用于 "对季后赛球队战绩" 规则
+const PlayoffSpots = 10
+
+// Record 单队战绩
+type Record struct {
+	TeamID     uint32
+	Conference string
+	Division   string
+
+	Rank         int     // 联盟内排名
+	DivisionRank int     // 赛区内排名, 没有赛区时为 0
+	GamesBehind  float64 // 落后联盟第一的胜场差
+	Tiebreaker   string  // 与同胜率球队比较时决定名次的规则, 没有同胜率时为空
+
+	Wins, Losses             int
+	HomeWins, HomeLosses     int
+	AwayWins, AwayLosses     int
+	ConfWins, ConfLosses     int
+	DivWins, DivLosses       int
+	PointsFor, PointsAgainst int
+	Last10Wins, Last10Losses int
+	Streak                   int // 正数为连胜, 负数为连败
+	results                  []result
+}
+
+type result struct {
+	opponent uint32
+	won      bool
+}
+
+// Pct 胜率, 未比赛时为 0
+func (r *Record) Pct() float64 {
+	return pct(r.Wins, r.Losses)
+}
+
+func pct(w, l int) float64 {
+	if w+l == 0 {
+		return 0
+	}
+	return float64(w) / float64(w+l)
+}
+
+// Compute 按联盟计算排名, 返回 联盟 -> 按名次排序的战绩
+// games 需按比赛时间升序 (用于近 10 场和连胜/连败), 比分相同或涉及未知球队的比赛忽略
+func Compute(teams []Team, games []Game) map[string][]*Record {
+	c := &calculator{records: make(map[uint32]*Record, len(teams)), h2h: make(map[[2]uint32]int)}
+	for _, t := range teams {
+		c.records[t.ID] = &Record{TeamID: t.ID, Conference: t.Conference, Division: t.Division}
+	}
+	for _, g := range games {
+		c.add(g)
+	}
+	for _, r := range c.records {
+		r.summarize()
+	}
+
+	byConf := make(map[string][]*Record)
+	byDiv := make(map[string][]*Record)
+	for _, t := range teams {
+		r := c.records[t.ID]
+		byConf[t.Conference] = append(byConf[t.Conference], r)
+		if t.Division != "" {
+			byDiv[t.Division] = append(byDiv[t.Division], r)
+		}
+	}
+
+	// 季后赛球队: 按胜率排在联盟前 PlayoffSpots 名 (与第 PlayoffSpots 名同胜率的也算)
+	c.playoff = make(map[uint32]bool)
+	for _, group := range byConf {
+		sorted := append([]*Record(nil), group...)
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Pct() > sorted[j].Pct() })
+		cutoff := sorted[min(PlayoffSpots, len(sorted))-1].Pct()
+		for i, r := range sorted {
+			if i < PlayoffSpots || equal(r.Pct(), cutoff) {
+				c.playoff[r.TeamID] = true
+			}
+		}
+	}
+	// 赛区第一: 先在赛区内排名 (赛区内比较不涉及 "赛区第一" 规则)
+	c.leaders = make(map[uint32]bool)
+	for _, group := range byDiv {
+		ranked, _ := c.rank(group)
+		c.leaders[ranked[0].TeamID] = true
+		for i, r := range ranked {
+			r.DivisionRank = i + 1
+		}
+	}
+
+	out := make(map[string][]*Record, len(byConf))
+	for conf, group := range byConf {
+		ranked, reasons := c.rank(group)
+		leader := ranked[0]
+		for i, r := range ranked {
+			r.Rank = i + 1
+			r.GamesBehind = float64((leader.Wins-r.Wins)+(r.Losses-leader.Losses)) / 2
+			r.Tiebreaker = reasons[r.TeamID]
+		}
+		out[conf] = ranked
+	}
+	return out
+}
+
+type calculator struct {
+	records map[uint32]*Record
+	h2h     map[[2]uint32]int // {a, b} -> a 战胜 b 的场次
+	playoff map[uint32]bool
+	leaders map[uint32]bool
+}
+
+func (c *calculator) add(g Game) {
+	home, visitor := c.records[g.Home], c.records[g.Visitor]
+	if home == nil || visitor == nil || g.HomeScore == g.VisitorScore {
+		return
+	}
+	homeWon := g.HomeScore > g.VisitorScore
+	home.PointsFor += g.HomeScore
+	home.PointsAgainst += g.VisitorScore
+	visitor.PointsFor += g.VisitorScore
+	visitor.PointsAgainst += g.HomeScore
+
+	winner, loser := home, visitor
+	if homeWon {
+		home.HomeWins++
+		visitor.AwayLosses++
+	} else {
+		winner, loser = visitor, home
+		home.HomeLosses++
+		visitor.AwayWins++
+	}
+	winner.Wins++
+	loser.Losses++
+	c.h2h[[2]uint32{winner.TeamID, loser.TeamID}]++
+	if home.Conference == visitor.Conference {
+		winner.ConfWins++
+		loser.ConfLosses++
+	}
+	if home.Division != "" && home.Division == visitor.Division {
+		winner.DivWins++
+		loser.DivLosses++
+	}
+	winner.results = append(winner.results, result{opponent: loser.TeamID, won: true})
+	loser.results = append(loser.results, result{opponent: winner.TeamID, won: false})
+}
+
+// summarize 近 10 场与连胜/连败
+func (r *Record) summarize() {
+	for i := len(r.results) - 1; i >= 0 && i >= len(r.results)-10; i-- {
+		if r.results[i].won {
+			r.Last10Wins++
+		} else {
+			r.Last10Losses++
+		}
+	}
+	for i := len(r.results) - 1; i >= 0; i-- {
+		won := r.results[i].won
+		if r.Streak > 0 && !won || r.Streak < 0 && won {
+			break
+		}
+		if won {
+			r.Streak++
+		} else {
+			r.Streak--
+		}
+	}
+}
+
+// rank 按胜率排序, 同胜率的按官方规则决胜; 返回排序结果和每队决定名次的规则
+func (c *calculator) rank(group []*Record) ([]*Record, map[uint32]string) {
+	sorted := append([]*Record(nil), group...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !equal(sorted[i].Pct(), sorted[j].Pct()) {
+			return sorted[i].Pct() > sorted[j].Pct()
+		}
+		return sorted[i].TeamID < sorted[j].TeamID
+	})
+
+	reasons := make(map[uint32]string)
+	ranked := make([]*Record, 0, len(sorted))
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && equal(sorted[j].Pct(), sorted[i].Pct()) {
+			j++
+		}
+		if j-i == 1 {
+			ranked = append(ranked, sorted[i])
+		} else {
+			ranked = append(ranked, c.breakTie(sorted[i:j], reasons)...)
+		}
+		i = j
+	}
+	return ranked, reasons
+}
+
+// criterion 决胜规则, 值越大排名越靠前; applies 为 false 时跳过该规则
+type criterion struct {
+	name    string
+	applies func(group []*Record) bool
+	value   func(r *Record, group []*Record) float64
+}
+
+// breakTie 同胜率决胜 (NBA 官方规则):
+// 依次比较各项规则, 某项规则分出部分名次后, 仍然同分的球队回到第一条规则重新比较
+// (剩 2 队时用两队规则, 3 队及以上用多队规则); 全部相同时按球队ID (代替抽签)
+func (c *calculator) breakTie(group []*Record, reasons map[uint32]string) []*Record {
+	criteria := c.multiTeamCriteria()
+	if len(group) == 2 {
+		criteria = c.twoTeamCriteria()
+	}
+	for _, crit := range criteria {
+		if !crit.applies(group) {
+			continue
+		}
+		values := make(map[uint32]float64, len(group))
+		for _, r := range group {
+			values[r.TeamID] = crit.value(r, group)
+		}
+		sorted := append([]*Record(nil), group...)
+		sort.SliceStable(sorted, func(i, j int) bool { return values[sorted[i].TeamID] > values[sorted[j].TeamID] })
+		if equal(values[sorted[0].TeamID], values[sorted[len(sorted)-1].TeamID]) {
+			continue
+		}
+
+		ranked := make([]*Record, 0, len(group))
+		for i := 0; i < len(sorted); {
+			j := i + 1
+			for j < len(sorted) && equal(values[sorted[j].TeamID], values[sorted[i].TeamID]) {
+				j++
+			}
+			if j-i == 1 {
+				reasons[sorted[i].TeamID] = crit.name
+				ranked = append(ranked, sorted[i])
+			} else {
+				ranked = append(ranked, c.breakTie(sorted[i:j], reasons)...)
+			}
+			i = j
+		}
+		return ranked
+	}
+
+	sorted := append([]*Record(nil), group...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].TeamID < sorted[j].TeamID })
+	for _, r := range sorted {
+		reasons[r.TeamID] = "抽签"
+	}
+	return sorted
+}
+
+func (c *calculator) twoTeamCriteria() []criterion {
+	return []criterion{
+		{"相互战绩", always, c.headToHead},
+		{"赛区第一", differentDivisions, c.divisionLeader},
+		{"赛区战绩", sameDivision, divisionPct},
+		{"联盟战绩", always, conferencePct},
+		{"对本联盟季后赛球队战绩", always, c.vsPlayoff(true)},
+		{"对另一联盟季后赛球队战绩", always, c.vsPlayoff(false)},
+		{"净胜分", always, pointDiff},
+	}
+}
+
+func (c *calculator) multiTeamCriteria() []criterion {
+	return []criterion{
+		{"赛区第一", differentDivisions, c.divisionLeader},
+		{"相互战绩", always, c.headToHead},
+		{"赛区战绩", sameDivision, divisionPct},
+		{"联盟战绩", always, conferencePct},
+		{"对本联盟季后赛球队战绩", always, c.vsPlayoff(true)},
+		{"净胜分", always, pointDiff},
+	}
+}
+
+func always([]*Record) bool { return true }
+
+// differentDivisions 赛区第一规则只在同分球队不全属于同一赛区时使用
+func differentDivisions(group []*Record) bool {
+	return group[0].Division != "" && !sameDivision(group)
+}
+
+func sameDivision(group []*Record) bool {
+	for _, r := range group {
+		if r.Division == "" || r.Division != group[0].Division {
+			return false
+		}
+	}
+	return true
+}
+
+// headToHead 对同分其他球队的合计胜率
+func (c *calculator) headToHead(r *Record, group []*Record) float64 {
+	w, l := 0, 0
+	for _, other := range group {
+		if other.TeamID == r.TeamID {
+			continue
+		}
+		w += c.h2h[[2]uint32{r.TeamID, other.TeamID}]
+		l += c.h2h[[2]uint32{other.TeamID, r.TeamID}]
+	}
+	return pct(w, l)
+}
+
+func (c *calculator) divisionLeader(r *Record, _ []*Record) float64 {
+	if c.leaders[r.TeamID] {
+		return 1
+	}
+	return 0
+}
+
+func divisionPct(r *Record, _ []*Record) float64 {
+	return pct(r.DivWins, r.DivLosses)
+}
+
+func conferencePct(r *Record, _ []*Record) float64 {
+	return pct(r.ConfWins, r.ConfLosses)
+}
+
+// vsPlayoff 对本联盟 (或另一联盟) 季后赛球队的胜率
+func (c *calculator) vsPlayoff(ownConference bool) func(r *Record, group []*Record) float64 {
+	return func(r *Record, _ []*Record) float64 {
+		w, l := 0, 0
+		for _, res := range r.results {
+			opp := c.records[res.opponent]
+			if !c.playoff[opp.TeamID] || (opp.Conference == r.Conference) != ownConference {
+				continue
+			}
+			if res.won {
+				w++
+			} else {
+				l++
+			}
+		}
+		return pct(w, l)
+	}
+}
+
+func pointDiff(r *Record, _ []*Record) float64 {
+	return float64(r.PointsFor - r.PointsAgainst)
+}
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
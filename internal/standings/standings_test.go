@@ -0,0 +1,120 @@
+package standings
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// beat winner 主场以 margin 分击败 loser
+func beat(winner, loser uint32, margin int) Game {
+	return Game{Home: winner, Visitor: loser, HomeScore: 100 + margin, VisitorScore: 100}
+}
+
+func east(ids ...uint32) []Team {
+	teams := make([]Team, 0, len(ids))
+	for _, id := range ids {
+		teams = append(teams, Team{ID: id, Conference: "E"})
+	}
+	return teams
+}
+
+// table 联盟排名的简写: 按名次列出 "球队ID(决胜规则)", 没有同胜率时只写ID
+func table(ranked []*Record) string {
+	parts := make([]string, 0, len(ranked))
+	for i, r := range ranked {
+		if r.Rank != i+1 {
+			return fmt.Sprintf("球队 %d 排第 %d 位但 Rank = %d", r.TeamID, i+1, r.Rank)
+		}
+		if r.Tiebreaker == "" {
+			parts = append(parts, fmt.Sprint(r.TeamID))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d(%s)", r.TeamID, r.Tiebreaker))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestTiebreakers(t *testing.T) {
+	t.Run("没有同胜率", func(t *testing.T) {
+		got := table(Compute(east(1, 2, 3), []Game{beat(3, 1, 5), beat(3, 2, 5), beat(2, 1, 5)})["E"])
+		if want := "3 2 1"; got != want {
+			t.Errorf("排名 = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("两队同胜率按相互战绩", func(t *testing.T) {
+		// 1 和 2 都是 1 胜 1 负, 2 在相互交手中获胜
+		got := table(Compute(east(1, 2, 3, 4), []Game{beat(2, 1, 30), beat(1, 3, 1), beat(4, 2, 5)})["E"])
+		if want := "4 2(相互战绩) 1(相互战绩) 3"; got != want {
+			t.Errorf("排名 = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("相互战绩持平按净胜分", func(t *testing.T) {
+		got := table(Compute(east(1, 2), []Game{beat(1, 2, 20), beat(2, 1, 1)})["E"])
+		if want := "1(净胜分) 2(净胜分)"; got != want {
+			t.Errorf("排名 = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("全部相同按球队ID抽签", func(t *testing.T) {
+		got := table(Compute(east(7, 3), nil)["E"])
+		if want := "3(抽签) 7(抽签)"; got != want {
+			t.Errorf("排名 = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("多队同胜率先比赛区第一", func(t *testing.T) {
+		// 三队循环各 1 胜 1 负; 1 在 A 赛区相互战绩胜 2 成为赛区第一,
+		// 2 不是赛区第一被排到最后, 剩下的 1 和 3 回到两队规则按相互战绩
+		teams := []Team{
+			{ID: 1, Conference: "E", Division: "A"},
+			{ID: 2, Conference: "E", Division: "A"},
+			{ID: 3, Conference: "E", Division: "B"},
+		}
+		got := table(Compute(teams, []Game{beat(1, 2, 5), beat(2, 3, 5), beat(3, 1, 5)})["E"])
+		if want := "3(相互战绩) 1(相互战绩) 2(赛区第一)"; got != want {
+			t.Errorf("排名 = %s, want %s", got, want)
+		}
+	})
+}
+
+func TestComputeRecord(t *testing.T) {
+	teams := []Team{{ID: 1, Conference: "E", Division: "A"}, {ID: 2, Conference: "E", Division: "A"}, {ID: 3, Conference: "W"}}
+	games := []Game{
+		beat(1, 2, 10),
+		{Home: 3, Visitor: 1, HomeScore: 90, VisitorScore: 95},
+		beat(3, 1, 2),
+		beat(2, 1, 4),
+		{Home: 1, Visitor: 2, HomeScore: 99, VisitorScore: 99}, // 平局忽略
+		beat(1, 9, 50), // 未知球队忽略
+	}
+	east := Compute(teams, games)["E"]
+	// 相互战绩和赛区战绩都持平, 1 对西部季后赛球队赢过一场
+	if got := table(east); got != "1(对另一联盟季后赛球队战绩) 2(对另一联盟季后赛球队战绩)" {
+		t.Fatalf("东部排名 = %s", got)
+	}
+
+	first, second := east[0], east[1]
+	if first.Wins != 2 || first.Losses != 2 || first.HomeWins != 1 || first.AwayWins != 1 {
+		t.Errorf("球队 1 战绩 = %d-%d (主场 %d 胜, 客场 %d 胜)", first.Wins, first.Losses, first.HomeWins, first.AwayWins)
+	}
+	if first.Streak != -2 {
+		t.Errorf("球队 1 最近两场连败, Streak = %d", first.Streak)
+	}
+	if first.PointsFor != 405 || first.PointsAgainst != 396 {
+		t.Errorf("球队 1 得失分 = %d/%d, want 405/396", first.PointsFor, first.PointsAgainst)
+	}
+	if second.Wins != 1 || second.Losses != 1 || second.Streak != 1 || second.GamesBehind != 0 {
+		t.Errorf("球队 2 = %d-%d Streak %d GamesBehind %v", second.Wins, second.Losses, second.Streak, second.GamesBehind)
+	}
+
+	// 赛区排名: 没有赛区的球队为 0
+	if first.DivisionRank != 1 || second.DivisionRank != 2 {
+		t.Errorf("A 赛区排名 = %d, %d, want 1, 2", first.DivisionRank, second.DivisionRank)
+	}
+	if west := Compute(teams, games)["W"]; west[0].DivisionRank != 0 {
+		t.Errorf("没有赛区的球队 DivisionRank = %d, want 0", west[0].DivisionRank)
+	}
+}
@@ -0,0 +1,67 @@
+package trade
+
+import (
+	"fmt"
+
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/model"
+)
+
+// 工资匹配分档 (美元)
+const (
+	matchingSmall  = 7_500_000  // 转出工资不超过该值时按 200% + 25 万
+	matchingMedium = 29_000_000 // 转出工资不超过该值时按 +750 万, 以上按 125% + 25 万
+	matchingBuffer = 250_000
+)
+
+// SalaryMatching 工资匹配规则 (按现行劳资协议简化):
+// 转入工资不超过转出工资, 或交易后仍在工资帽以下的球队不受限制;
+// 交易后超过第一土豪线的球队转入不能超过转出的 110%, 其余按 MatchingLimit 分档
+func SalaryMatching(caps model.CapThresholds) Rule {
+	return func(p *Proposal, st *State) []Violation {
+		var out []Violation
+		for _, teamID := range p.Teams() {
+			if st.Rosters[teamID] == nil {
+				continue
+			}
+			var outgoing, incoming int64
+			for _, a := range p.Assets {
+				if a.PlayerID == 0 {
+					continue
+				}
+				if a.From == teamID {
+					outgoing += st.Salaries[a.PlayerID]
+				}
+				if a.To == teamID {
+					incoming += st.Salaries[a.PlayerID]
+				}
+			}
+			after := st.Payrolls[teamID] - outgoing + incoming
+			if incoming <= outgoing || after <= caps.SalaryCap {
+				continue
+			}
+
+			limit := MatchingLimit(outgoing)
+			if after > caps.FirstApron {
+				limit = outgoing * 110 / 100
+			}
+			if incoming > limit {
+				out = append(out, Violation{Code: myErrors.CodeSalaryMismatch, Rule: RuleSalary, TeamID: teamID,
+					Message: fmt.Sprintf("球队 %d 转出工资 %d, 转入 %d, 超过允许的 %d", teamID, outgoing, incoming, limit)})
+			}
+		}
+		return out
+	}
+}
+
+// MatchingLimit 超帽 (未超第一土豪线) 球队按转出工资允许转入的最高工资
+func MatchingLimit(outgoing int64) int64 {
+	switch {
+	case outgoing <= matchingSmall:
+		return outgoing*2 + matchingBuffer
+	case outgoing <= matchingMedium:
+		return outgoing + matchingSmall
+	default:
+		return outgoing*125/100 + matchingBuffer
+	}
+}
@@ -0,0 +1,59 @@
+package trade
+
+import (
+	"testing"
+
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/model"
+)
+
+var caps = model.CapThresholds{SalaryCap: 140_000_000, LuxuryTax: 170_000_000, FirstApron: 178_000_000, SecondApron: 189_000_000}
+
+func TestSalaryMatching(t *testing.T) {
+	mismatch := Violation{Rule: RuleSalary, Code: myErrors.CodeSalaryMismatch}
+	// 球员 10 换球员 20 (工资 1000 万), 只有转入工资更多的球队 2 需要匹配
+	tests := []struct {
+		name     string
+		salary10 int64 // 球员 10 的工资, 即球队 2 的转入工资
+		payroll2 int64 // 球队 2 交易前的工资总额
+		want     []Violation
+	}{
+		{"交易后仍在帽下不受限制", 30_000_000, 100_000_000, nil},
+		{"超帽后超过转出 + 750 万", 30_000_000, 150_000_000, []Violation{mismatch}},
+		{"超帽后不超过转出 + 750 万", 17_500_000, 150_000_000, nil},
+		{"超第一土豪线后只能转入 110%", 11_500_000, 180_000_000, []Violation{mismatch}},
+		{"超第一土豪线后转入恰好 110%", 11_000_000, 180_000_000, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newLeague()
+			l.Salaries = map[uint32]int64{10: tt.salary10, 20: 10_000_000}
+			l.Payrolls = map[uint32]int64{1: 120_000_000, 2: tt.payroll2}
+			expect(t, SalaryMatching(caps)(swap(), l.State), tt.want...)
+		})
+	}
+}
+
+func TestSalaryMatchingWithDefaultRules(t *testing.T) {
+	l := newLeague()
+	l.Players[10].NoTrade = true
+	l.Salaries = map[uint32]int64{10: 30_000_000, 20: 10_000_000}
+	l.Payrolls = map[uint32]int64{1: 120_000_000, 2: 150_000_000}
+	expect(t, Validate(swap(), l.State, SalaryMatching(caps)),
+		Violation{Rule: RuleNoTrade, Code: myErrors.CodePlayerNoTrade},
+		Violation{Rule: RuleSalary, Code: myErrors.CodeSalaryMismatch})
+}
+
+func TestMatchingLimit(t *testing.T) {
+	for outgoing, want := range map[int64]int64{
+		5_000_000:  10_250_000, // 200% + 25 万
+		7_500_000:  15_250_000,
+		10_000_000: 17_500_000, // + 750 万
+		29_000_000: 36_500_000,
+		40_000_000: 50_250_000, // 125% + 25 万
+	} {
+		if got := MatchingLimit(outgoing); got != want {
+			t.Errorf("MatchingLimit(%d) = %d, want %d", outgoing, got, want)
+		}
+	}
+}
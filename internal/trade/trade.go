@@ -0,0 +1,311 @@
+package trade
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	nba_v "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/model"
+)
+
+// 交易规则名称 (Violation.Rule)
+const (
+	RuleStructure = "structure" // 交易内容本身无效 (空/重复/转出转入同队)
+	RuleTeam      = "team"      // 球队不存在
+	RuleOwnership = "ownership" // 球员/选秀权不属于转出球队
+	RuleNoTrade   = "no_trade"  // 不可交易条款
+	RuleCooldown  = "cooldown"  // 新签球员交易冷却期
+	RuleRoster    = "roster"    // 交易后阵容人数超限
+	RuleJersey    = "jersey"    // 交易后球衣号冲突
+	RuleSalary    = "salary"    // 工资不匹配
+	RulePick      = "pick"      // 选秀权保护无效
+)
+
+// SigningCooldown 自由球员签约后不能被交易的最短时间; 同时不早于签约赛季的 12 月 15 日
+const SigningCooldown = 3 // 月
+
+// Asset 交易中的一项资产, PlayerID 与 PickID 二选一; Protection 为随选秀权附加的前 N 顺位保护
+type Asset struct {
+	From       uint32
+	To         uint32
+	PlayerID   uint32
+	PickID     uint32
+	Protection int
+}
+
+// Proposal 交易方案
+type Proposal struct {
+	Assets        []Asset
+	Date          time.Time
+	NoTradeWaived map[uint32]bool // 同意放弃不可交易条款的球员
+}
+
+// State 交易涉及的当前数据
+type State struct {
+	Rosters  map[uint32]*model.Roster    // 涉及的球队 -> 阵容, 不存在的球队没有条目
+	Players  map[uint32]*model.Player    // 被交易的球员
+	Picks    map[uint32]*model.DraftPick // 被交易的选秀权
+	SignedAt map[uint32]time.Time        // 被交易球员最近一次签约日期
+	Salaries map[uint32]int64            // 被交易球员本赛季工资 (工资匹配规则用)
+	Payrolls map[uint32]int64            // 涉及球队交易前的工资总额 (工资匹配规则用)
+}
+
+// Violation 违反的交易规则
+type Violation struct {
+	Code     myErrors.ErrorCode
+	Rule     string
+	TeamID   uint32
+	PlayerID uint32
+	PickID   uint32
+	Message  string
+}
+
+// Rule 交易规则, 结构检查通过后依次执行
+type Rule func(p *Proposal, st *State) []Violation
+
+// Rules 默认规则
+var Rules = []Rule{checkOwnership, checkProtection, checkNoTrade, checkCooldown, checkRoster}
+
+// Teams 交易涉及的球队 (升序)
+func (p *Proposal) Teams() []uint32 {
+	seen := make(map[uint32]bool)
+	var ids []uint32
+	for _, a := range p.Assets {
+		for _, id := range []uint32{a.From, a.To} {
+			if id != 0 && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// PlayerIDs / PickIDs 被交易的球员和选秀权
+func (p *Proposal) PlayerIDs() []uint32 {
+	var ids []uint32
+	for _, a := range p.Assets {
+		if a.PlayerID != 0 {
+			ids = append(ids, a.PlayerID)
+		}
+	}
+	return ids
+}
+
+func (p *Proposal) PickIDs() []uint32 {
+	var ids []uint32
+	for _, a := range p.Assets {
+		if a.PickID != 0 {
+			ids = append(ids, a.PickID)
+		}
+	}
+	return ids
+}
+
+// Validate 检查交易, 返回全部违反的规则; 结构无效时只返回结构问题
+// extra 为额外规则 (如工资匹配), 在默认规则之后执行
+func Validate(p *Proposal, st *State, extra ...Rule) []Violation {
+	if vs := CheckStructure(p); len(vs) > 0 {
+		return vs
+	}
+	var out []Violation
+	for _, rule := range append(append([]Rule(nil), Rules...), extra...) {
+		out = append(out, rule(p, st)...)
+	}
+	return out
+}
+
+// CheckStructure 每项资产只能是球员或选秀权之一, 转出转入球队不同, 同一资产不能出现两次
+func CheckStructure(p *Proposal) []Violation {
+	if len(p.Assets) == 0 {
+		return []Violation{{Code: myErrors.CodeInvalidTrade, Rule: RuleStructure, Message: "交易内容为空"}}
+	}
+	var out []Violation
+	players := make(map[uint32]bool)
+	picks := make(map[uint32]bool)
+	for i, a := range p.Assets {
+		v := Violation{Code: myErrors.CodeInvalidTrade, Rule: RuleStructure, PlayerID: a.PlayerID, PickID: a.PickID}
+		switch {
+		case (a.PlayerID == 0) == (a.PickID == 0):
+			v.Message = fmt.Sprintf("第 %d 项: 球员和选秀权需且只能指定一个", i+1)
+		case a.Protection < 0 || a.Protection > 0 && a.PickID == 0:
+			v.Message = fmt.Sprintf("第 %d 项: 保护只能附加在选秀权上且不能为负", i+1)
+		case a.From == 0 || a.To == 0 || a.From == a.To:
+			v.Message = fmt.Sprintf("第 %d 项: 转出/转入球队无效", i+1)
+		case a.PlayerID != 0 && players[a.PlayerID] || a.PickID != 0 && picks[a.PickID]:
+			v.Message = fmt.Sprintf("第 %d 项: 资产重复", i+1)
+		default:
+			players[a.PlayerID], picks[a.PickID] = a.PlayerID != 0, a.PickID != 0
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+// checkOwnership 球队存在, 球员在转出球队阵容中, 选秀权由转出球队持有
+func checkOwnership(p *Proposal, st *State) []Violation {
+	var out []Violation
+	for _, id := range p.Teams() {
+		if st.Rosters[id] == nil {
+			out = append(out, Violation{Code: myErrors.CodeTeamNotFound, Rule: RuleTeam, TeamID: id,
+				Message: fmt.Sprintf("球队不存在: %d", id)})
+		}
+	}
+	for _, a := range p.Assets {
+		if a.PickID != 0 {
+			pick := st.Picks[a.PickID]
+			switch {
+			case pick == nil:
+				out = append(out, Violation{Code: myErrors.CodePickNotFound, Rule: RuleOwnership, PickID: a.PickID,
+					Message: fmt.Sprintf("选秀权不存在: %d", a.PickID)})
+			case pick.OwnerTeamID != a.From:
+				out = append(out, Violation{Code: myErrors.CodePickNotOwned, Rule: RuleOwnership, TeamID: a.From, PickID: a.PickID,
+					Message: fmt.Sprintf("选秀权 %d 不属于球队 %d", a.PickID, a.From)})
+			case pick.Used():
+				out = append(out, Violation{Code: myErrors.CodePickUsed, Rule: RuleOwnership, TeamID: a.From, PickID: a.PickID,
+					Message: fmt.Sprintf("选秀权 %d 已使用", a.PickID)})
+			}
+			continue
+		}
+		player := st.Players[a.PlayerID]
+		switch {
+		case player == nil:
+			out = append(out, Violation{Code: myErrors.CodePlayerNotFound, Rule: RuleOwnership, PlayerID: a.PlayerID,
+				Message: fmt.Sprintf("球员不存在: %d", a.PlayerID)})
+		case player.TeamID != a.From || player.Status == nba_v.PlayerStatus_RETIRED:
+			out = append(out, Violation{Code: myErrors.CodePlayerNotOnTeam, Rule: RuleOwnership, TeamID: a.From, PlayerID: a.PlayerID,
+				Message: fmt.Sprintf("球员 %s 不在球队 %d 阵容中", player.Name, a.From)})
+		}
+	}
+	return out
+}
+
+// checkProtection 顺位已确定 (已抽签) 或已带保护的选秀权不能再附加保护
+// 已有的保护随选秀权转手, 保护生效时回到当初附加保护的球队
+func checkProtection(p *Proposal, st *State) []Violation {
+	var out []Violation
+	for _, a := range p.Assets {
+		pick := st.Picks[a.PickID]
+		if a.Protection <= 0 || pick == nil {
+			continue
+		}
+		switch {
+		case pick.Number != 0:
+			out = append(out, Violation{Code: myErrors.CodeInvalidTrade, Rule: RulePick, TeamID: a.From, PickID: a.PickID,
+				Message: fmt.Sprintf("选秀权 %d 顺位已确定, 不能附加保护", a.PickID)})
+		case pick.Protection > 0:
+			out = append(out, Violation{Code: myErrors.CodeInvalidTrade, Rule: RulePick, TeamID: a.From, PickID: a.PickID,
+				Message: fmt.Sprintf("选秀权 %d 已带前 %d 顺位保护, 不能再附加保护", a.PickID, pick.Protection)})
+		}
+	}
+	return out
+}
+
+// checkNoTrade 有不可交易条款的球员需本人同意
+func checkNoTrade(p *Proposal, st *State) []Violation {
+	var out []Violation
+	for _, id := range p.PlayerIDs() {
+		if player := st.Players[id]; player != nil && player.NoTrade && !p.NoTradeWaived[id] {
+			out = append(out, Violation{Code: myErrors.CodePlayerNoTrade, Rule: RuleNoTrade, TeamID: player.TeamID, PlayerID: id,
+				Message: fmt.Sprintf("球员 %s 有不可交易条款, 需本人同意", player.Name)})
+		}
+	}
+	return out
+}
+
+// checkCooldown 自由球员签约后 SigningCooldown 个月内且签约赛季 12 月 15 日之前不能被交易
+func checkCooldown(p *Proposal, st *State) []Violation {
+	var out []Violation
+	for _, id := range p.PlayerIDs() {
+		player, signed := st.Players[id], st.SignedAt[id]
+		if player == nil || signed.IsZero() {
+			continue
+		}
+		if until := TradableFrom(signed); p.Date.Before(until) {
+			out = append(out, Violation{Code: myErrors.CodePlayerTradeLocked, Rule: RuleCooldown, TeamID: player.TeamID, PlayerID: id,
+				Message: fmt.Sprintf("球员 %s 于 %s 签约, %s 起才能被交易", player.Name, signed.Format("2006-01-02"), until.Format("2006-01-02"))})
+		}
+	}
+	return out
+}
+
+// TradableFrom 签约日期对应的最早可交易日期
+func TradableFrom(signed time.Time) time.Time {
+	until := signed.AddDate(0, SigningCooldown, 0)
+	// 签约所在赛季的 12 月 15 日 (赛季从 7 月开始算休赛期签约)
+	year := signed.Year()
+	if signed.Month() < time.July {
+		year--
+	}
+	if dec15 := time.Date(year, time.December, 15, 0, 0, 0, 0, signed.Location()); dec15.After(until) {
+		until = dec15
+	}
+	return until
+}
+
+// checkRoster 交易后各队的标准/双向名额不超上限, 球衣号不冲突
+func checkRoster(p *Proposal, st *State) []Violation {
+	var out []Violation
+	for _, teamID := range p.Teams() {
+		if st.Rosters[teamID] == nil {
+			continue
+		}
+		after := AfterTrade(p, st, teamID)
+		for _, limit := range []struct {
+			slot nba_v.RosterSlot
+			max  int
+			name string
+		}{
+			{nba_v.RosterSlot_STANDARD, model.MaxStandardRoster, "标准合同"},
+			{nba_v.RosterSlot_TWO_WAY, model.MaxTwoWayRoster, "双向合同"},
+		} {
+			if n := after.Count(limit.slot); n > limit.max {
+				out = append(out, Violation{Code: myErrors.CodeTeamFull, Rule: RuleRoster, TeamID: teamID,
+					Message: fmt.Sprintf("交易后球队 %d 的%s人数为 %d, 超过上限 %d", teamID, limit.name, n, limit.max)})
+			}
+		}
+
+		numbers := make(map[uint8]*model.Player)
+		for _, player := range after.Players {
+			if other, ok := numbers[player.JerseyNumber]; ok {
+				out = append(out, Violation{Code: myErrors.CodeJerseyConflict, Rule: RuleJersey, TeamID: teamID, PlayerID: player.ID,
+					Message: fmt.Sprintf("交易后球队 %d 的 %s 与 %s 都穿 %d 号", teamID, other.Name, player.Name, player.JerseyNumber)})
+				continue
+			}
+			numbers[player.JerseyNumber] = player
+		}
+	}
+	return out
+}
+
+// AfterTrade 交易后 teamID 的阵容 (转出的球员移除, 转入的球员加入)
+// 不在转出球队阵容中的球员由所属权规则报错, 这里不计入
+func AfterTrade(p *Proposal, st *State, teamID uint32) *model.Roster {
+	out := make(map[uint32]bool)
+	var in []*model.Player
+	for _, a := range p.Assets {
+		if a.PlayerID == 0 || st.Players[a.PlayerID] == nil || st.Players[a.PlayerID].TeamID != a.From {
+			continue
+		}
+		if a.From == teamID {
+			out[a.PlayerID] = true
+		}
+		if a.To == teamID {
+			in = append(in, st.Players[a.PlayerID])
+		}
+	}
+	after := &model.Roster{TeamID: teamID}
+	if current := st.Rosters[teamID]; current != nil {
+		for _, player := range current.Players {
+			if !out[player.ID] {
+				after.Players = append(after.Players, player)
+			}
+		}
+	}
+	after.Players = append(after.Players, in...)
+	return after
+}
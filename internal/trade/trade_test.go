@@ -0,0 +1,239 @@
+package trade
+
+import (
+	"testing"
+	"time"
+
+	nba_v "nba-remake/api/proto/v1"
+	myErrors "nba-remake/errors"
+	"nba-remake/internal/model"
+)
+
+var tradeDate = time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+// league 两支球队的交易现场, 测试中按需修改后交给规则检查
+// 球队 1: 球员 10 (1 号), 11 (2 号), 持有自己的选秀权 100
+// 球队 2: 球员 20 (3 号), 21 (4 号), 持有自己的选秀权 101
+type league struct {
+	*State
+}
+
+func newLeague() league {
+	l := league{&State{
+		Rosters:  map[uint32]*model.Roster{1: {TeamID: 1}, 2: {TeamID: 2}},
+		Players:  make(map[uint32]*model.Player),
+		SignedAt: make(map[uint32]time.Time),
+		Picks: map[uint32]*model.DraftPick{
+			100: {ID: 100, OriginalTeamID: 1, OwnerTeamID: 1},
+			101: {ID: 101, OriginalTeamID: 2, OwnerTeamID: 2},
+		},
+	}}
+	l.sign(10, 1, 1)
+	l.sign(11, 1, 2)
+	l.sign(20, 2, 3)
+	l.sign(21, 2, 4)
+	return l
+}
+
+// sign 球员以标准合同加入球队
+func (l league) sign(id, teamID uint32, jersey uint8) *model.Player {
+	player := &model.Player{ID: id, TeamID: teamID, JerseyNumber: jersey, RosterSlot: nba_v.RosterSlot_STANDARD}
+	l.Players[id] = player
+	l.Rosters[teamID].Players = append(l.Rosters[teamID].Players, player)
+	return player
+}
+
+// trade 交易方案, 每项资产为 {From, To, PlayerID, PickID}
+func trade(assets ...Asset) *Proposal {
+	return &Proposal{Date: tradeDate, Assets: assets}
+}
+
+// swap 球队 1 的球员 10 换球队 2 的球员 20
+func swap() *Proposal {
+	return trade(Asset{From: 1, To: 2, PlayerID: 10}, Asset{From: 2, To: 1, PlayerID: 20})
+}
+
+// expect 检查违反的规则 (按顺序) 和错误码
+func expect(t *testing.T, got []Violation, want ...Violation) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("违反 %d 条规则, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].Rule != want[i].Rule || got[i].Code != want[i].Code {
+			t.Errorf("第 %d 条 = %s/%d, want %s/%d (%s)", i+1, got[i].Rule, got[i].Code, want[i].Rule, want[i].Code, got[i].Message)
+		}
+	}
+}
+
+func TestCheckStructure(t *testing.T) {
+	invalid := Violation{Rule: RuleStructure, Code: myErrors.CodeInvalidTrade}
+
+	expect(t, CheckStructure(swap()))
+	expect(t, CheckStructure(trade()), invalid)
+	expect(t, CheckStructure(trade(Asset{From: 1, To: 2, PlayerID: 10, PickID: 100})), invalid)
+	expect(t, CheckStructure(trade(Asset{From: 1, To: 2})), invalid)
+	expect(t, CheckStructure(trade(Asset{From: 1, To: 1, PlayerID: 10})), invalid)
+	expect(t, CheckStructure(trade(Asset{From: 0, To: 2, PickID: 100})), invalid)
+	expect(t, CheckStructure(trade(Asset{From: 1, To: 2, PlayerID: 10, Protection: 5})), invalid)
+	expect(t, CheckStructure(trade(Asset{From: 1, To: 2, PickID: 100, Protection: -1})), invalid)
+	// 同一资产出现两次只报重复的那一项
+	expect(t, CheckStructure(trade(Asset{From: 1, To: 2, PickID: 100}, Asset{From: 1, To: 2, PickID: 100})), invalid)
+}
+
+func TestCheckOwnership(t *testing.T) {
+	l := newLeague()
+	l.Players[11].Status = nba_v.PlayerStatus_RETIRED
+
+	expect(t, checkOwnership(swap(), l.State))
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 2, PickID: 100}), l.State))
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 9, PickID: 100}), l.State),
+		Violation{Rule: RuleTeam, Code: myErrors.CodeTeamNotFound})
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 2, PlayerID: 21}), l.State),
+		Violation{Rule: RuleOwnership, Code: myErrors.CodePlayerNotOnTeam})
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 2, PlayerID: 11}), l.State),
+		Violation{Rule: RuleOwnership, Code: myErrors.CodePlayerNotOnTeam})
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 2, PlayerID: 99}), l.State),
+		Violation{Rule: RuleOwnership, Code: myErrors.CodePlayerNotFound})
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 2, PickID: 101}), l.State),
+		Violation{Rule: RuleOwnership, Code: myErrors.CodePickNotOwned})
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 2, PickID: 999}), l.State),
+		Violation{Rule: RuleOwnership, Code: myErrors.CodePickNotFound})
+
+	l.Picks[100].PlayerID = 99
+	expect(t, checkOwnership(trade(Asset{From: 1, To: 2, PickID: 100}), l.State),
+		Violation{Rule: RuleOwnership, Code: myErrors.CodePickUsed})
+}
+
+func TestCheckProtection(t *testing.T) {
+	l := newLeague()
+	l.Picks[101].Number = 5 // 已抽签
+
+	expect(t, checkProtection(trade(Asset{From: 1, To: 2, PickID: 100, Protection: 10}), l.State))
+	expect(t, checkProtection(trade(Asset{From: 2, To: 1, PickID: 101}), l.State))
+	expect(t, checkProtection(trade(Asset{From: 2, To: 1, PickID: 101, Protection: 10}), l.State),
+		Violation{Rule: RulePick, Code: myErrors.CodeInvalidTrade})
+
+	// 已带保护的选秀权沿用原保护转手, 不能再附加保护覆盖之前的保护
+	l.Picks[100].Protection, l.Picks[100].ProtectedBy = 10, 3
+	expect(t, checkProtection(trade(Asset{From: 1, To: 2, PickID: 100}), l.State))
+	expect(t, checkProtection(trade(Asset{From: 1, To: 2, PickID: 100, Protection: 5}), l.State),
+		Violation{Rule: RulePick, Code: myErrors.CodeInvalidTrade})
+}
+
+func TestCheckNoTrade(t *testing.T) {
+	l := newLeague()
+	l.Players[10].NoTrade = true
+
+	expect(t, checkNoTrade(swap(), l.State), Violation{Rule: RuleNoTrade, Code: myErrors.CodePlayerNoTrade})
+
+	waived := swap()
+	waived.NoTradeWaived = map[uint32]bool{10: true}
+	expect(t, checkNoTrade(waived, l.State))
+}
+
+func TestCheckCooldown(t *testing.T) {
+	l := newLeague()
+	l.SignedAt[20] = tradeDate.AddDate(0, -1, 0)
+	expect(t, checkCooldown(swap(), l.State), Violation{Rule: RuleCooldown, Code: myErrors.CodePlayerTradeLocked})
+
+	l.SignedAt[20] = tradeDate.AddDate(0, -4, 0)
+	expect(t, checkCooldown(swap(), l.State))
+}
+
+func TestTradableFrom(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	for signed, want := range map[time.Time]time.Time{
+		date(2024, time.July, 10):    date(2024, time.December, 15), // 休赛期签约不早于 12 月 15 日
+		date(2024, time.November, 1): date(2025, time.February, 1),  // 赛季中签约按 3 个月
+		date(2025, time.January, 20): date(2025, time.April, 20),    // 次年签约按 3 个月
+	} {
+		if got := TradableFrom(signed); !got.Equal(want) {
+			t.Errorf("TradableFrom(%s) = %s, want %s", signed.Format("2006-01-02"), got.Format("2006-01-02"), want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestCheckRoster(t *testing.T) {
+	t.Run("二换一后超员", func(t *testing.T) {
+		l := newLeague()
+		for i := uint32(0); i < model.MaxStandardRoster-2; i++ {
+			l.sign(30+i, 2, uint8(30+i))
+		}
+		p := trade(Asset{From: 1, To: 2, PlayerID: 10}, Asset{From: 1, To: 2, PlayerID: 11}, Asset{From: 2, To: 1, PlayerID: 20})
+		expect(t, checkRoster(p, l.State), Violation{Rule: RuleRoster, Code: myErrors.CodeTeamFull})
+	})
+
+	t.Run("换来的球员与留队球员同号", func(t *testing.T) {
+		l := newLeague()
+		l.Players[10].JerseyNumber = 4
+		expect(t, checkRoster(swap(), l.State), Violation{Rule: RuleJersey, Code: myErrors.CodeJerseyConflict})
+	})
+
+	t.Run("换走的球员号码可以让给换来的球员", func(t *testing.T) {
+		l := newLeague()
+		l.Players[20].JerseyNumber = 1
+		expect(t, checkRoster(swap(), l.State))
+	})
+
+	t.Run("不在转出球队的球员不计入阵容", func(t *testing.T) {
+		// 球员 21 本就在球队 2, 错报为球队 1 转出; 由所属权规则报错, 不应再和自己撞号
+		l := newLeague()
+		expect(t, checkRoster(trade(Asset{From: 1, To: 2, PlayerID: 21}), l.State))
+	})
+}
+
+func TestAfterTrade(t *testing.T) {
+	l := newLeague()
+	ids := func(r *model.Roster) (out []uint32) {
+		for _, p := range r.Players {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+
+	if got := ids(AfterTrade(swap(), l.State, 1)); len(got) != 2 || got[0] != 11 || got[1] != 20 {
+		t.Errorf("球队 1 交易后阵容 = %v, want [11 20]", got)
+	}
+	if got := ids(AfterTrade(swap(), l.State, 2)); len(got) != 2 || got[0] != 21 || got[1] != 10 {
+		t.Errorf("球队 2 交易后阵容 = %v, want [21 10]", got)
+	}
+	// 球员 21 不在球队 1, 错报的资产不改变任何一方的阵容
+	if got := ids(AfterTrade(trade(Asset{From: 1, To: 2, PlayerID: 21}), l.State, 2)); len(got) != 2 {
+		t.Errorf("球队 2 交易后阵容 = %v, want [20 21]", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("合法交易", func(t *testing.T) {
+		expect(t, Validate(swap(), newLeague().State))
+	})
+
+	t.Run("结构无效时不执行其它规则", func(t *testing.T) {
+		// 球员 20 不属于球队 1, 但结构问题优先
+		p := trade(Asset{From: 1, To: 2, PlayerID: 20}, Asset{From: 1, To: 2, PlayerID: 20})
+		expect(t, Validate(p, newLeague().State), Violation{Rule: RuleStructure, Code: myErrors.CodeInvalidTrade})
+	})
+
+	t.Run("按规则顺序返回全部违反项", func(t *testing.T) {
+		l := newLeague()
+		l.Players[10].NoTrade = true
+		l.Players[10].JerseyNumber = 4
+		l.SignedAt[20] = tradeDate.AddDate(0, -1, 0)
+		expect(t, Validate(swap(), l.State),
+			Violation{Rule: RuleNoTrade, Code: myErrors.CodePlayerNoTrade},
+			Violation{Rule: RuleCooldown, Code: myErrors.CodePlayerTradeLocked},
+			Violation{Rule: RuleJersey, Code: myErrors.CodeJerseyConflict})
+	})
+
+	t.Run("额外规则在默认规则之后执行", func(t *testing.T) {
+		reject := func(p *Proposal, st *State) []Violation {
+			return []Violation{{Rule: "extra", Code: myErrors.CodeInvalidTrade}}
+		}
+		l := newLeague()
+		l.Players[10].NoTrade = true
+		expect(t, Validate(swap(), l.State, reject),
+			Violation{Rule: RuleNoTrade, Code: myErrors.CodePlayerNoTrade},
+			Violation{Rule: "extra", Code: myErrors.CodeInvalidTrade})
+	})
+}
@@ -20,6 +20,7 @@ import (
 	pb "nba-remake/api/proto/v1"
 	"nba-remake/internal/config"
 	"nba-remake/internal/dao"
+	"nba-remake/internal/model"
 	"nba-remake/internal/mq"
 	"nba-remake/internal/processor"
 	"nba-remake/internal/service"
@@ -30,30 +31,103 @@ func main() {
 	conf := config.LoadConfig()
 
 	// 2. 初始化共享资源 (DB)
-	db, err := gorm.Open(mysql.Open(conf.MySQL.DSN), &gorm.Config{})
+	// 表结构和外键由建表脚本维护, AutoMigrate 只补列/建新表, 不创建外键
+	db, err := gorm.Open(mysql.Open(conf.MySQL.DSN), &gorm.Config{DisableForeignKeyConstraintWhenMigrating: true})
 	if err != nil {
 		log.Fatal("DB连接失败:", err)
 	}
 
+	// 自动建表 (新增的表由程序维护)
+	hasPeriodScores := db.Migrator().HasTable(&model.MatchPeriodScore{})
+	// 旧版事件表没有 shot_type 列, type 仍是旧编号, 建表后需改写
+	legacyEvents := db.Migrator().HasTable(&model.MatchEvent{}) && !db.Migrator().HasColumn(&model.MatchEvent{}, "shot_type")
+	if err := db.AutoMigrate(&model.Player{}, &model.Match{}, &model.MatchEvent{}, &model.PlayerGameStats{}, &model.MatchPeriodScore{},
+		&model.Conference{}, &model.Division{}, &model.TeamDivision{}, &model.TeamSeason{},
+		&model.PlayerTransaction{}, &model.Trade{}, &model.TradePick{}, &model.DraftPick{},
+		&model.Contract{}, &model.ContractSeason{}, &model.Injury{}, &model.Draft{}, &model.Prospect{}); err != nil {
+		log.Fatal("建表失败:", err)
+	}
+	// 首次建表时写入现行的联盟/赛区划分
+	if err := dao.NewLeagueDao(db).SeedAlignment(model.DefaultAlignment, model.DefaultAlignmentSeason); err != nil {
+		log.Printf("写入赛区划分失败: %v", err)
+	}
+	// 赛季记录之前已存在的球队补写基线, 之后的更名不影响历史比赛的队名
+	if err := dao.NewTeamDao(db).SeedSeasons(model.BaselineSeason); err != nil {
+		log.Printf("写入球队赛季信息失败: %v", err)
+	}
+
 	// 初始化 Kafka Producer
 	kafkaProducer, err := mq.NewProducer(conf.Kafka)
 	if err != nil {
 		log.Fatal("Kafka Producer 失败:", err)
 	}
 	defer kafkaProducer.Close()
+	dlqReplayer := mq.NewDeadLetterReplayer(conf.Kafka, kafkaProducer)
+
+	// 初始化 ES 球员/事件索引, 首次创建时从 MySQL 全量导入
+	esClient := es.NewEsClient(&conf.Elasticsearch)
+	playerIndex := es.NewPlayerIndex(esClient, &conf.Elasticsearch)
+	created, err := playerIndex.EnsureIndex()
+	if err != nil {
+		log.Printf("ES 球员索引初始化失败 (搜索不可用): %v", err)
+	}
+	eventIndex := es.NewEventIndex(esClient, &conf.Elasticsearch)
+	eventIndexCreated, err := eventIndex.EnsureIndex()
+	if err != nil {
+		log.Printf("ES 事件索引初始化失败 (搜索不可用): %v", err)
+	}
 
 	// 初始化 DAO & Service
-	playerDAO := dao.NewPlayerDao(db)
+	playerDAO := dao.NewPlayerDao(db, playerIndex)
 	teamDAO := dao.NewTeamDao(db)
+	leagueDAO := dao.NewLeagueDao(db)
 	matchDAO := dao.NewMatchDao(db)
+	statsDAO := dao.NewStatsDao(db)
+
+	// 旧编号的事件先改写, 单节比分补数据依赖新的事件类型
+	if legacyEvents {
+		if rows, err := matchDAO.RemapLegacyEventTypes(); err != nil {
+			log.Fatal("事件类型迁移失败:", err)
+		} else {
+			log.Printf("事件类型迁移 %d 行", rows)
+		}
+	}
+
+	// 单节比分表新建时从历史事件补数据
+	if !hasPeriodScores {
+		if rows, err := matchDAO.RebuildPeriodScores(); err != nil {
+			log.Printf("单节比分补数据失败: %v", err)
+		} else {
+			log.Printf("单节比分补数据 %d 行", rows)
+		}
+	}
+
+	if created {
+		players, err := playerDAO.ListAll()
+		if err == nil {
+			err = playerIndex.BulkIndex(players)
+		}
+		if err != nil {
+			log.Printf("ES 球员索引全量导入失败: %v", err)
+		} else {
+			log.Printf("ES 球员索引全量导入 %d 条", len(players))
+		}
+	}
+	if eventIndexCreated {
+		// 事件量大, 放到后台导入, 不阻塞启动
+		go indexAllEvents(matchDAO, eventIndex)
+	}
 
 	// 初始化Redis Client
 	cacheClient := cache.NewCache(&conf.Redis)
 
 	// 初始化mongodb Client
 	mongoClient := mongodb.NewMongoDBClient(&conf.MongoDB)
-	esClient := es.NewEsClient(&conf.Elasticsearch)
-	nbaService := service.NewNBAService(playerDAO, teamDAO, matchDAO, kafkaProducer, cacheClient, mongoClient, esClient)
+	gameStore := mongodb.NewGameStore(mongoClient, &conf.MongoDB)
+	if err := gameStore.EnsureIndexes(context.Background()); err != nil {
+		log.Printf("MongoDB 比赛归档索引创建失败: %v", err)
+	}
+	nbaService := service.NewNBAService(playerDAO, teamDAO, leagueDAO, matchDAO, statsDAO, kafkaProducer, dlqReplayer, cacheClient, mongoClient, esClient, playerIndex, eventIndex, gameStore, conf.SalaryCap)
 
 	// 初始化 gRPC Server
 	server := grpc.NewServer()
@@ -74,7 +148,7 @@ func main() {
 	}
 	defer consumerGroup.Close()
 
-	statsHandler := processor.NewStatsHandler(db)
+	statsHandler := processor.NewStatsHandler(db, kafkaProducer, cacheClient, eventIndex, nbaService, conf.Kafka)
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// 1. 启动 gRPC 服务
@@ -116,3 +190,28 @@ func main() {
 
 	log.Println("服务已全部停止")
 }
+
+// indexAllEvents 把全部比赛事件分批导入 ES
+func indexAllEvents(matchDAO *dao.MatchDao, eventIndex *es.EventIndex) {
+	total := 0
+	err := matchDAO.ScanEvents(1000, func(events []*model.MatchEvent) error {
+		ids := make([]uint64, 0, len(events))
+		for _, e := range events {
+			ids = append(ids, e.MatchID)
+		}
+		matches, err := matchDAO.GetMatchesByIDs(ids)
+		if err != nil {
+			return err
+		}
+		if err := eventIndex.BulkIndex(events, matches); err != nil {
+			return err
+		}
+		total += len(events)
+		return nil
+	})
+	if err != nil {
+		log.Printf("ES 事件索引全量导入失败 (已导入 %d 条): %v", total, err)
+		return
+	}
+	log.Printf("ES 事件索引全量导入 %d 条", total)
+}